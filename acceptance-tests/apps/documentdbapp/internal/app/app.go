@@ -0,0 +1,50 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+const (
+	collectionName = "csb"
+
+	unauthorizedCode = 13
+)
+
+func App(db *mongo.Database) http.Handler {
+	r := http.NewServeMux()
+
+	r.HandleFunc("GET /", aliveness)
+	r.HandleFunc("PUT /documents/{id}", handleStore(db.Collection(collectionName)))
+	r.HandleFunc("GET /documents/{id}", handleFetch(db.Collection(collectionName)))
+
+	return r
+}
+
+func aliveness(w http.ResponseWriter, r *http.Request) {
+	log.Printf("Handled aliveness test.")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func fail(w http.ResponseWriter, code int, format string, a ...any) {
+	msg := fmt.Sprintf(format, a...)
+	log.Println(msg)
+	http.Error(w, msg, code)
+}
+
+// errorStatus distinguishes the errors of a binding without the required role, so that tests can check them
+func errorStatus(err error) int {
+	var commandErr mongo.CommandError
+	if errors.As(err, &commandErr) && commandErr.HasErrorCode(unauthorizedCode) {
+		return http.StatusForbidden
+	}
+	var writeErr mongo.WriteException
+	if errors.As(err, &writeErr) && writeErr.HasErrorCode(unauthorizedCode) {
+		return http.StatusForbidden
+	}
+	return http.StatusFailedDependency
+}
@@ -0,0 +1,44 @@
+package app
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+func handleFetch(collection *mongo.Collection) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log.Println("Handling fetch.")
+
+		id := r.PathValue("id")
+		if id == "" {
+			fail(w, http.StatusBadRequest, "url parameter 'id' is required")
+			return
+		}
+
+		var document struct {
+			Value string `bson:"value"`
+		}
+		err := collection.FindOne(r.Context(), bson.D{{Key: "_id", Value: id}}).Decode(&document)
+		switch {
+		case errors.Is(err, mongo.ErrNoDocuments):
+			fail(w, http.StatusNotFound, "document %q not found", id)
+			return
+		case err != nil:
+			fail(w, errorStatus(err), "failed to fetch document: %s", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(document.Value)); err != nil {
+			log.Printf("Error writing value: %s", err)
+			return
+		}
+
+		log.Printf("Document %q fetched with value %q.", id, document.Value)
+	}
+}
@@ -0,0 +1,44 @@
+package app
+
+import (
+	"io"
+	"log"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func handleStore(collection *mongo.Collection) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log.Println("Handling store.")
+
+		id := r.PathValue("id")
+		if id == "" {
+			fail(w, http.StatusBadRequest, "url parameter 'id' is required")
+			return
+		}
+
+		rawValue, err := io.ReadAll(r.Body)
+		if err != nil {
+			fail(w, http.StatusBadRequest, "error parsing value from body: %s", err)
+			return
+		}
+
+		value := string(rawValue)
+		_, err = collection.ReplaceOne(
+			r.Context(),
+			bson.D{{Key: "_id", Value: id}},
+			bson.D{{Key: "_id", Value: id}, {Key: "value", Value: value}},
+			options.Replace().SetUpsert(true),
+		)
+		if err != nil {
+			fail(w, errorStatus(err), "failed to store document: %s", err)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		log.Printf("Document %q stored with value %q.", id, value)
+	}
+}
@@ -0,0 +1,90 @@
+package credentials
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/cloudfoundry-community/go-cfenv"
+	"github.com/mitchellh/mapstructure"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type Credentials struct {
+	URI            string `mapstructure:"uri"`
+	Name           string `mapstructure:"name"`
+	TLSCABundleURL string `mapstructure:"tls_ca_bundle_url"`
+}
+
+func Read() (Credentials, error) {
+	app, err := cfenv.Current()
+	if err != nil {
+		return Credentials{}, fmt.Errorf("error reading app env: %w", err)
+	}
+	svs, err := app.Services.WithTag("documentdb")
+	if err != nil {
+		return Credentials{}, fmt.Errorf("error reading DocumentDB service details")
+	}
+
+	var r Credentials
+	if err := mapstructure.Decode(svs[0].Credentials, &r); err != nil {
+		return Credentials{}, fmt.Errorf("failed to decode credentials: %w", err)
+	}
+
+	if r.URI == "" || r.Name == "" {
+		return Credentials{}, fmt.Errorf("parsed credentials are not valid")
+	}
+
+	return r, nil
+}
+
+// Client connects with the URI, and verifies the cluster certificate with the CA bundle when there is one
+func (c Credentials) Client(ctx context.Context) (*mongo.Client, error) {
+	opts := options.Client().ApplyURI(c.URI)
+	if c.TLSCABundleURL != "" {
+		roots, err := downloadCABundle(ctx, c.TLSCABundleURL)
+		if err != nil {
+			return nil, err
+		}
+		opts.SetTLSConfig(&tls.Config{RootCAs: roots})
+	}
+
+	client, err := mongo.Connect(opts)
+	if err != nil {
+		// The error is returned without the URI as it contains the password
+		return nil, fmt.Errorf("failed to connect with the URI from the credentials")
+	}
+	return client, nil
+}
+
+func downloadCABundle(ctx context.Context, bundleURL string) (*x509.CertPool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, bundleURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading the CA bundle: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error downloading the CA bundle: %s", resp.Status)
+	}
+
+	bundle, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the CA bundle: %w", err)
+	}
+
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(bundle) {
+		return nil, fmt.Errorf("no certificates found in the CA bundle")
+	}
+	return roots, nil
+}
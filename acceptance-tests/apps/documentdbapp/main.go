@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"documentdbapp/internal/app"
+	"documentdbapp/internal/credentials"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+)
+
+func main() {
+	log.Println("Starting.")
+
+	log.Println("Reading credentials.")
+	creds, err := credentials.Read()
+	if err != nil {
+		panic(err)
+	}
+
+	log.Println("Connecting to DocumentDB.")
+	client, err := creds.Client(context.Background())
+	if err != nil {
+		panic(err)
+	}
+
+	port := port()
+	log.Printf("Listening on port: %s", port)
+	http.Handle("/", app.App(client.Database(creds.Name)))
+	http.ListenAndServe(port, nil)
+}
+
+func port() string {
+	if port := os.Getenv("PORT"); port != "" {
+		return fmt.Sprintf(":%s", port)
+	}
+	return ":8080"
+}
@@ -7,7 +7,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 
 	appcreds "dynamodbnsapp/internal/credentials"
 )
@@ -17,23 +19,21 @@ const (
 )
 
 func App(creds appcreds.DynamoDBNamespaceService) http.Handler {
-	cfg, err := config.LoadDefaultConfig(
-		context.Background(),
-		config.WithCredentialsProvider(
-			aws.NewCredentialsCache(
-				credentials.NewStaticCredentialsProvider(
-					creds.AccessKeyID,
-					creds.SecretAccessKey,
-					"",
-				),
-			),
-		),
-		config.WithRegion(creds.Region),
-	)
+	cfg, err := config.LoadDefaultConfig(context.Background(), config.WithRegion(creds.Region))
 	if err != nil {
 		panic(err)
 	}
 
+	// A namespace binding is either an access key, or a role that the platform identity of the app
+	// can only assume with the external ID of the binding
+	if creds.RoleARN == "" {
+		cfg.Credentials = credentials.NewStaticCredentialsProvider(creds.AccessKeyID, creds.SecretAccessKey, "")
+	} else {
+		cfg.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(sts.NewFromConfig(cfg), creds.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+			o.ExternalID = aws.String(creds.ExternalID)
+		}))
+	}
+
 	client := dynamodb.NewFromConfig(cfg)
 
 	r := http.NewServeMux()
@@ -41,6 +41,7 @@ func App(creds appcreds.DynamoDBNamespaceService) http.Handler {
 	r.HandleFunc("POST /tables", ddbClient(client, createTable))
 	r.HandleFunc("GET /tables/{tableName}", ddbClient(client, tableCtx(getTable)))
 	r.HandleFunc("DELETE /tables/{tableName}", ddbClient(client, tableCtx(deleteTable)))
+	r.HandleFunc("GET /tables/{tableName}/values", ddbClient(client, tableCtx(listValues)))
 	r.HandleFunc("POST /tables/{tableName}/values/{key}", ddbClient(client, tableCtx(tableKeyCtx(createValue))))
 	r.HandleFunc("GET /tables/{tableName}/values/{key}/{pk}", ddbClient(client, tableCtx(tableKeyCtx(tablePrimaryKeyCtx(getValue)))))
 	r.HandleFunc("DELETE /tables/{tableName}/values/{key}/{pk}", ddbClient(client, tableCtx(tableKeyCtx(tablePrimaryKeyCtx(deleteValue)))))
@@ -122,6 +122,32 @@ func getValue(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+func listValues(w http.ResponseWriter, r *http.Request) {
+	tableName, client := extractTableContextValues(r)
+
+	reply, err := client.Scan(r.Context(), &dynamodb.ScanInput{TableName: &tableName})
+	if err != nil {
+		writeJSONResponse(w, statusCodeFromAWSError(err), NewErrResponse(err))
+		return
+	}
+
+	values := make([]ValueResponse, 0, len(reply.Items))
+	for _, item := range reply.Items {
+		pk, err := strconv.ParseInt(item[tableKeyPrimary].(*types.AttributeValueMemberN).Value, 10, 64)
+		if err != nil {
+			writeJSONResponse(w, http.StatusUnprocessableEntity, NewErrResponse(err))
+			return
+		}
+		values = append(values, ValueResponse{
+			Pk:      pk,
+			Sorting: item[tableKeySorting].(*types.AttributeValueMemberS).Value,
+			Value:   item[tableValueColumnName].(*types.AttributeValueMemberS).Value,
+		})
+	}
+
+	writeJSONResponse(w, http.StatusOK, values)
+}
+
 func extractValueContextValues(r *http.Request) (tableName string, key string, pk string, client *dynamodb.Client) {
 	tableName, client = extractTableContextValues(r)
 	key = r.Context().Value(tableKeyNameKey).(string)
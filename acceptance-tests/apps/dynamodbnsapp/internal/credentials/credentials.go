@@ -10,6 +10,8 @@ import (
 type DynamoDBNamespaceService struct {
 	AccessKeyID     string `mapstructure:"access_key_id"`
 	SecretAccessKey string `mapstructure:"secret_access_key"`
+	RoleARN         string `mapstructure:"role_arn"`
+	ExternalID      string `mapstructure:"external_id"`
 	Region          string `mapstructure:"region"`
 	Prefix          string `mapstructure:"prefix"`
 }
@@ -35,7 +37,9 @@ func parseCredentials(input map[string]any) (DynamoDBNamespaceService, error) {
 		return DynamoDBNamespaceService{}, fmt.Errorf("failed to decode credentials: %w", err)
 	}
 
-	if r.AccessKeyID == "" || r.SecretAccessKey == "" || r.Region == "" || r.Prefix == "" {
+	hasKey := r.AccessKeyID != "" && r.SecretAccessKey != ""
+	hasRole := r.RoleARN != "" && r.ExternalID != ""
+	if !(hasKey || hasRole) || r.Region == "" || r.Prefix == "" {
 		return DynamoDBNamespaceService{}, fmt.Errorf("parsed credentials are not valid")
 	}
 
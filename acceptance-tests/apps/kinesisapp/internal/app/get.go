@@ -0,0 +1,62 @@
+package app
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"kinesisapp/internal/credentials"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+)
+
+func handleGet(creds credentials.Credentials) func(r *http.Request) (int, string) {
+	return func(r *http.Request) (int, string) {
+		binding := r.PathValue("binding_name")
+		log.Printf("Handling get on binding %q\n", binding)
+
+		cred, ok := creds[binding]
+		if !ok {
+			return http.StatusBadRequest, fmt.Sprintf("no creds found for binding: %q", binding)
+		}
+		cfg, err := cred.Config()
+		if err != nil {
+			return http.StatusInternalServerError, fmt.Sprintf("could not read AWS config: %q", err)
+		}
+
+		shardID := r.URL.Query().Get("shard_id")
+		sequenceNumber := r.URL.Query().Get("sequence_number")
+		if shardID == "" || sequenceNumber == "" {
+			return http.StatusBadRequest, "Should include shard_id and sequence_number query params."
+		}
+
+		client := kinesis.NewFromConfig(cfg)
+		iterator, err := client.GetShardIterator(r.Context(), &kinesis.GetShardIteratorInput{
+			StreamARN:              &cred.ARN,
+			ShardId:                &shardID,
+			ShardIteratorType:      types.ShardIteratorTypeAtSequenceNumber,
+			StartingSequenceNumber: &sequenceNumber,
+		})
+		if err != nil {
+			return errorStatus(err, http.StatusBadRequest), fmt.Sprintf("error getting shard iterator: %q", err)
+		}
+
+		output, err := client.GetRecords(r.Context(), &kinesis.GetRecordsInput{
+			StreamARN:     &cred.ARN,
+			ShardIterator: iterator.ShardIterator,
+			Limit:         aws.Int32(1),
+		})
+		switch {
+		case err != nil:
+			return errorStatus(err, http.StatusBadRequest), fmt.Sprintf("error getting records: %q", err)
+		case len(output.Records) == 0:
+			return http.StatusTooEarly, "no records received"
+		}
+
+		data := string(output.Records[0].Data)
+		log.Printf("Record %q received.\n", data)
+		return http.StatusOK, data
+	}
+}
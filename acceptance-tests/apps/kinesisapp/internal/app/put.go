@@ -0,0 +1,55 @@
+package app
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"kinesisapp/internal/credentials"
+
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+)
+
+func handlePut(creds credentials.Credentials) func(r *http.Request) (int, string) {
+	return func(r *http.Request) (int, string) {
+		binding := r.PathValue("binding_name")
+		log.Printf("Handling put on binding %q\n", binding)
+
+		cred, ok := creds[binding]
+		if !ok {
+			return http.StatusBadRequest, fmt.Sprintf("no creds found for binding: %q", binding)
+		}
+		cfg, err := cred.Config()
+		if err != nil {
+			return http.StatusInternalServerError, fmt.Sprintf("could not read AWS config: %q", err)
+		}
+
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			return http.StatusBadRequest, fmt.Sprintf("could not read body: %q", err)
+		}
+		defer r.Body.Close()
+
+		partitionKey := r.URL.Query().Get("partitionKey")
+		if partitionKey == "" {
+			partitionKey = binding
+		}
+
+		output, err := kinesis.NewFromConfig(cfg).PutRecord(r.Context(), &kinesis.PutRecordInput{
+			StreamARN:    &cred.ARN,
+			Data:         data,
+			PartitionKey: &partitionKey,
+		})
+		if err != nil {
+			return errorStatus(err, http.StatusBadRequest), fmt.Sprintf("error putting record: %q", err)
+		}
+
+		shardID := aws.ToString(output.ShardId)
+		sequenceNumber := aws.ToString(output.SequenceNumber)
+		log.Printf("put record %q in shard %q\n", sequenceNumber, shardID)
+		return http.StatusOK, fmt.Sprintf(`{"shard_id":"%s","sequence_number":"%s"}`, shardID, sequenceNumber)
+	}
+}
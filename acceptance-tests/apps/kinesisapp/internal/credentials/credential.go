@@ -0,0 +1,75 @@
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+type Credential struct {
+	AccessKeyID     string `mapstructure:"access_key_id" binding:"key"`
+	SecretAccessKey string `mapstructure:"secret_access_key" binding:"key"`
+	RoleARN         string `mapstructure:"role_arn" binding:"role"`
+	ExternalID      string `mapstructure:"external_id" binding:"role"`
+	Region          string `mapstructure:"region"`
+	ARN             string `mapstructure:"arn"`
+	Name            string `mapstructure:"stream_name"`
+}
+
+func (c Credential) Config() (aws.Config, error) {
+	if c.RoleARN != "" {
+		return c.assumeRoleConfig()
+	}
+
+	return config.LoadDefaultConfig(
+		context.Background(),
+		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(c.AccessKeyID, c.SecretAccessKey, "")),
+		config.WithRegion(c.Region),
+	)
+}
+
+// assumeRoleConfig is the configuration of a binding with a role. The credentials that the platform
+// gives the app can only assume the role together with the external ID of the binding.
+func (c Credential) assumeRoleConfig() (aws.Config, error) {
+	cfg, err := config.LoadDefaultConfig(context.Background(), config.WithRegion(c.Region))
+	if err != nil {
+		return aws.Config{}, err
+	}
+
+	provider := stscreds.NewAssumeRoleProvider(sts.NewFromConfig(cfg), c.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+		o.ExternalID = aws.String(c.ExternalID)
+	})
+	cfg.Credentials = aws.NewCredentialsCache(provider)
+	return cfg, nil
+}
+
+// validate checks every field in the binding that is expected to have a value. Bindings
+// have either an access key or a role, so the fields of the other kind are skipped.
+func (c Credential) validate() error {
+	skip := "role"
+	if c.RoleARN != "" {
+		skip = "key"
+	}
+
+	var invalid []string
+	v := reflect.ValueOf(c)
+	t := v.Type()
+	for i := range t.NumField() {
+		if t.Field(i).Tag.Get("binding") != skip && v.Field(i).String() == "" {
+			invalid = append(invalid, t.Field(i).Name)
+		}
+	}
+
+	if len(invalid) > 0 {
+		return fmt.Errorf("parsed credentials are not valid, missing: %s", strings.Join(invalid, ", "))
+	}
+
+	return nil
+}
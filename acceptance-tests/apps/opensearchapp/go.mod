@@ -0,0 +1,10 @@
+module opensearchapp
+
+go 1.26.4
+
+require (
+	github.com/cloudfoundry-community/go-cfenv v1.24.1
+	github.com/mitchellh/mapstructure v1.5.0
+)
+
+require github.com/go-viper/mapstructure/v2 v2.5.0 // indirect
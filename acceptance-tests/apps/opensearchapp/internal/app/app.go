@@ -0,0 +1,32 @@
+package app
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"opensearchapp/internal/credentials"
+)
+
+func App(creds credentials.Credentials) http.Handler {
+	c := client{creds: creds, http: &http.Client{Timeout: time.Minute}}
+	r := http.NewServeMux()
+
+	r.HandleFunc("GET /", aliveness)
+	r.HandleFunc("PUT /documents/{index}/{id}", handleStore(c))
+	r.HandleFunc("GET /documents/{index}/{id}", handleFetch(c))
+
+	return r
+}
+
+func aliveness(w http.ResponseWriter, r *http.Request) {
+	log.Printf("Handled aliveness test.")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func fail(w http.ResponseWriter, code int, format string, a ...any) {
+	msg := fmt.Sprintf(format, a...)
+	log.Println(msg)
+	http.Error(w, msg, code)
+}
@@ -0,0 +1,81 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"opensearchapp/internal/credentials"
+)
+
+// client sends requests to the REST API of the domain, authenticating as the user of the binding
+type client struct {
+	creds credentials.Credentials
+	http  *http.Client
+}
+
+// requestError keeps the status of a failed request, so that a binding without the required
+// permissions can be distinguished in tests
+type requestError struct {
+	status int
+	body   string
+}
+
+func (e requestError) Error() string {
+	return fmt.Sprintf("request failed with status %d: %s", e.status, e.body)
+}
+
+func (c client) do(ctx context.Context, method, path string, query url.Values, body, result any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	target, err := url.Parse(c.creds.Endpoint)
+	if err != nil {
+		return err
+	}
+	target = target.JoinPath(path)
+	target.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), reader)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.creds.Username, c.creds.Password)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return requestError{status: resp.StatusCode, body: string(data)}
+	}
+	if result != nil {
+		return json.Unmarshal(data, result)
+	}
+	return nil
+}
+
+// errorStatus passes on the status of the domain for failed requests, such as 403 for a read-only binding
+func errorStatus(err error) int {
+	if reqErr, ok := err.(requestError); ok {
+		return reqErr.status
+	}
+	return http.StatusFailedDependency
+}
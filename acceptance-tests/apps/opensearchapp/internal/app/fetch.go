@@ -0,0 +1,37 @@
+package app
+
+import (
+	"log"
+	"net/http"
+)
+
+func handleFetch(c client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log.Println("Handling fetch.")
+
+		index, id := r.PathValue("index"), r.PathValue("id")
+		if index == "" || id == "" {
+			fail(w, http.StatusBadRequest, "url parameters 'index' and 'id' are required")
+			return
+		}
+
+		var document struct {
+			Source struct {
+				Value string `json:"value"`
+			} `json:"_source"`
+		}
+		if err := c.do(r.Context(), http.MethodGet, index+"/_doc/"+id, nil, nil, &document); err != nil {
+			fail(w, errorStatus(err), "failed to fetch document: %s", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(document.Source.Value)); err != nil {
+			log.Printf("Error writing value: %s", err)
+			return
+		}
+
+		log.Printf("Document %q fetched from index %q with value %q.", id, index, document.Source.Value)
+	}
+}
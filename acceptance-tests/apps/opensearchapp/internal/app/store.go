@@ -0,0 +1,36 @@
+package app
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+)
+
+func handleStore(c client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log.Println("Handling store.")
+
+		index, id := r.PathValue("index"), r.PathValue("id")
+		if index == "" || id == "" {
+			fail(w, http.StatusBadRequest, "url parameters 'index' and 'id' are required")
+			return
+		}
+
+		rawValue, err := io.ReadAll(r.Body)
+		if err != nil {
+			fail(w, http.StatusBadRequest, "error parsing value from body: %s", err)
+			return
+		}
+
+		value := string(rawValue)
+		// The index is refreshed so that the document can be fetched straight away
+		if err := c.do(r.Context(), http.MethodPut, index+"/_doc/"+id, url.Values{"refresh": {"true"}}, map[string]string{"value": value}, nil); err != nil {
+			fail(w, errorStatus(err), "failed to store document: %s", err)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		log.Printf("Document %q stored in index %q with value %q.", id, index, value)
+	}
+}
@@ -0,0 +1,36 @@
+package credentials
+
+import (
+	"fmt"
+
+	"github.com/cloudfoundry-community/go-cfenv"
+	"github.com/mitchellh/mapstructure"
+)
+
+type Credentials struct {
+	Endpoint string `mapstructure:"endpoint"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+}
+
+func Read() (Credentials, error) {
+	app, err := cfenv.Current()
+	if err != nil {
+		return Credentials{}, fmt.Errorf("error reading app env: %w", err)
+	}
+	svs, err := app.Services.WithTag("opensearch")
+	if err != nil {
+		return Credentials{}, fmt.Errorf("error reading OpenSearch service details")
+	}
+
+	var r Credentials
+	if err := mapstructure.Decode(svs[0].Credentials, &r); err != nil {
+		return Credentials{}, fmt.Errorf("failed to decode credentials: %w", err)
+	}
+
+	if r.Endpoint == "" || r.Username == "" || r.Password == "" {
+		return Credentials{}, fmt.Errorf("parsed credentials are not valid")
+	}
+
+	return r, nil
+}
@@ -51,7 +51,7 @@ func handleGet(creds credentials.Credentials, primary bool) http.HandlerFunc {
 func client(creds credentials.Credentials, primary bool) (*redis.Client, error) {
 	switch primary {
 	case primaryNode:
-		return creds.Client(), nil
+		return creds.Client()
 	default:
 		return creds.ReaderClient()
 	}
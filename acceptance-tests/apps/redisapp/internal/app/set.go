@@ -23,8 +23,14 @@ func handleSet(creds credentials.Credentials) http.HandlerFunc {
 			return
 		}
 
+		c, err := creds.Client()
+		if err != nil {
+			fail(w, http.StatusFailedDependency, "could not create client: %s", err)
+			return
+		}
+
 		value := string(rawValue)
-		if err := creds.Client().Set(r.Context(), key, value, 0).Err(); err != nil {
+		if err := c.Set(r.Context(), key, value, 0).Err(); err != nil {
 			fail(w, http.StatusFailedDependency, "failed to set value in Redis: %s", err)
 			return
 		}
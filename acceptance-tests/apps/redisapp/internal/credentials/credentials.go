@@ -12,8 +12,12 @@ import (
 type Credentials struct {
 	Host           string `mapstructure:"host"`
 	ReaderEndpoint string `mapstructure:"reader_endpoint"`
+	Username       string `mapstructure:"username"`
 	Password       string `mapstructure:"password"`
 	TLSPort        int    `mapstructure:"tls_port"`
+	URI            string `mapstructure:"uri"`
+	ReaderURI      string `mapstructure:"reader_uri"`
+	TLS            bool   `mapstructure:"tls"`
 }
 
 func Read() (Credentials, error) {
@@ -31,29 +35,67 @@ func Read() (Credentials, error) {
 		return Credentials{}, fmt.Errorf("failed to decode credentials: %w", err)
 	}
 
-	if r.Host == "" || r.Password == "" || r.TLSPort == 0 {
+	// Bindings created before the URI was added only have the individual fields
+	if r.URI == "" && (r.Host == "" || r.Password == "" || r.TLSPort == 0) {
 		return Credentials{}, fmt.Errorf("parsed credentials are not valid")
 	}
+	if _, err := r.Options(); err != nil {
+		return Credentials{}, err
+	}
 
 	return r, nil
 }
 
-func (c Credentials) Client() *redis.Client {
-	return c.client(c.Host)
+// Options are parsed from the URI when present, which sets up TLS for the "rediss" scheme
+func (c Credentials) Options() (*redis.Options, error) {
+	if c.URI != "" {
+		return parseURI(c.URI)
+	}
+	return c.options(c.Host), nil
 }
 
-func (c Credentials) ReaderClient() (*redis.Client, error) {
-	if c.ReaderEndpoint == "" {
+func (c Credentials) ReaderOptions() (*redis.Options, error) {
+	switch {
+	case c.ReaderURI != "":
+		return parseURI(c.ReaderURI)
+	case c.ReaderEndpoint != "":
+		return c.options(c.ReaderEndpoint), nil
+	default:
 		return nil, fmt.Errorf("no reader endpoint in the credentials")
 	}
-	return c.client(c.ReaderEndpoint), nil
 }
 
-func (c Credentials) client(endpoint string) *redis.Client {
-	return redis.NewClient(&redis.Options{
+func (c Credentials) Client() (*redis.Client, error) {
+	opts, err := c.Options()
+	if err != nil {
+		return nil, err
+	}
+	return redis.NewClient(opts), nil
+}
+
+func (c Credentials) ReaderClient() (*redis.Client, error) {
+	opts, err := c.ReaderOptions()
+	if err != nil {
+		return nil, err
+	}
+	return redis.NewClient(opts), nil
+}
+
+func (c Credentials) options(endpoint string) *redis.Options {
+	return &redis.Options{
 		Addr:      fmt.Sprintf("%s:%d", endpoint, c.TLSPort),
+		Username:  c.Username,
 		Password:  c.Password,
 		DB:        0,
 		TLSConfig: &tls.Config{},
-	})
+	}
+}
+
+func parseURI(uri string) (*redis.Options, error) {
+	opts, err := redis.ParseURL(uri)
+	if err != nil {
+		// The error is returned without the URI as it contains the password
+		return nil, fmt.Errorf("failed to parse Redis URI from the credentials")
+	}
+	return opts, nil
 }
@@ -0,0 +1,170 @@
+package credentials_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/redis/go-redis/v9"
+
+	"redisapp/internal/credentials"
+)
+
+var _ = Describe("Credentials", func() {
+	const (
+		username = "csb-binding-user"
+		password = "a&password#with$special^characters"
+	)
+
+	var (
+		server *miniredis.Miniredis
+		roots  *x509.CertPool
+	)
+
+	BeforeEach(func() {
+		var cert tls.Certificate
+		cert, roots = selfSignedCertificate()
+
+		server = miniredis.NewMiniRedis()
+		Expect(server.StartTLS(&tls.Config{Certificates: []tls.Certificate{cert}})).To(Succeed())
+		DeferCleanup(server.Close)
+	})
+
+	uri := func(user string) string {
+		return fmt.Sprintf("rediss://%s:%s@%s", url.QueryEscape(user), url.QueryEscape(password), server.Addr())
+	}
+
+	connect := func(opts *redis.Options) {
+		GinkgoHelper()
+		Expect(opts.TLSConfig).NotTo(BeNil(), "TLS is not configured")
+		opts.TLSConfig.RootCAs = roots
+
+		client := redis.NewClient(opts)
+		defer client.Close()
+		Expect(client.Set(context.TODO(), "key", "value", 0).Err()).To(Succeed())
+		Expect(client.Get(context.TODO(), "key").Val()).To(Equal("value"))
+	}
+
+	It("connects as the binding user through the URI", func() {
+		server.RequireUserAuth(username, password)
+		setBinding(map[string]any{
+			"uri":        uri(username),
+			"reader_uri": uri(username),
+			"username":   username,
+			"password":   password,
+			"tls":        true,
+		})
+
+		creds, err := credentials.Read()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(creds.TLS).To(BeTrue())
+
+		opts, err := creds.Options()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(opts.Username).To(Equal(username))
+		Expect(opts.Password).To(Equal(password))
+		connect(opts)
+
+		readerOpts, err := creds.ReaderOptions()
+		Expect(err).NotTo(HaveOccurred())
+		connect(readerOpts)
+	})
+
+	It("connects with the password only when the binding has no user", func() {
+		server.RequireAuth(password)
+		setBinding(map[string]any{"uri": uri(""), "password": password, "tls": true})
+
+		creds, err := credentials.Read()
+		Expect(err).NotTo(HaveOccurred())
+
+		opts, err := creds.Options()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(opts.Username).To(BeEmpty())
+		connect(opts)
+	})
+
+	It("falls back to the individual fields for bindings without a URI", func() {
+		server.RequireAuth(password)
+		port, err := strconv.Atoi(server.Port())
+		Expect(err).NotTo(HaveOccurred())
+		setBinding(map[string]any{"host": server.Host(), "tls_port": port, "password": password})
+
+		creds, err := credentials.Read()
+		Expect(err).NotTo(HaveOccurred())
+
+		opts, err := creds.Options()
+		Expect(err).NotTo(HaveOccurred())
+		opts.TLSConfig.ServerName = server.Host()
+		connect(opts)
+
+		_, err = creds.ReaderOptions()
+		Expect(err).To(MatchError("no reader endpoint in the credentials"))
+	})
+
+	It("rejects a URI that does not parse without revealing it", func() {
+		setBinding(map[string]any{"uri": "http://user:" + password + "@example.com"})
+
+		_, err := credentials.Read()
+		Expect(err).To(MatchError("failed to parse Redis URI from the credentials"))
+	})
+})
+
+// setBinding sets up the environment of an app bound to a Redis service with the given credentials
+func setBinding(creds map[string]any) {
+	GinkgoHelper()
+
+	services, err := json.Marshal(map[string]any{
+		"csb-aws-redis": []map[string]any{{
+			"name":        "redis",
+			"label":       "csb-aws-redis",
+			"tags":        []string{"redis"},
+			"credentials": creds,
+		}},
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	GinkgoT().Setenv("VCAP_APPLICATION", `{"application_id":"redisapp","name":"redisapp"}`)
+	GinkgoT().Setenv("VCAP_SERVICES", string(services))
+}
+
+func selfSignedCertificate() (tls.Certificate, *x509.CertPool) {
+	GinkgoHelper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "redis"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).NotTo(HaveOccurred())
+
+	leaf, err := x509.ParseCertificate(der)
+	Expect(err).NotTo(HaveOccurred())
+	roots := x509.NewCertPool()
+	roots.AddCert(leaf)
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}, roots
+}
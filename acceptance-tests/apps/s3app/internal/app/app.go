@@ -1,26 +1,31 @@
 package app
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"strings"
 
 	"s3app/internal/credentials"
+
+	"github.com/aws/smithy-go"
 )
 
 func App(client *credentials.Client) http.HandlerFunc {
 
 	return func(w http.ResponseWriter, r *http.Request) {
 		filename := strings.Trim(r.URL.Path, "/")
-		switch r.Method {
-		case http.MethodHead:
+		switch {
+		case r.Method == http.MethodHead:
 			aliveness(w, r)
-		case http.MethodPut:
+		case r.Method == http.MethodPut:
 			handleUpload(w, r, filename, client)
-		case http.MethodGet:
+		case r.Method == http.MethodGet && filename == "":
+			handleList(w, r, client)
+		case r.Method == http.MethodGet:
 			handleDownload(w, r, filename, client)
-		case http.MethodDelete:
+		case r.Method == http.MethodDelete:
 			handleDelete(w, r, filename, client)
 		default:
 			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
@@ -38,3 +43,13 @@ func fail(w http.ResponseWriter, code int, format string, a ...any) {
 	log.Println(msg)
 	http.Error(w, msg, code)
 }
+
+// awsErrorStatus reports requests that the binding is not permitted to make as forbidden,
+// so that tests can tell them apart from other failures
+func awsErrorStatus(err error) int {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) && apiErr.ErrorCode() == "AccessDenied" {
+		return http.StatusForbidden
+	}
+	return http.StatusFailedDependency
+}
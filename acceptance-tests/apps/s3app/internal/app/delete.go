@@ -18,7 +18,7 @@ func handleDelete(w http.ResponseWriter, r *http.Request, filename string, clien
 		Key:    aws.String(filename),
 	}
 	if _, err := client.S3Client.DeleteObject(r.Context(), &input); err != nil {
-		fail(w, http.StatusFailedDependency, "Error deleting file %q: %s", filename, err)
+		fail(w, awsErrorStatus(err), "Error deleting file %q: %s", filename, err)
 		return
 	}
 
@@ -19,7 +19,7 @@ func handleDownload(w http.ResponseWriter, r *http.Request, filename string, cli
 		Key:    aws.String(filename),
 	})
 	if err != nil {
-		fail(w, http.StatusFailedDependency, "Error downloading file %q from bucket %q: %s", filename, client.Credentials.BucketName, err)
+		fail(w, awsErrorStatus(err), "Error downloading file %q from bucket %q: %s", filename, client.Credentials.BucketName, err)
 		return
 	}
 
@@ -0,0 +1,40 @@
+package app
+
+import (
+	"log"
+	"net/http"
+	"strings"
+
+	"s3app/internal/credentials"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+func handleList(w http.ResponseWriter, r *http.Request, client *credentials.Client) {
+	log.Println("Handling list.")
+
+	prefix := r.URL.Query().Get("prefix")
+	output, err := client.S3Client.ListObjectsV2(r.Context(), &s3.ListObjectsV2Input{
+		Bucket: aws.String(client.Credentials.BucketName),
+		Prefix: aws.String(prefix),
+	})
+	if err != nil {
+		fail(w, awsErrorStatus(err), "Error listing files with prefix %q in bucket %q: %s", prefix, client.Credentials.BucketName, err)
+		return
+	}
+
+	var keys []string
+	for _, object := range output.Contents {
+		keys = append(keys, aws.ToString(object.Key))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Header().Set("Content-Type", "text/plain")
+	if _, err := w.Write([]byte(strings.Join(keys, "\n"))); err != nil {
+		log.Printf("Error writing value: %s", err)
+		return
+	}
+
+	log.Printf("Listed %d files with prefix %q.", len(keys), prefix)
+}
@@ -20,7 +20,7 @@ func handleUpload(w http.ResponseWriter, r *http.Request, filename string, clien
 		ContentLength: &r.ContentLength,
 	})
 	if err != nil {
-		fail(w, http.StatusFailedDependency, "Error uploading file part %q: %s", filename, err)
+		fail(w, awsErrorStatus(err), "Error uploading file part %q: %s", filename, err)
 		return
 	}
 
@@ -7,7 +7,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/cloudfoundry-community/go-cfenv"
 	"github.com/mitchellh/mapstructure"
 )
@@ -22,22 +24,11 @@ func NewClient() *Client {
 	if err != nil {
 		panic(err)
 	}
-	cfg, err := config.LoadDefaultConfig(
-		context.Background(),
-		config.WithCredentialsProvider(
-			aws.NewCredentialsCache(
-				credentials.NewStaticCredentialsProvider(
-					creds.AccessKeyId,
-					creds.AccessKeySecret,
-					"",
-				),
-			),
-		),
-		config.WithRegion(creds.Region),
-	)
+	cfg, err := config.LoadDefaultConfig(context.Background(), config.WithRegion(creds.Region))
 	if err != nil {
 		panic(err)
 	}
+	cfg.Credentials = aws.NewCredentialsCache(creds.credentialsProvider(cfg))
 
 	client := s3.NewFromConfig(cfg)
 	c := Client{
@@ -50,6 +41,8 @@ func NewClient() *Client {
 type S3Service struct {
 	AccessKeyId      string `mapstructure:"access_key_id"`
 	AccessKeySecret  string `mapstructure:"secret_access_key"`
+	RoleARN          string `mapstructure:"role_arn"`
+	ExternalID       string `mapstructure:"external_id"`
 	Region           string `mapstructure:"region"`
 	BucketDomainName string `mapstructure:"bucket_domain_name"`
 	BucketName       string `mapstructure:"bucket_name"`
@@ -135,11 +128,26 @@ func ReadLegacyS3(svs cfenv.Service) (S3Service, error) {
 	}, nil
 }
 
+// credentialsProvider returns the access key of the binding. A binding with a role has no access key:
+// the app assumes the role with the credentials that cfg loaded from the platform, and the external ID of
+// the binding proves that the app is bound to the bucket.
+func (s S3Service) credentialsProvider(cfg aws.Config) aws.CredentialsProvider {
+	if s.RoleARN == "" {
+		return credentials.NewStaticCredentialsProvider(s.AccessKeyId, s.AccessKeySecret, "")
+	}
+
+	return stscreds.NewAssumeRoleProvider(sts.NewFromConfig(cfg), s.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+		o.ExternalID = aws.String(s.ExternalID)
+	})
+}
+
 func (s S3Service) Valid() error {
 	switch {
-	case s.AccessKeyId == "":
+	case s.RoleARN != "" && s.ExternalID == "":
+		return fmt.Errorf("missing external id")
+	case s.RoleARN == "" && s.AccessKeyId == "":
 		return fmt.Errorf("missing access key id")
-	case s.AccessKeySecret == "":
+	case s.RoleARN == "" && s.AccessKeySecret == "":
 		return fmt.Errorf("missing access key secret")
 	case s.Region == "":
 		return fmt.Errorf("missing region")
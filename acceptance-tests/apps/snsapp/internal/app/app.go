@@ -0,0 +1,55 @@
+// Package app provides functionality for publishing messages to an SNS topic.
+package app
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"snsapp/internal/credentials"
+
+	"github.com/aws/smithy-go"
+)
+
+func App(creds credentials.Credentials) http.Handler {
+	r := http.NewServeMux()
+
+	r.HandleFunc("GET /", aliveness)
+	r.HandleFunc("POST /publish/{binding_name}", writeResponse(handlePublish(creds)))
+
+	return r
+}
+
+func aliveness(w http.ResponseWriter, r *http.Request) {
+	log.Printf("Handled aliveness test.")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeResponse allows handler functions to simply return an HTTP code and a message
+// avoiding repeated boilerplate code for dealing with the http.ResponseWriter
+func writeResponse(h func(r *http.Request) (int, string)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		code, msg := h(r)
+		switch code {
+		case http.StatusOK:
+			w.WriteHeader(code)
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(msg))
+		default:
+			http.Error(w, msg, code)
+		}
+	}
+}
+
+// errorStatus reports requests that the binding is not permitted to make as forbidden,
+// so that tests can tell them apart from other failures
+func errorStatus(err error, otherwise int) int {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.ErrorCode() {
+		case "AuthorizationError", "AccessDenied", "AccessDeniedException":
+			return http.StatusForbidden
+		}
+	}
+	return otherwise
+}
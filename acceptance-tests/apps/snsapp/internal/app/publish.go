@@ -0,0 +1,55 @@
+package app
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"snsapp/internal/credentials"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+)
+
+func handlePublish(creds credentials.Credentials) func(r *http.Request) (int, string) {
+	return func(r *http.Request) (int, string) {
+		binding := r.PathValue("binding_name")
+		log.Printf("Handling publish on binding %q\n", binding)
+
+		cred, ok := creds[binding]
+		if !ok {
+			return http.StatusBadRequest, fmt.Sprintf("no creds found for binding: %q", binding)
+		}
+		cfg, err := cred.Config()
+		if err != nil {
+			return http.StatusInternalServerError, fmt.Sprintf("could not read AWS config: %q", err)
+		}
+
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			return http.StatusBadRequest, fmt.Sprintf("could not read body: %q", err)
+		}
+		defer r.Body.Close()
+
+		publishInput := sns.PublishInput{
+			Message:  aws.String(string(data)),
+			TopicArn: aws.String(cred.ARN),
+		}
+		if messageGroupID := r.URL.Query().Get("messageGroupId"); messageGroupID != "" {
+			publishInput.MessageGroupId = aws.String(messageGroupID)
+		}
+		if messageDeduplicationID := r.URL.Query().Get("messageDeduplicationId"); messageDeduplicationID != "" {
+			publishInput.MessageDeduplicationId = aws.String(messageDeduplicationID)
+		}
+
+		output, err := sns.NewFromConfig(cfg).Publish(r.Context(), &publishInput)
+		if err != nil {
+			return errorStatus(err, http.StatusBadRequest), fmt.Sprintf("error publishing message: %q", err)
+		}
+
+		id := aws.ToString(output.MessageId)
+		log.Printf("published message ID: %q\n", id)
+		return http.StatusOK, fmt.Sprintf(`{"id":"%s"}`, id)
+	}
+}
@@ -2,10 +2,13 @@
 package app
 
 import (
+	"errors"
 	"log"
 	"net/http"
 
 	"sqsapp/internal/credentials"
+
+	"github.com/aws/smithy-go"
 )
 
 func App(creds credentials.Credentials) http.Handler {
@@ -42,3 +45,16 @@ func writeResponse(h func(r *http.Request) (int, string)) http.HandlerFunc {
 		}
 	}
 }
+
+// errorStatus reports requests that the binding is not permitted to make as forbidden,
+// so that tests can tell them apart from other failures
+func errorStatus(err error, otherwise int) int {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.ErrorCode() {
+		case "AccessDenied", "AccessDeniedException":
+			return http.StatusForbidden
+		}
+	}
+	return otherwise
+}
@@ -37,7 +37,7 @@ func handleRedrive(creds credentials.Credentials) func(r *http.Request) (int, st
 			DestinationArn: &cred.ARN,
 		})
 		if err != nil {
-			return http.StatusBadRequest, fmt.Sprintf("error starting message move task: %q", err)
+			return errorStatus(err, http.StatusBadRequest), fmt.Sprintf("error starting message move task: %q", err)
 		}
 
 		id := aws.ToString(output.TaskHandle)
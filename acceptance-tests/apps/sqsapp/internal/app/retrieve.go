@@ -35,7 +35,7 @@ func handleRetrieve(creds credentials.Credentials) func(r *http.Request) (int, s
 		})
 		switch {
 		case err != nil:
-			return http.StatusBadRequest, fmt.Sprintf("error receiving message: %q", err)
+			return errorStatus(err, http.StatusBadRequest), fmt.Sprintf("error receiving message: %q", err)
 		case len(output.Messages) == 0:
 			return http.StatusTooEarly, "no messages received"
 		}
@@ -34,7 +34,7 @@ func handleRetrieveAndDelete(creds credentials.Credentials) func(r *http.Request
 		})
 		switch {
 		case err != nil:
-			return http.StatusBadRequest, fmt.Sprintf("error receiving message: %q", err)
+			return errorStatus(err, http.StatusBadRequest), fmt.Sprintf("error receiving message: %q", err)
 		case len(output.Messages) == 0:
 			return http.StatusTooEarly, "no messages received"
 		}
@@ -45,7 +45,7 @@ func handleRetrieveAndDelete(creds credentials.Credentials) func(r *http.Request
 			ReceiptHandle: message.ReceiptHandle,
 		})
 		if err != nil {
-			return http.StatusNotAcceptable, fmt.Sprintf("failed to delete message: %q", err)
+			return errorStatus(err, http.StatusNotAcceptable), fmt.Sprintf("failed to delete message: %q", err)
 		}
 
 		log.Printf("Message %q received.\n", aws.ToString(message.Body))
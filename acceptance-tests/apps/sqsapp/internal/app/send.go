@@ -48,7 +48,7 @@ func handleSend(creds credentials.Credentials) func(r *http.Request) (int, strin
 
 		output, err := sqs.NewFromConfig(cfg).SendMessage(r.Context(), &sendMessageInput)
 		if err != nil {
-			return http.StatusBadRequest, fmt.Sprintf("error sending message: %q", err)
+			return errorStatus(err, http.StatusBadRequest), fmt.Sprintf("error sending message: %q", err)
 		}
 
 		id := aws.ToString(output.MessageId)
@@ -9,32 +9,55 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 )
 
 type Credential struct {
-	AccessKeyID     string `mapstructure:"access_key_id"`
-	SecretAccessKey string `mapstructure:"secret_access_key"`
+	AccessKeyID     string `mapstructure:"access_key_id" binding:"key"`
+	SecretAccessKey string `mapstructure:"secret_access_key" binding:"key"`
+	RoleARN         string `mapstructure:"role_arn" binding:"role"`
+	ExternalID      string `mapstructure:"external_id" binding:"role"`
 	Region          string `mapstructure:"region"`
 	ARN             string `mapstructure:"arn"`
 	URL             string `mapstructure:"queue_url"`
 	Name            string `mapstructure:"queue_name"`
 }
 
+// Config returns the AWS configuration for the queue of the binding. When the binding is a role,
+// the identity that the platform gives the app assumes it, presenting the external ID of the binding.
 func (c Credential) Config() (aws.Config, error) {
-	return config.LoadDefaultConfig(
-		context.Background(),
-		config.WithCredentialsProvider(aws.NewCredentialsCache(credentials.NewStaticCredentialsProvider(c.AccessKeyID, c.SecretAccessKey, ""))),
-		config.WithRegion(c.Region),
-	)
+	cfg, err := config.LoadDefaultConfig(context.Background(), config.WithRegion(c.Region))
+	if err != nil {
+		return aws.Config{}, err
+	}
+
+	switch c.RoleARN {
+	case "":
+		cfg.Credentials = credentials.NewStaticCredentialsProvider(c.AccessKeyID, c.SecretAccessKey, "")
+	default:
+		assumeRole := stscreds.NewAssumeRoleProvider(sts.NewFromConfig(cfg), c.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+			o.ExternalID = aws.String(c.ExternalID)
+		})
+		cfg.Credentials = aws.NewCredentialsCache(assumeRole)
+	}
+
+	return cfg, nil
 }
 
-// validate checks every field in the binding that is expected to have a value
+// validate checks every field in the binding that is expected to have a value. Bindings
+// have either an access key or a role, so the fields of the other kind are skipped.
 func (c Credential) validate() error {
+	skip := "role"
+	if c.RoleARN != "" {
+		skip = "key"
+	}
+
 	var invalid []string
 	v := reflect.ValueOf(c)
 	t := v.Type()
 	for i := range t.NumField() {
-		if v.Field(i).String() == "" {
+		if t.Field(i).Tag.Get("binding") != skip && v.Field(i).String() == "" {
 			invalid = append(invalid, t.Field(i).Name)
 		}
 	}
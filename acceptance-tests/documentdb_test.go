@@ -0,0 +1,53 @@
+package acceptance_tests_test
+
+import (
+	"net/http"
+
+	"csbbrokerpakaws/acceptance-tests/helpers/apps"
+	"csbbrokerpakaws/acceptance-tests/helpers/random"
+	"csbbrokerpakaws/acceptance-tests/helpers/services"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DocumentDB", Label("documentdb"), func() {
+	It("can be accessed by an app", func() {
+		By("creating a service instance")
+		params := map[string]any{
+			"cluster_instances": 1,
+			"instance_class":    "db.t3.medium",
+			"engine_version":    "5.0.0",
+		}
+		serviceInstance := services.CreateInstance("csb-aws-documentdb", services.WithPlan("default"), services.WithParameters(params))
+		defer serviceInstance.Delete()
+
+		By("pushing the unstarted apps")
+		writerApp := apps.Push(apps.WithApp(apps.DocumentDB))
+		readerApp := apps.Push(apps.WithApp(apps.DocumentDB))
+		defer apps.Delete(writerApp, readerApp)
+
+		By("binding the apps to the service instance, the second one as read only")
+		binding := serviceInstance.Bind(writerApp)
+		serviceInstance.Bind(readerApp, services.WithBindParameters(map[string]any{"read_only": true}))
+
+		By("starting the apps")
+		apps.Start(writerApp, readerApp)
+
+		By("checking that the app environment has a credhub reference for credentials")
+		Expect(binding.Credential()).To(HaveKey("credhub-ref"))
+
+		By("storing a document using the first app")
+		id := random.Hexadecimal()
+		value := random.Hexadecimal()
+		writerApp.PUTf(value, "/documents/%s", id)
+
+		By("fetching the document using the second app")
+		got := readerApp.GETf("/documents/%s", id).String()
+		Expect(got).To(Equal(value))
+
+		By("checking that the second app cannot store documents")
+		response := readerApp.PUTResponsef(random.Hexadecimal(), "/documents/%s", id)
+		Expect(response).To(HaveHTTPStatus(http.StatusForbidden))
+	})
+})
@@ -83,4 +83,79 @@ var _ = Describe("DynamoDB Namespace", Label("dynamodb-namespace"), func() {
 			g.Expect(getResponse).To(HaveHTTPStatus(http.StatusNotFound))
 		}).WithTimeout(5 * time.Minute).WithPolling(time.Second).Should(Succeed())
 	})
+
+	It("restricts bindings to their access level and tables", func() {
+		By("creating a service instance")
+		serviceInstance := services.CreateInstance(
+			"csb-aws-dynamodb-namespace",
+			services.WithPlan("default"),
+		)
+		defer serviceInstance.Delete()
+
+		By("pushing the unstarted apps")
+		adminApp := apps.Push(apps.WithApp(apps.DynamoDBNamespace))
+		writerApp := apps.Push(apps.WithApp(apps.DynamoDBNamespace))
+		readerApp := apps.Push(apps.WithApp(apps.DynamoDBNamespace))
+		defer apps.Delete(adminApp, writerApp, readerApp)
+
+		By("binding the apps with different access levels")
+		serviceInstance.Bind(adminApp)
+		serviceInstance.Bind(writerApp, services.WithBindParameters(map[string]any{"access": "read_write", "table_suffixes": []string{"orders"}}))
+		serviceInstance.Bind(readerApp, services.WithBindParameters(map[string]any{"access": "read_only"}))
+
+		By("starting the apps")
+		apps.Start(adminApp, writerApp, readerApp)
+
+		By("creating tables using the admin app")
+		ordersTable := fmt.Sprintf("csb-%s-orders", serviceInstance.GUID())
+		otherTable := fmt.Sprintf("csb-%s-%s", serviceInstance.GUID(), random.Hexadecimal())
+		adminApp.POST(map[string]string{"table_name": ordersTable}, "/tables")
+		adminApp.POST(map[string]string{"table_name": otherTable}, "/tables")
+		defer adminApp.DELETEf("/tables/%s", otherTable)
+		defer adminApp.DELETEf("/tables/%s", ordersTable)
+
+		By("checking that only the admin app can create tables")
+		Expect(writerApp.POSTResponse(`{"table_name":"`+ordersTable+`-writer"}`, "/tables")).To(HaveHTTPStatus(http.StatusForbidden))
+		Expect(readerApp.POSTResponse(`{"table_name":"`+ordersTable+`-reader"}`, "/tables")).To(HaveHTTPStatus(http.StatusForbidden))
+
+		By("storing a value using the read-write app")
+		valuePayload := random.Name(random.WithPrefix("dynamodb-namespace-value"))
+		valueSortKey := random.Name(random.WithPrefix("sort-key"))
+		var postBody dynamoDBValueResponseType
+		Eventually(func(g Gomega) {
+			postResponse := writerApp.POSTResponsef(valuePayload, "/tables/%s/values/%s", ordersTable, valueSortKey)
+			g.Expect(postResponse).To(HaveHTTPStatus(http.StatusCreated))
+			defer postResponse.Body.Close()
+			apps.NewPayload(postResponse).ParseInto(&postBody)
+		}).WithTimeout(5 * time.Minute).WithPolling(time.Second).Should(Succeed())
+
+		By("checking that the read-write app is restricted to its tables")
+		Eventually(func(g Gomega) {
+			g.Expect(adminApp.GETResponsef("/tables/%s", otherTable)).To(HaveHTTPStatus(http.StatusOK))
+		}).WithTimeout(5 * time.Minute).WithPolling(time.Second).Should(Succeed())
+		Expect(writerApp.POSTResponsef(valuePayload, "/tables/%s/values/%s", otherTable, valueSortKey)).To(HaveHTTPStatus(http.StatusForbidden))
+		Expect(writerApp.GETResponsef("/tables/%s", otherTable)).To(HaveHTTPStatus(http.StatusForbidden))
+
+		By("reading the value using the read-only app")
+		var getBody dynamoDBValueResponseType
+		readerApp.GETf("/tables/%s/values/%s/%d", ordersTable, valueSortKey, postBody.PK).ParseInto(&getBody)
+		Expect(getBody).To(Equal(postBody))
+		var scanBody []dynamoDBValueResponseType
+		readerApp.GETf("/tables/%s/values", ordersTable).ParseInto(&scanBody)
+		Expect(scanBody).To(ContainElement(postBody))
+
+		By("checking that the read-only app cannot write values")
+		Expect(readerApp.POSTResponsef(valuePayload, "/tables/%s/values/%s", ordersTable, random.Hexadecimal())).To(HaveHTTPStatus(http.StatusForbidden))
+		response, err := readerApp.DELETEResponsef("/tables/%s/values/%s/%d", ordersTable, valueSortKey, postBody.PK)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response).To(HaveHTTPStatus(http.StatusForbidden))
+
+		By("checking that only the admin app can delete tables")
+		response, err = writerApp.DELETEResponsef("/tables/%s", ordersTable)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response).To(HaveHTTPStatus(http.StatusForbidden))
+		response, err = readerApp.DELETEResponsef("/tables/%s", ordersTable)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response).To(HaveHTTPStatus(http.StatusForbidden))
+	})
 })
@@ -65,6 +65,25 @@ func (a *App) PUTf(input any, format string, s ...any) {
 	Expect(response).To(HaveHTTPStatus(http.StatusCreated, http.StatusOK))
 }
 
+// PUTResponse does an HTTP put, returning the *http.Response
+func (a *App) PUTResponse(data, path string) *http.Response {
+	return a.PUTResponsef(data, "%s", path)
+}
+
+func (a *App) PUTResponsef(data, format string, s ...any) *http.Response {
+	GinkgoHelper()
+
+	url := a.urlf(format, s...)
+	GinkgoWriter.Printf("HTTP PUT: %s\n", url)
+	GinkgoWriter.Printf("Sending data: %s\n", data)
+	request, err := http.NewRequest(http.MethodPut, url, strings.NewReader(data))
+	Expect(err).NotTo(HaveOccurred())
+	request.Header.Set("Content-Type", "text/html")
+	response, err := http.DefaultClient.Do(request)
+	Expect(err).NotTo(HaveOccurred())
+	return response
+}
+
 func (a *App) POSTResponse(data, path string) *http.Response {
 	return a.POSTResponsef(data, "%s", path)
 }
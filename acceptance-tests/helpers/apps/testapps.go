@@ -14,6 +14,10 @@ const (
 	MSSQL                AppCode = "mssqlapp"
 	DynamoDBNamespace    AppCode = "dynamodbnsapp"
 	SQS                  AppCode = "sqsapp"
+	SNS                  AppCode = "snsapp"
+	DocumentDB           AppCode = "documentdbapp"
+	OpenSearch           AppCode = "opensearchapp"
+	Kinesis              AppCode = "kinesisapp"
 	JDBCTestAppPostgres  AppCode = "jdbctestapp/jdbctestapp-postgres-1.0.0.jar"
 	JDBCTestAppMysql     AppCode = "jdbctestapp/jdbctestapp-mysql-1.0.0.jar"
 	JDBCTestAppSQLServer AppCode = "jdbctestapp/jdbctestapp-sqlserver-1.0.0.jar"
@@ -0,0 +1,61 @@
+package acceptance_tests_test
+
+import (
+	"net/http"
+	"time"
+
+	"csbbrokerpakaws/acceptance-tests/helpers/apps"
+	"csbbrokerpakaws/acceptance-tests/helpers/random"
+	"csbbrokerpakaws/acceptance-tests/helpers/services"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Kinesis", Label("kinesis"), func() {
+	It("puts and gets records in two apps with scoped roles", func() {
+		By("creating a service instance")
+		serviceInstance := services.CreateInstance("csb-aws-kinesis", services.WithPlan("default"))
+		defer serviceInstance.Delete()
+
+		By("pushing the unstarted app twice")
+		producerApp := apps.Push(apps.WithApp(apps.Kinesis))
+		consumerApp := apps.Push(apps.WithApp(apps.Kinesis))
+		defer apps.Delete(producerApp, consumerApp)
+
+		By("binding the apps to the service instance as a producer and a consumer")
+		producerBindingName := random.Name(random.WithPrefix("producer"))
+		binding := serviceInstance.Bind(producerApp, services.WithBindingName(producerBindingName), services.WithBindParameters(map[string]any{"role": "producer"}))
+		consumerBindingName := random.Name(random.WithPrefix("consumer"))
+		serviceInstance.Bind(consumerApp, services.WithBindingName(consumerBindingName), services.WithBindParameters(map[string]any{"role": "consumer"}))
+
+		By("starting the apps")
+		apps.Start(producerApp, consumerApp)
+
+		By("checking that the app environment has a credhub reference for credentials")
+		Expect(binding.Credential()).To(HaveKey("credhub-ref"))
+
+		By("putting a record using the producer app")
+		data := random.Hexadecimal()
+		var record struct {
+			ShardID        string `json:"shard_id"`
+			SequenceNumber string `json:"sequence_number"`
+		}
+		producerApp.POSTf(data, "/put/%s", producerBindingName).ParseInto(&record)
+
+		By("getting the record using the consumer app")
+		Eventually(func(g Gomega) {
+			response := consumerApp.GETResponsef("/get/%s?shard_id=%s&sequence_number=%s", consumerBindingName, record.ShardID, record.SequenceNumber)
+			g.Expect(response).To(HaveHTTPStatus(http.StatusOK))
+			g.Expect(response).To(HaveHTTPBody(data))
+		}).WithTimeout(time.Minute).WithPolling(time.Second).Should(Succeed())
+
+		By("checking that the producer app cannot get records")
+		response := producerApp.GETResponsef("/get/%s?shard_id=%s&sequence_number=%s", producerBindingName, record.ShardID, record.SequenceNumber)
+		Expect(response).To(HaveHTTPStatus(http.StatusForbidden))
+
+		By("checking that the consumer app cannot put records")
+		response = consumerApp.POSTResponsef(random.Hexadecimal(), "/put/%s", consumerBindingName)
+		Expect(response).To(HaveHTTPStatus(http.StatusForbidden))
+	})
+})
@@ -0,0 +1,53 @@
+package acceptance_tests_test
+
+import (
+	"net/http"
+
+	"csbbrokerpakaws/acceptance-tests/helpers/apps"
+	"csbbrokerpakaws/acceptance-tests/helpers/random"
+	"csbbrokerpakaws/acceptance-tests/helpers/services"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OpenSearch", Label("opensearch"), func() {
+	It("can be accessed by an app", func() {
+		By("creating a service instance")
+		serviceInstance := services.CreateInstance("csb-aws-opensearch", services.WithPlan("default"))
+		defer serviceInstance.Delete()
+
+		By("pushing the unstarted apps")
+		writerApp := apps.Push(apps.WithApp(apps.OpenSearch))
+		readerApp := apps.Push(apps.WithApp(apps.OpenSearch))
+		defer apps.Delete(writerApp, readerApp)
+
+		By("binding the apps to the service instance, the second one as read only")
+		binding := serviceInstance.Bind(writerApp, services.WithBindParameters(map[string]any{"index_patterns": []string{"acceptance-*"}}))
+		serviceInstance.Bind(readerApp, services.WithBindParameters(map[string]any{"index_patterns": []string{"acceptance-*"}, "read_only": true}))
+
+		By("starting the apps")
+		apps.Start(writerApp, readerApp)
+
+		By("checking that the app environment has a credhub reference for credentials")
+		Expect(binding.Credential()).To(HaveKey("credhub-ref"))
+
+		By("storing a document using the first app")
+		index := "acceptance-" + random.Hexadecimal()
+		id := random.Hexadecimal()
+		value := random.Hexadecimal()
+		writerApp.PUTf(value, "/documents/%s/%s", index, id)
+
+		By("fetching the document using the second app")
+		got := readerApp.GETf("/documents/%s/%s", index, id).String()
+		Expect(got).To(Equal(value))
+
+		By("checking that the second app cannot store documents")
+		response := readerApp.PUTResponsef(random.Hexadecimal(), "/documents/%s/%s", index, id)
+		Expect(response).To(HaveHTTPStatus(http.StatusForbidden))
+
+		By("checking that the first app cannot store documents outside of its index patterns")
+		response = writerApp.PUTResponsef(random.Hexadecimal(), "/documents/%s/%s", "other-"+random.Hexadecimal(), id)
+		Expect(response).To(HaveHTTPStatus(http.StatusForbidden))
+	})
+})
@@ -100,4 +100,52 @@ var _ = Describe("S3", Label("s3"), func() {
 		By("deleting the file from bucket using the app")
 		app.DELETE(filename)
 	})
+
+	It("enforces the permissions of each binding", func() {
+		By("creating a service instance")
+		serviceInstance := services.CreateInstance("csb-aws-s3-bucket", services.WithPlan("default"))
+		defer serviceInstance.Delete()
+
+		By("pushing the unstarted apps")
+		writer := apps.Push(apps.WithApp(apps.S3))
+		reader := apps.Push(apps.WithApp(apps.S3))
+		reports := apps.Push(apps.WithApp(apps.S3))
+		defer apps.Delete(writer, reader, reports)
+
+		By("binding the apps with different permissions")
+		serviceInstance.Bind(writer, services.WithBindParameters(map[string]any{"permissions": "write_only"}))
+		serviceInstance.Bind(reader, services.WithBindParameters(map[string]any{"permissions": "read_only"}))
+		serviceInstance.Bind(reports, services.WithBindParameters(map[string]any{"permissions": "custom_prefix", "key_prefix": "reports/"}))
+
+		By("starting the apps")
+		apps.Start(writer, reader, reports)
+
+		By("uploading a file using the write-only app")
+		filename := fmt.Sprintf("reports/%s", random.Hexadecimal())
+		fileContent := fmt.Sprintf("This is a dummy file that will be uploaded the S3 at %s.", time.Now().String())
+		writer.PUT(fileContent, filename)
+
+		By("checking that the write-only app can neither download nor list files")
+		Expect(writer.GETResponse(filename)).To(HaveHTTPStatus(http.StatusForbidden))
+		Expect(writer.GETResponse("/")).To(HaveHTTPStatus(http.StatusForbidden))
+
+		By("downloading and listing the file using the read-only app")
+		Expect(reader.GET(filename).String()).To(Equal(fileContent))
+		Expect(reader.GET("/").String()).To(ContainSubstring(filename))
+
+		By("checking that the read-only app can neither upload nor delete files")
+		Expect(reader.PUTResponse(fileContent, random.Hexadecimal())).To(HaveHTTPStatus(http.StatusForbidden))
+		response, err := reader.DELETEResponse(filename)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response).To(HaveHTTPStatus(http.StatusForbidden))
+
+		By("checking that the prefix-scoped app can only access files under its prefix")
+		Expect(reports.GET(filename).String()).To(Equal(fileContent))
+		Expect(reports.GETf("/?prefix=%s", "reports/").String()).To(ContainSubstring(filename))
+		Expect(reports.GETResponse("/")).To(HaveHTTPStatus(http.StatusForbidden))
+		Expect(reports.PUTResponse(fileContent, random.Hexadecimal())).To(HaveHTTPStatus(http.StatusForbidden))
+
+		By("deleting the file using the prefix-scoped app")
+		reports.DELETE(filename)
+	})
 })
@@ -0,0 +1,66 @@
+package acceptance_tests_test
+
+import (
+	"net/http"
+	"time"
+
+	"csbbrokerpakaws/acceptance-tests/helpers/apps"
+	"csbbrokerpakaws/acceptance-tests/helpers/random"
+	"csbbrokerpakaws/acceptance-tests/helpers/services"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SNS", Label("sns"), func() {
+	It("delivers messages published to a topic to a subscribed queue", func() {
+		By("creating a queue service instance")
+		queueServiceInstance := services.CreateInstance("csb-aws-sqs", services.WithPlan("standard"))
+		defer queueServiceInstance.Delete()
+
+		csbKey := queueServiceInstance.CreateServiceKey()
+		defer csbKey.Delete()
+		var skReceiver struct {
+			ARN string `json:"arn"`
+		}
+		csbKey.Get(&skReceiver)
+
+		By("creating a topic service instance")
+		topicServiceInstance := services.CreateInstance("csb-aws-sns", services.WithPlan("standard"))
+		defer topicServiceInstance.Delete()
+
+		By("pushing the unstarted apps")
+		publisherApp := apps.Push(apps.WithApp(apps.SNS))
+		consumerApp := apps.Push(apps.WithApp(apps.SQS))
+		defer apps.Delete(publisherApp, consumerApp)
+
+		By("binding the publisher app to the topic")
+		publisherBindingName := random.Name(random.WithPrefix("publisher"))
+		binding := topicServiceInstance.Bind(publisherApp, services.WithBindingName(publisherBindingName))
+
+		By("binding the consumer app to the queue, and subscribing the queue to the topic")
+		consumerBindingName := random.Name(random.WithPrefix("consumer"))
+		queueServiceInstance.Bind(consumerApp, services.WithBindingName(consumerBindingName))
+		topicServiceInstance.Bind(consumerApp, services.WithBindParameters(map[string]any{
+			"subscription_queue_arn": skReceiver.ARN,
+			"raw_message_delivery":   true,
+		}))
+
+		By("starting the apps")
+		apps.Start(publisherApp, consumerApp)
+
+		By("checking that the app environment has a credhub reference for credentials")
+		Expect(binding.Credential()).To(HaveKey("credhub-ref"))
+
+		By("publishing a message to the topic")
+		message := random.Hexadecimal()
+		publisherApp.POSTf(message, "/publish/%s", publisherBindingName)
+
+		By("receiving the message from the subscribed queue")
+		Eventually(func(g Gomega) {
+			response := consumerApp.GETResponsef("/retrieve_and_delete/%s", consumerBindingName)
+			g.Expect(response).To(HaveHTTPStatus(http.StatusOK))
+			g.Expect(response).To(HaveHTTPBody(message))
+		}).WithTimeout(time.Minute).WithPolling(time.Second).Should(Succeed())
+	})
+})
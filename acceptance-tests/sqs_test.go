@@ -150,4 +150,38 @@ var _ = Describe("SQS", Label("sqs"), func() {
 			}).WithTimeout(time.Minute).WithPolling(5 * time.Second).Should(Equal(message))
 		})
 	})
+
+	It("restricts producer and consumer bindings", func() {
+		By("creating a service instance")
+		serviceInstance := services.CreateInstance("csb-aws-sqs", services.WithPlan("standard"))
+		defer serviceInstance.Delete()
+
+		By("pushing the unstarted apps")
+		producerApp := apps.Push(apps.WithApp(apps.SQS))
+		consumerApp := apps.Push(apps.WithApp(apps.SQS))
+		defer apps.Delete(producerApp, consumerApp)
+
+		By("binding the apps with the producer and consumer roles")
+		producerBindingName := random.Name(random.WithPrefix("producer"))
+		serviceInstance.Bind(producerApp, services.WithBindingName(producerBindingName), services.WithBindParameters(map[string]any{"role": "producer"}))
+		consumerBindingName := random.Name(random.WithPrefix("consumer"))
+		serviceInstance.Bind(consumerApp, services.WithBindingName(consumerBindingName), services.WithBindParameters(map[string]any{"role": "consumer"}))
+
+		By("starting the apps")
+		apps.Start(producerApp, consumerApp)
+
+		By("sending a message from the producer app")
+		message := random.Hexadecimal()
+		producerApp.POSTf(message, "/send/%s", producerBindingName)
+
+		By("checking that the producer app cannot receive messages")
+		Expect(producerApp.GETResponsef("/retrieve/%s", producerBindingName)).To(HaveHTTPStatus(http.StatusForbidden))
+
+		By("checking that the consumer app cannot send messages")
+		Expect(consumerApp.POSTResponsef(random.Hexadecimal(), "/send/%s", consumerBindingName)).To(HaveHTTPStatus(http.StatusForbidden))
+
+		By("receiving the message using the consumer app")
+		got := consumerApp.GETf("/retrieve_and_delete/%s", consumerBindingName).String()
+		Expect(got).To(Equal(message))
+	})
 })
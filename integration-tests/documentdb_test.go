@@ -0,0 +1,329 @@
+package integration_test
+
+import (
+	"fmt"
+
+	testframework "github.com/cloudfoundry/cloud-service-broker/v2/brokerpaktestframework"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+const (
+	documentDBServiceID                  = "e588d181-a070-472d-8306-baf7a2308bfc"
+	documentDBServiceName                = "csb-aws-documentdb"
+	documentDBServiceDescription         = "Amazon DocumentDB (with MongoDB compatibility)"
+	documentDBServiceDisplayName         = "Amazon DocumentDB (with MongoDB compatibility)"
+	documentDBServiceSupportURL          = "https://aws.amazon.com/documentdb/"
+	documentDBServiceProviderDisplayName = "VMware"
+	documentDBCustomPlanName             = "custom-sample"
+	documentDBCustomPlanID               = "fcc420c1-ad96-426c-91ae-740128a8236f"
+)
+
+var customDocumentDBPlans = []map[string]any{
+	customDocumentDBPlan,
+}
+
+var customDocumentDBPlan = map[string]any{
+	"name":        documentDBCustomPlanName,
+	"id":          documentDBCustomPlanID,
+	"description": "Default DocumentDB plan",
+	"metadata": map[string]any{
+		"displayName": "custom-sample",
+	},
+}
+
+var _ = Describe("DocumentDB", Label("documentdb"), func() {
+	requiredProperties := map[string]any{
+		"engine_version": "5.0.0",
+		"instance_class": "db.t3.medium",
+	}
+
+	BeforeEach(func() {
+		Expect(mockTerraform.SetTFState([]testframework.TFStateValue{})).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(mockTerraform.Reset()).To(Succeed())
+	})
+
+	It("should publish DocumentDB in the catalog", func() {
+		catalog, err := broker.Catalog()
+		Expect(err).NotTo(HaveOccurred())
+
+		service := testframework.FindService(catalog, documentDBServiceName)
+		Expect(service.ID).To(Equal(documentDBServiceID))
+		Expect(service.Description).To(Equal(documentDBServiceDescription))
+		Expect(service.Tags).To(ConsistOf("aws", "documentdb", "mongodb"))
+		Expect(service.Metadata.DisplayName).To(Equal(documentDBServiceDisplayName))
+		Expect(service.Metadata.DocumentationUrl).To(Equal(documentationURL))
+		Expect(service.Metadata.ImageUrl).To(ContainSubstring("data:image/png;base64,"))
+		Expect(service.Metadata.SupportUrl).To(Equal(documentDBServiceSupportURL))
+		Expect(service.Metadata.ProviderDisplayName).To(Equal(documentDBServiceProviderDisplayName))
+		Expect(service.Plans).To(
+			ConsistOf(
+				MatchFields(IgnoreExtras, Fields{
+					ID:   Equal(documentDBCustomPlanID),
+					Name: Equal(documentDBCustomPlanName),
+				}),
+			),
+		)
+	})
+
+	Describe("provisioning", func() {
+		DescribeTable("should check property constraints",
+			func(params map[string]any, expectedErrorMsg string) {
+				_, err := broker.Provision(documentDBServiceName, documentDBCustomPlanName, buildProperties(requiredProperties, params))
+
+				Expect(err).To(MatchError(ContainSubstring(expectedErrorMsg)))
+			},
+			Entry(
+				"invalid region",
+				map[string]any{"region": "-Asia-northeast1"},
+				"region: Does not match pattern '^[a-z][a-z0-9-]+$'",
+			),
+			Entry(
+				"instance name minimum length is 6 characters",
+				map[string]any{"instance_name": stringOfLen(5)},
+				"instance_name: String length must be greater than or equal to 6",
+			),
+			Entry(
+				"instance name maximum length is 58 characters",
+				map[string]any{"instance_name": stringOfLen(59)},
+				"instance_name: String length must be less than or equal to 58",
+			),
+			Entry(
+				"instance name invalid characters",
+				map[string]any{"instance_name": ".aaaaa"},
+				"instance_name: Does not match pattern '^[a-z][a-z0-9-]+$'",
+			),
+			Entry(
+				"database name maximum length is 63 characters",
+				map[string]any{"db_name": stringOfLen(64)},
+				"db_name: String length must be less than or equal to 63",
+			),
+			Entry(
+				"database name invalid characters",
+				map[string]any{"db_name": "csb.db"},
+				"db_name: Does not match pattern '^[a-zA-Z][a-zA-Z0-9_-]+$'",
+			),
+			Entry(
+				"cluster_instances minimum value is 1",
+				map[string]any{"cluster_instances": 0},
+				"cluster_instances: Must be greater than or equal to 1",
+			),
+			Entry(
+				"cluster_instances maximum value is 16",
+				map[string]any{"cluster_instances": 17},
+				"cluster_instances: Must be less than or equal to 16",
+			),
+			Entry(
+				"backup_retention_period minimum value is 1",
+				map[string]any{"backup_retention_period": 0},
+				"backup_retention_period: Must be greater than or equal to 1",
+			),
+			Entry(
+				"backup_retention_period maximum value is 35",
+				map[string]any{"backup_retention_period": 36},
+				"backup_retention_period: Must be less than or equal to 35",
+			),
+			Entry(
+				"port too low",
+				map[string]any{"port": 1149},
+				"port: Must be greater than or equal to 1150",
+			),
+			Entry(
+				"port too high",
+				map[string]any{"port": 65536},
+				"port: Must be less than or equal to 65535",
+			),
+			Entry(
+				"port not integer",
+				map[string]any{"port": 3.14},
+				"port: Invalid type. Expected: integer, given: number",
+			),
+		)
+
+		It("should require the engine version and the instance class", func() {
+			_, err := broker.Provision(documentDBServiceName, documentDBCustomPlanName, nil)
+
+			Expect(err).To(MatchError(SatisfyAll(
+				ContainSubstring("engine_version is required"),
+				ContainSubstring("instance_class is required"),
+			)))
+		})
+
+		It("should provision a plan", func() {
+			instanceID, err := broker.Provision(documentDBServiceName, documentDBCustomPlanName, requiredProperties)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mockTerraform.FirstTerraformInvocationVars()).To(
+				SatisfyAll(
+					HaveKeyWithValue("instance_name", fmt.Sprintf("csb-documentdb-%s", instanceID)),
+					HaveKeyWithValue("cluster_instances", BeNumerically("==", 3)),
+					HaveKeyWithValue("db_name", "csbdb"),
+					HaveKeyWithValue("region", fakeRegion),
+					HaveKeyWithValue("port", BeNumerically("==", 27017)),
+					HaveKeyWithValue("aws_vpc_id", BeEmpty()),
+					HaveKeyWithValue("docdb_vpc_security_group_ids", BeEmpty()),
+					HaveKeyWithValue("docdb_subnet_group", BeEmpty()),
+					HaveKeyWithValue("labels", MatchKeys(IgnoreExtras, Keys{
+						"pcf-instance-id": Equal(instanceID),
+						"key1":            Equal("value1"),
+						"key2":            Equal("value2"),
+					})),
+					HaveKeyWithValue("engine_version", "5.0.0"),
+					HaveKeyWithValue("instance_class", "db.t3.medium"),
+					HaveKeyWithValue("allow_major_version_upgrade", BeTrue()),
+					HaveKeyWithValue("auto_minor_version_upgrade", BeTrue()),
+					HaveKeyWithValue("deletion_protection", BeFalse()),
+					HaveKeyWithValue("backup_retention_period", BeNumerically("==", 1)),
+					HaveKeyWithValue("preferred_backup_window", BeNil()),
+					HaveKeyWithValue("require_tls", BeTrue()),
+					HaveKeyWithValue("db_cluster_parameter_group_name", BeEmpty()),
+					HaveKeyWithValue("storage_encrypted", BeTrue()),
+					HaveKeyWithValue("kms_key_id", BeEmpty()),
+				))
+		})
+
+		It("should allow properties to be set on provision", func() {
+			_, err := broker.Provision(documentDBServiceName, documentDBCustomPlanName, map[string]any{
+				"engine_version":                  "4.0.0",
+				"instance_class":                  "db.r6g.large",
+				"instance_name":                   "csb-documentdb-fake-name",
+				"db_name":                         "fake-db-name",
+				"region":                          "africa-north-4",
+				"cluster_instances":               2,
+				"port":                            27018,
+				"aws_vpc_id":                      "vpc-fake",
+				"docdb_vpc_security_group_ids":    "group1,group2",
+				"docdb_subnet_group":              "some-other-subnet",
+				"allow_major_version_upgrade":     false,
+				"auto_minor_version_upgrade":      false,
+				"deletion_protection":             true,
+				"backup_retention_period":         7,
+				"preferred_backup_window":         "09:46-10:16",
+				"require_tls":                     false,
+				"db_cluster_parameter_group_name": "some-parameter-group",
+				"storage_encrypted":               true,
+				"kms_key_id":                      "arn:aws:kms:us-south-10:123456789012:key/face1945-7581-4bf6-b311-39594be3dce5",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mockTerraform.FirstTerraformInvocationVars()).To(
+				SatisfyAll(
+					HaveKeyWithValue("engine_version", "4.0.0"),
+					HaveKeyWithValue("instance_class", "db.r6g.large"),
+					HaveKeyWithValue("instance_name", "csb-documentdb-fake-name"),
+					HaveKeyWithValue("db_name", "fake-db-name"),
+					HaveKeyWithValue("region", "africa-north-4"),
+					HaveKeyWithValue("cluster_instances", BeNumerically("==", 2)),
+					HaveKeyWithValue("port", BeNumerically("==", 27018)),
+					HaveKeyWithValue("aws_vpc_id", "vpc-fake"),
+					HaveKeyWithValue("docdb_vpc_security_group_ids", "group1,group2"),
+					HaveKeyWithValue("docdb_subnet_group", "some-other-subnet"),
+					HaveKeyWithValue("allow_major_version_upgrade", BeFalse()),
+					HaveKeyWithValue("auto_minor_version_upgrade", BeFalse()),
+					HaveKeyWithValue("deletion_protection", BeTrue()),
+					HaveKeyWithValue("backup_retention_period", BeNumerically("==", 7)),
+					HaveKeyWithValue("preferred_backup_window", "09:46-10:16"),
+					HaveKeyWithValue("require_tls", BeFalse()),
+					HaveKeyWithValue("db_cluster_parameter_group_name", "some-parameter-group"),
+					HaveKeyWithValue("storage_encrypted", BeTrue()),
+					HaveKeyWithValue("kms_key_id", "arn:aws:kms:us-south-10:123456789012:key/face1945-7581-4bf6-b311-39594be3dce5"),
+				),
+			)
+		})
+	})
+
+	Describe("updating instance", func() {
+		var instanceID string
+
+		BeforeEach(func() {
+			var err error
+			instanceID, err = broker.Provision(documentDBServiceName, documentDBCustomPlanName, requiredProperties)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		DescribeTable(
+			"preventing updates with `prohibit_update` as it can force resource replacement or re-creation",
+			func(prop string, value any) {
+				err := broker.Update(instanceID, documentDBServiceName, documentDBCustomPlanName, map[string]any{prop: value})
+
+				Expect(err).To(MatchError(
+					ContainSubstring(
+						"attempt to update parameter that may result in service instance re-creation and data loss",
+					),
+				))
+
+				const initialProvisionInvocation = 1
+				Expect(mockTerraform.ApplyInvocations()).To(HaveLen(initialProvisionInvocation))
+			},
+			Entry("region", "region", "no-matter-what-region"),
+			Entry("instance_name", "instance_name", "marmaduke"),
+			Entry("db_name", "db_name", "someNewName"),
+			Entry("docdb_subnet_group", "docdb_subnet_group", "some-new-subnet-name"),
+			Entry("docdb_vpc_security_group_ids", "docdb_vpc_security_group_ids", "group3"),
+			Entry("storage_encrypted", "storage_encrypted", false),
+			Entry("kms_key_id", "kms_key_id", "arn:aws:kms:eu-north-42:741085209630:key/a2c0ffee-cab0-4617-a28e-cabba9e06193"),
+		)
+
+		DescribeTable(
+			"allowed updates",
+			func(prop string, value any) {
+				Expect(broker.Update(instanceID, documentDBServiceName, documentDBCustomPlanName, map[string]any{prop: value})).To(Succeed())
+			},
+			Entry("cluster_instances", "cluster_instances", 5),
+			Entry("engine_version", "engine_version", "5.0.0"),
+			Entry("instance_class", "instance_class", "db.r6g.large"),
+			Entry("allow_major_version_upgrade", "allow_major_version_upgrade", false),
+			Entry("auto_minor_version_upgrade", "auto_minor_version_upgrade", false),
+			Entry("deletion_protection", "deletion_protection", true),
+			Entry("backup_retention_period", "backup_retention_period", 14),
+			Entry("require_tls", "require_tls", false),
+			Entry("port", "port", 27018),
+		)
+	})
+
+	Describe("binding", func() {
+		var instanceID string
+
+		BeforeEach(func() {
+			Expect(mockTerraform.SetTFState([]testframework.TFStateValue{
+				{Name: "name", Type: "string", Value: "csbdb"},
+				{Name: "hostname", Type: "string", Value: "csb-documentdb.cluster-fake.docdb.amazonaws.com"},
+				{Name: "reader_hostname", Type: "string", Value: "csb-documentdb.cluster-ro-fake.docdb.amazonaws.com"},
+				{Name: "username", Type: "string", Value: "admin-user"},
+				{Name: "password", Type: "string", Value: "admin-password"},
+				{Name: "port", Type: "number", Value: 27017},
+				{Name: "require_tls", Type: "bool", Value: true},
+				{Name: "region", Type: "string", Value: "us-west-2"},
+			})).To(Succeed())
+
+			var err error
+			instanceID, err = broker.Provision(documentDBServiceName, documentDBCustomPlanName, requiredProperties)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should pass the cluster details and the binding role to terraform", func() {
+			_, err := broker.Bind(documentDBServiceName, documentDBCustomPlanName, instanceID, map[string]any{"read_only": true})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(
+				SatisfyAll(
+					HaveKeyWithValue("name", "csbdb"),
+					HaveKeyWithValue("hostname", "csb-documentdb.cluster-fake.docdb.amazonaws.com"),
+					HaveKeyWithValue("reader_hostname", "csb-documentdb.cluster-ro-fake.docdb.amazonaws.com"),
+					HaveKeyWithValue("admin_username", "admin-user"),
+					HaveKeyWithValue("admin_password", "admin-password"),
+					HaveKeyWithValue("port", BeNumerically("==", 27017)),
+					HaveKeyWithValue("require_tls", BeTrue()),
+					HaveKeyWithValue("tls_ca_bundle_url", "https://truststore.pki.rds.amazonaws.com/global/global-bundle.pem"),
+					HaveKeyWithValue("reader_endpoint", BeFalse()),
+					HaveKeyWithValue("read_only", BeTrue()),
+				),
+			)
+		})
+	})
+})
@@ -85,7 +85,104 @@ var _ = Describe("DynamoDB Namespace", Label("DynamoDB Namespace"), func() {
 			Expect(mockTerraform.FirstTerraformInvocationVars()).To(SatisfyAll(
 				HaveKeyWithValue("prefix", fmt.Sprintf("csb-%s-", instanceID)),
 				HaveKeyWithValue("region", fakeRegion),
+				HaveKeyWithValue("backup_before_delete", false),
+				HaveKeyWithValue("access_key_rotation", BeZero()),
+				HaveKeyWithValue("access_key_rotation_grace_period_hours", BeNumerically("==", 24)),
 			))
 		})
+
+		It("should allow backups to be enabled", func() {
+			_, err := broker.Provision(dynamoDBNamespaceServiceName, "default", map[string]any{"backup_before_delete": true})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mockTerraform.FirstTerraformInvocationVars()).To(HaveKeyWithValue("backup_before_delete", true))
+		})
+	})
+
+	Describe("binding", func() {
+		It("should grant admin access to every table by default", func() {
+			instanceID, err := broker.Provision(dynamoDBNamespaceServiceName, "default", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = broker.Bind(dynamoDBNamespaceServiceName, "default", instanceID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(SatisfyAll(
+				HaveKeyWithValue("access", "admin"),
+				HaveKeyWithValue("table_suffixes", BeEmpty()),
+			))
+		})
+
+		It("should allow the access and tables to be restricted", func() {
+			instanceID, err := broker.Provision(dynamoDBNamespaceServiceName, "default", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = broker.Bind(dynamoDBNamespaceServiceName, "default", instanceID, map[string]any{
+				"access":         "read_only",
+				"table_suffixes": []string{"orders", "users"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(SatisfyAll(
+				HaveKeyWithValue("access", "read_only"),
+				HaveKeyWithValue("table_suffixes", ConsistOf("orders", "users")),
+			))
+		})
+
+		It("should reject unknown access levels", func() {
+			instanceID, err := broker.Provision(dynamoDBNamespaceServiceName, "default", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = broker.Bind(dynamoDBNamespaceServiceName, "default", instanceID, map[string]any{"access": "owner"})
+			Expect(err).To(MatchError(ContainSubstring("access must be one of the following")))
+		})
+
+		It("rotates the access key of an existing binding with a grace period", func() {
+			instanceID, err := broker.Provision(dynamoDBNamespaceServiceName, "default", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mockTerraform.SetTFState([]testframework.TFStateValue{
+				{Name: "prefix", Type: "string", Value: "csb-rotation-"},
+				{Name: "region", Type: "string", Value: "us-west-2"},
+				{Name: "access_key_rotation", Type: "number", Value: 1},
+				{Name: "access_key_rotation_grace_period_ends_at", Type: "string", Value: "2026-10-20T12:00:00Z"},
+			})).To(Succeed())
+			Expect(broker.Update(instanceID, dynamoDBNamespaceServiceName, "default", map[string]any{
+				"access_key_rotation":                    1,
+				"access_key_rotation_grace_period_hours": 48,
+			})).To(Succeed())
+
+			_, err = broker.Bind(dynamoDBNamespaceServiceName, "default", instanceID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(SatisfyAll(
+				HaveKeyWithValue("access_key_rotation", BeNumerically("==", 1)),
+				HaveKeyWithValue("access_key_rotation_grace_period_hours", BeNumerically("==", 48)),
+			))
+			Expect(nthTerraformInvocationVars(mockTerraform, 2)).To(HaveKeyWithValue("instance_details", SatisfyAll(
+				ContainSubstring(`"access_key_rotation":1`),
+				ContainSubstring(`"access_key_rotation_grace_period_ends_at":"2026-10-20T12:00:00Z"`),
+			)))
+		})
+
+		It("binds instances that were provisioned before access keys could be rotated", func() {
+			Expect(mockTerraform.SetTFState([]testframework.TFStateValue{
+				{Name: "prefix", Type: "string", Value: "csb-rotation-"},
+				{Name: "region", Type: "string", Value: "us-west-2"},
+			})).To(Succeed())
+
+			instanceID, err := broker.Provision(dynamoDBNamespaceServiceName, "default", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = broker.Bind(dynamoDBNamespaceServiceName, "default", instanceID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(HaveKeyWithValue("instance_details", Not(ContainSubstring("access_key_rotation"))))
+		})
+
+		It("rejects a negative access key rotation", func() {
+			_, err := broker.Provision(dynamoDBNamespaceServiceName, "default", map[string]any{"access_key_rotation": -1})
+			Expect(err).To(MatchError(ContainSubstring("access_key_rotation: Must be greater than or equal to 0")))
+		})
 	})
 })
@@ -0,0 +1,303 @@
+package integration_test
+
+import (
+	"fmt"
+
+	testframework "github.com/cloudfoundry/cloud-service-broker/v2/brokerpaktestframework"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+const (
+	dynamoDBTableServiceID                  = "9ec44530-eebd-4296-ab0e-94be792ff908"
+	dynamoDBTableServiceName                = "csb-aws-dynamodb-table"
+	dynamoDBTableServiceDescription         = "CSB Amazon DynamoDB Table"
+	dynamoDBTableServiceDisplayName         = "CSB Amazon DynamoDB Table"
+	dynamoDBTableServiceSupportURL          = "https://aws.amazon.com/dynamodb/"
+	dynamoDBTableServiceProviderDisplayName = "VMware"
+	dynamoDBTableDefaultPlanName            = "default"
+	dynamoDBTableDefaultPlanID              = "a84cc3db-5919-4944-9965-c1b3e5668bfb"
+	dynamoDBTableProvisionedPlanName        = "provisioned"
+	dynamoDBTableProvisionedPlanID          = "c22f58c1-382c-4e80-9b82-ecd76b014f7e"
+)
+
+var customDynamoDBTablePlans = []map[string]any{
+	{
+		"name":        dynamoDBTableDefaultPlanName,
+		"id":          dynamoDBTableDefaultPlanID,
+		"description": "Default DynamoDB table plan",
+		"metadata": map[string]any{
+			"displayName": "default",
+		},
+	},
+	{
+		"name":         dynamoDBTableProvisionedPlanName,
+		"id":           dynamoDBTableProvisionedPlanID,
+		"description":  "DynamoDB table plan with provisioned capacity",
+		"billing_mode": "PROVISIONED",
+		"metadata": map[string]any{
+			"displayName": "provisioned",
+		},
+	},
+}
+
+var _ = Describe("DynamoDB Table", Label("DynamoDB Table"), func() {
+	BeforeEach(func() {
+		Expect(mockTerraform.SetTFState([]testframework.TFStateValue{})).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(mockTerraform.Reset()).To(Succeed())
+	})
+
+	It("should publish the service in the catalog", func() {
+		catalog, err := broker.Catalog()
+		Expect(err).NotTo(HaveOccurred())
+
+		service := testframework.FindService(catalog, dynamoDBTableServiceName)
+		Expect(service.ID).To(Equal(dynamoDBTableServiceID))
+		Expect(service.Description).To(Equal(dynamoDBTableServiceDescription))
+		Expect(service.Tags).To(ConsistOf("aws", "dynamodb", "table"))
+		Expect(service.Metadata.DisplayName).To(Equal(dynamoDBTableServiceDisplayName))
+		Expect(service.Metadata.DocumentationUrl).To(Equal(documentationURL))
+		Expect(service.Metadata.ImageUrl).To(ContainSubstring("data:image/png;base64,"))
+		Expect(service.Metadata.SupportUrl).To(Equal(dynamoDBTableServiceSupportURL))
+		Expect(service.Metadata.ProviderDisplayName).To(Equal(dynamoDBTableServiceProviderDisplayName))
+		Expect(service.Plans).To(
+			ConsistOf(
+				MatchFields(IgnoreExtras, Fields{
+					Name: Equal(dynamoDBTableDefaultPlanName),
+					ID:   Equal(dynamoDBTableDefaultPlanID),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					Name: Equal(dynamoDBTableProvisionedPlanName),
+					ID:   Equal(dynamoDBTableProvisionedPlanID),
+				}),
+			),
+		)
+	})
+
+	Describe("provisioning", func() {
+		DescribeTable("property constraints",
+			func(params map[string]any, expectedErrorMsg string) {
+				_, err := broker.Provision(dynamoDBTableServiceName, dynamoDBTableDefaultPlanName, params)
+
+				Expect(err).To(MatchError(ContainSubstring(expectedErrorMsg)))
+			},
+			Entry(
+				"invalid region",
+				map[string]any{"region": "-Asia-northeast1"},
+				"region: Does not match pattern '^[a-z][a-z0-9-]+$'",
+			),
+			Entry(
+				"invalid key type",
+				map[string]any{"hash_key_type": "BOOL"},
+				"hash_key_type must be one of the following",
+			),
+			Entry(
+				"invalid billing mode",
+				map[string]any{"billing_mode": "ON_DEMAND"},
+				"billing_mode must be one of the following",
+			),
+			Entry(
+				"invalid stream view type",
+				map[string]any{"stream_view_type": "ALL"},
+				"stream_view_type must be one of the following",
+			),
+			Entry(
+				"read_capacity minimum value is 1",
+				map[string]any{"read_capacity": 0},
+				"read_capacity: Must be greater than or equal to 1",
+			),
+		)
+
+		It("should provision a table", func() {
+			instanceID, err := broker.Provision(dynamoDBTableServiceName, dynamoDBTableDefaultPlanName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mockTerraform.FirstTerraformInvocationVars()).To(SatisfyAll(
+				HaveKeyWithValue("labels", MatchKeys(IgnoreExtras, Keys{
+					"pcf-instance-id": Equal(instanceID),
+					"key1":            Equal("value1"),
+					"key2":            Equal("value2"),
+				})),
+				HaveKeyWithValue("instance_name", fmt.Sprintf("csb-dynamodb-%s", instanceID)),
+				HaveKeyWithValue("region", fakeRegion),
+				HaveKeyWithValue("hash_key", "id"),
+				HaveKeyWithValue("hash_key_type", "S"),
+				HaveKeyWithValue("range_key", ""),
+				HaveKeyWithValue("range_key_type", "S"),
+				HaveKeyWithValue("global_secondary_indexes", BeEmpty()),
+				HaveKeyWithValue("billing_mode", "PAY_PER_REQUEST"),
+				HaveKeyWithValue("read_capacity", BeNumerically("==", 5)),
+				HaveKeyWithValue("write_capacity", BeNumerically("==", 5)),
+				HaveKeyWithValue("ttl_attribute", ""),
+				HaveKeyWithValue("stream_view_type", BeNil()),
+				HaveKeyWithValue("point_in_time_recovery", BeFalse()),
+				HaveKeyWithValue("kms_key_arn", ""),
+			))
+		})
+
+		It("should take the billing mode from the plan", func() {
+			_, err := broker.Provision(dynamoDBTableServiceName, dynamoDBTableProvisionedPlanName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mockTerraform.FirstTerraformInvocationVars()).To(HaveKeyWithValue("billing_mode", "PROVISIONED"))
+		})
+
+		It("should allow properties to be set on provision", func() {
+			index := map[string]any{
+				"name":           "by-customer",
+				"hash_key":       "customer_id",
+				"range_key":      "created_at",
+				"range_key_type": "N",
+			}
+
+			_, err := broker.Provision(dynamoDBTableServiceName, dynamoDBTableDefaultPlanName, map[string]any{
+				"region":                   "africa-north-4",
+				"hash_key":                 "order_id",
+				"hash_key_type":            "N",
+				"range_key":                "line",
+				"range_key_type":           "N",
+				"global_secondary_indexes": []any{index},
+				"billing_mode":             "PROVISIONED",
+				"read_capacity":            10,
+				"write_capacity":           20,
+				"ttl_attribute":            "expires_at",
+				"stream_view_type":         "NEW_AND_OLD_IMAGES",
+				"point_in_time_recovery":   true,
+				"kms_key_arn":              "arn:aws:kms:africa-north-4:123456789012:key/fake",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mockTerraform.FirstTerraformInvocationVars()).To(SatisfyAll(
+				HaveKeyWithValue("region", "africa-north-4"),
+				HaveKeyWithValue("hash_key", "order_id"),
+				HaveKeyWithValue("hash_key_type", "N"),
+				HaveKeyWithValue("range_key", "line"),
+				HaveKeyWithValue("range_key_type", "N"),
+				HaveKeyWithValue("global_secondary_indexes", ConsistOf(index)),
+				HaveKeyWithValue("billing_mode", "PROVISIONED"),
+				HaveKeyWithValue("read_capacity", BeNumerically("==", 10)),
+				HaveKeyWithValue("write_capacity", BeNumerically("==", 20)),
+				HaveKeyWithValue("ttl_attribute", "expires_at"),
+				HaveKeyWithValue("stream_view_type", "NEW_AND_OLD_IMAGES"),
+				HaveKeyWithValue("point_in_time_recovery", BeTrue()),
+				HaveKeyWithValue("kms_key_arn", "arn:aws:kms:africa-north-4:123456789012:key/fake"),
+			))
+		})
+	})
+
+	Describe("updating instance", func() {
+		var instanceID string
+
+		BeforeEach(func() {
+			var err error
+			instanceID, err = broker.Provision(dynamoDBTableServiceName, dynamoDBTableDefaultPlanName, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		DescribeTable("should prevent updating properties flagged as `prohibit_update` because it can result in the recreation of the service instance",
+			func(prop string, value any) {
+				err := broker.Update(instanceID, dynamoDBTableServiceName, dynamoDBTableDefaultPlanName, map[string]any{prop: value})
+
+				Expect(err).To(MatchError(
+					ContainSubstring(
+						"attempt to update parameter that may result in service instance re-creation and data loss",
+					),
+				))
+
+				const initialProvisionInvocation = 1
+				Expect(mockTerraform.ApplyInvocations()).To(HaveLen(initialProvisionInvocation))
+			},
+			Entry("update region", "region", "no-matter-what-region"),
+			Entry("update hash_key", "hash_key", "other"),
+			Entry("update hash_key_type", "hash_key_type", "N"),
+			Entry("update range_key", "range_key", "other"),
+			Entry("update range_key_type", "range_key_type", "N"),
+		)
+
+		DescribeTable(
+			"some allowed updates",
+			func(prop string, value any) {
+				err := broker.Update(instanceID, dynamoDBTableServiceName, dynamoDBTableDefaultPlanName, map[string]any{prop: value})
+
+				Expect(err).NotTo(HaveOccurred())
+			},
+			Entry(nil, "global_secondary_indexes", []any{map[string]any{"name": "by-status", "hash_key": "status"}}),
+			Entry(nil, "billing_mode", "PROVISIONED"),
+			Entry(nil, "read_capacity", 10),
+			Entry(nil, "write_capacity", 10),
+			Entry(nil, "ttl_attribute", "expires_at"),
+			Entry(nil, "stream_view_type", "KEYS_ONLY"),
+			Entry(nil, "point_in_time_recovery", true),
+		)
+	})
+
+	Describe("binding", func() {
+		It("should return the bind values from terraform output", func() {
+			err := mockTerraform.SetTFState([]testframework.TFStateValue{
+				{Name: "access_key_id", Type: "string", Value: "initial.access.key.id.test"},
+				{Name: "secret_access_key", Type: "string", Value: "initial.secret.access.key.test"},
+				{Name: "access", Type: "string", Value: "read_write"},
+				{Name: "arn", Type: "string", Value: "arn:aws:dynamodb:ap-northeast-3:123456789012:table/example"},
+				{Name: "region", Type: "string", Value: "ap-northeast-3"},
+				{Name: "table_name", Type: "string", Value: "example"},
+				{Name: "hash_key", Type: "string", Value: "id"},
+				{Name: "range_key", Type: "string", Value: ""},
+				{Name: "stream_arn", Type: "string", Value: ""},
+				{Name: "kms_key_arn", Type: "string", Value: ""},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			instanceID, err := broker.Provision(dynamoDBTableServiceName, dynamoDBTableDefaultPlanName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			bindResult, err := broker.Bind(dynamoDBTableServiceName, dynamoDBTableDefaultPlanName, instanceID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(bindResult).To(Equal(map[string]any{
+				"access_key_id":     "initial.access.key.id.test",
+				"secret_access_key": "initial.secret.access.key.test",
+				"access":            "read_write",
+				"arn":               "arn:aws:dynamodb:ap-northeast-3:123456789012:table/example",
+				"region":            "ap-northeast-3",
+				"table_name":        "example",
+				"hash_key":          "id",
+				"range_key":         "",
+				"stream_arn":        "",
+				"kms_key_arn":       "",
+			}))
+		})
+
+		It("should grant read and write access by default", func() {
+			instanceID, err := broker.Provision(dynamoDBTableServiceName, dynamoDBTableDefaultPlanName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = broker.Bind(dynamoDBTableServiceName, dynamoDBTableDefaultPlanName, instanceID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(HaveKeyWithValue("access", "read_write"))
+		})
+
+		It("should allow read-only bindings", func() {
+			instanceID, err := broker.Provision(dynamoDBTableServiceName, dynamoDBTableDefaultPlanName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = broker.Bind(dynamoDBTableServiceName, dynamoDBTableDefaultPlanName, instanceID, map[string]any{"access": "read_only"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(HaveKeyWithValue("access", "read_only"))
+		})
+
+		It("should reject unknown access levels", func() {
+			instanceID, err := broker.Provision(dynamoDBTableServiceName, dynamoDBTableDefaultPlanName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = broker.Bind(dynamoDBTableServiceName, dynamoDBTableDefaultPlanName, instanceID, map[string]any{"access": "admin"})
+			Expect(err).To(MatchError(ContainSubstring("access must be one of the following")))
+		})
+	})
+})
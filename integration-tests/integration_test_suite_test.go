@@ -45,11 +45,16 @@ var _ = BeforeSuite(func() {
 		"GSB_SERVICE_CSB_AWS_POSTGRESQL_PLANS=" + marshall(customPostgresPlans),
 		"GSB_SERVICE_CSB_AWS_AURORA_POSTGRESQL_PLANS=" + marshall(customAuroraPostgresPlans),
 		"GSB_SERVICE_CSB_AWS_AURORA_MYSQL_PLANS=" + marshall(customAuroraMySQLPlans),
+		"GSB_SERVICE_CSB_AWS_DOCUMENTDB_PLANS=" + marshall(customDocumentDBPlans),
 		"GSB_SERVICE_CSB_AWS_MYSQL_PLANS=" + marshall(customMySQLPlans),
 		"GSB_SERVICE_CSB_AWS_REDIS_PLANS=" + marshall(customRedisPlans),
 		"GSB_SERVICE_CSB_AWS_MSSQL_PLANS=" + marshall(customMSSQLPlans),
+		"GSB_SERVICE_CSB_AWS_OPENSEARCH_PLANS=" + marshall(customOpenSearchPlans),
 		"GSB_SERVICE_CSB_AWS_SQS_PLANS=" + marshall(customSQSPlans),
+		"GSB_SERVICE_CSB_AWS_SNS_PLANS=" + marshall(customSNSPlans),
+		"GSB_SERVICE_CSB_AWS_KINESIS_PLANS=" + marshall(customKinesisPlans),
 		"GSB_SERVICE_CSB_AWS_DYNAMODB_NAMESPACE_PLANS=" + marshall(customDynamoDBNamespacePlans),
+		"GSB_SERVICE_CSB_AWS_DYNAMODB_TABLE_PLANS=" + marshall(customDynamoDBTablePlans),
 		"AWS_ACCESS_KEY_ID=" + awsAccessKeyID,
 		"AWS_SECRET_ACCESS_KEY=" + awsSecretAccessKey,
 		"CSB_LISTENER_HOST=localhost",
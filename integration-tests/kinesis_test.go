@@ -0,0 +1,288 @@
+package integration_test
+
+import (
+	"fmt"
+
+	testframework "github.com/cloudfoundry/cloud-service-broker/v2/brokerpaktestframework"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+const (
+	kinesisServiceID                  = "5a97b253-5806-4846-a4ad-605de5a09492"
+	kinesisServiceName                = "csb-aws-kinesis"
+	kinesisServiceDescription         = "CSB AWS Kinesis Data Streams"
+	kinesisServiceDisplayName         = "CSB AWS Kinesis Data Streams"
+	kinesisServiceSupportURL          = "https://aws.amazon.com/kinesis/data-streams/"
+	kinesisServiceProviderDisplayName = "VMware"
+	kinesisCustomPlanName             = "custom-default"
+	kinesisCustomPlanID               = "12666024-517b-4a03-994e-19682ab4d3d0"
+)
+
+var customKinesisPlans = []map[string]any{
+	{
+		"name":        kinesisCustomPlanName,
+		"id":          kinesisCustomPlanID,
+		"description": "Custom Kinesis data stream plan",
+		"metadata": map[string]any{
+			"displayName": "custom-default",
+		},
+	},
+}
+
+var _ = Describe("Kinesis", Label("Kinesis"), func() {
+	BeforeEach(func() {
+		Expect(mockTerraform.SetTFState([]testframework.TFStateValue{})).To(Succeed())
+
+		DeferCleanup(func() {
+			Expect(mockTerraform.Reset()).To(Succeed())
+		})
+	})
+
+	It("should publish AWS Kinesis in the catalog", func() {
+		catalog, err := broker.Catalog()
+		Expect(err).NotTo(HaveOccurred())
+
+		service := testframework.FindService(catalog, kinesisServiceName)
+		Expect(service.ID).To(Equal(kinesisServiceID))
+		Expect(service.Description).To(Equal(kinesisServiceDescription))
+		Expect(service.Tags).To(ConsistOf("aws", "kinesis"))
+		Expect(service.Metadata.DisplayName).To(Equal(kinesisServiceDisplayName))
+		Expect(service.Metadata.DocumentationUrl).To(Equal(documentationURL))
+		Expect(service.Metadata.ImageUrl).To(ContainSubstring("data:image/png;base64,"))
+		Expect(service.Metadata.SupportUrl).To(Equal(kinesisServiceSupportURL))
+		Expect(service.Metadata.ProviderDisplayName).To(Equal(kinesisServiceProviderDisplayName))
+		Expect(service.Plans).To(ConsistOf(
+			MatchFields(IgnoreExtras, Fields{
+				Name: Equal(kinesisCustomPlanName),
+				ID:   Equal(kinesisCustomPlanID),
+			}),
+		))
+	})
+
+	Describe("provisioning", func() {
+		DescribeTable("property constraints",
+			func(params map[string]any, expectedErrorMsg string) {
+				_, err := broker.Provision(kinesisServiceName, kinesisCustomPlanName, params)
+
+				Expect(err).To(MatchError(ContainSubstring(expectedErrorMsg)))
+			},
+			Entry(
+				"invalid region",
+				map[string]any{"region": "-Asia-northeast1"},
+				"region: Does not match pattern '^[a-z][a-z0-9-]+$'",
+			),
+			Entry(
+				"stream_mode must be on-demand or provisioned",
+				map[string]any{"stream_mode": "SERVERLESS"},
+				"stream_mode must be one of the following",
+			),
+			Entry(
+				"shard_count minimum value is 1",
+				map[string]any{"shard_count": 0},
+				"shard_count: Must be greater than or equal to 1",
+			),
+			Entry(
+				"retention_period minimum value is 24",
+				map[string]any{"retention_period": 23},
+				"retention_period: Must be greater than or equal to 24",
+			),
+			Entry(
+				"retention_period maximum value is 8760",
+				map[string]any{"retention_period": 8761},
+				"retention_period: Must be less than or equal to 8760",
+			),
+			Entry(
+				"encryption_type must be KMS or NONE",
+				map[string]any{"encryption_type": "AES256"},
+				"encryption_type must be one of the following",
+			),
+		)
+
+		It("should provision an encrypted on-demand stream", func() {
+			instanceID, err := broker.Provision(kinesisServiceName, kinesisCustomPlanName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mockTerraform.FirstTerraformInvocationVars()).To(
+				SatisfyAll(
+					HaveKeyWithValue("labels", MatchKeys(IgnoreExtras, Keys{
+						"pcf-instance-id": Equal(instanceID),
+						"key1":            Equal("value1"),
+						"key2":            Equal("value2"),
+					})),
+					HaveKeyWithValue("instance_name", fmt.Sprintf("csb-kinesis-%s", instanceID)),
+					HaveKeyWithValue("region", fakeRegion),
+					HaveKeyWithValue("stream_mode", "ON_DEMAND"),
+					HaveKeyWithValue("shard_count", BeNumerically("==", 1)),
+					HaveKeyWithValue("retention_period", BeNumerically("==", 24)),
+					HaveKeyWithValue("encryption_type", "KMS"),
+					HaveKeyWithValue("kms_key_id", ""),
+				),
+			)
+		})
+
+		It("should allow properties to be set on provision", func() {
+			_, err := broker.Provision(kinesisServiceName, kinesisCustomPlanName, map[string]any{
+				"region":           "africa-north-4",
+				"stream_mode":      "PROVISIONED",
+				"shard_count":      4,
+				"retention_period": 168,
+				"encryption_type":  "KMS",
+				"kms_key_id":       "xxxx",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mockTerraform.FirstTerraformInvocationVars()).To(
+				SatisfyAll(
+					HaveKeyWithValue("region", "africa-north-4"),
+					HaveKeyWithValue("stream_mode", "PROVISIONED"),
+					HaveKeyWithValue("shard_count", BeNumerically("==", 4)),
+					HaveKeyWithValue("retention_period", BeNumerically("==", 168)),
+					HaveKeyWithValue("encryption_type", "KMS"),
+					HaveKeyWithValue("kms_key_id", "xxxx"),
+				),
+			)
+		})
+	})
+
+	Describe("updating instance", func() {
+		var instanceID string
+
+		BeforeEach(func() {
+			var err error
+			instanceID, err = broker.Provision(kinesisServiceName, kinesisCustomPlanName, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		DescribeTable("should prevent updating properties flagged as `prohibit_update` because it can result in the recreation of the service instance",
+			func(prop string, value any) {
+				err := broker.Update(instanceID, kinesisServiceName, kinesisCustomPlanName, map[string]any{prop: value})
+
+				Expect(err).To(MatchError(
+					ContainSubstring(
+						"attempt to update parameter that may result in service instance re-creation and data loss",
+					),
+				))
+
+				const initialProvisionInvocation = 1
+				Expect(mockTerraform.ApplyInvocations()).To(HaveLen(initialProvisionInvocation))
+			},
+			Entry("update region", "region", "no-matter-what-region"),
+		)
+
+		DescribeTable(
+			"some allowed updates",
+			func(prop string, value any) {
+				err := broker.Update(instanceID, kinesisServiceName, kinesisCustomPlanName, map[string]any{prop: value})
+
+				Expect(err).NotTo(HaveOccurred())
+			},
+			Entry(nil, "stream_mode", "PROVISIONED"),
+			Entry(nil, "shard_count", 2),
+			Entry(nil, "retention_period", 48),
+			Entry(nil, "encryption_type", "NONE"),
+			Entry(nil, "kms_key_id", "xxxx"),
+		)
+	})
+
+	Describe("bind a service ", func() {
+		It("return the bind values from terraform output", func() {
+			err := mockTerraform.SetTFState([]testframework.TFStateValue{
+				{Name: "access_key_id", Type: "string", Value: "initial.access.key.id.test"},
+				{Name: "secret_access_key", Type: "string", Value: "initial.secret.access.key.test"},
+				{Name: "region", Type: "string", Value: "ap-northeast-3"},
+				{Name: "arn", Type: "string", Value: "arn:aws:kinesis:ap-northeast-3:123456789012:stream/example"},
+				{Name: "stream_name", Type: "string", Value: "example"},
+				{Name: "stream_mode", Type: "string", Value: "ON_DEMAND"},
+				{Name: "kms_key_id", Type: "string", Value: "alias_kms_id"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			instanceID, err := broker.Provision(kinesisServiceName, kinesisCustomPlanName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			bindResult, err := broker.Bind(kinesisServiceName, kinesisCustomPlanName, instanceID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(bindResult).To(
+				Equal(map[string]any{
+					"access_key_id":     "initial.access.key.id.test",
+					"secret_access_key": "initial.secret.access.key.test",
+					"region":            "ap-northeast-3",
+					"arn":               "arn:aws:kinesis:ap-northeast-3:123456789012:stream/example",
+					"stream_name":       "example",
+					"stream_mode":       "ON_DEMAND",
+					"kms_key_id":        "alias_kms_id",
+				}),
+			)
+		})
+
+		It("passes the stream details to the binding", func() {
+			err := mockTerraform.SetTFState([]testframework.TFStateValue{
+				{Name: "arn", Type: "string", Value: "arn:aws:kinesis:ap-northeast-3:123456789012:stream/example"},
+				{Name: "region", Type: "string", Value: "ap-northeast-3"},
+				{Name: "kms_key_id", Type: "string", Value: "alias_kms_id"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			instanceID, err := broker.Provision(kinesisServiceName, kinesisCustomPlanName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = broker.Bind(kinesisServiceName, kinesisCustomPlanName, instanceID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(
+				SatisfyAll(
+					HaveKeyWithValue("arn", "arn:aws:kinesis:ap-northeast-3:123456789012:stream/example"),
+					HaveKeyWithValue("region", "ap-northeast-3"),
+					HaveKeyWithValue("kms_key_id", "alias_kms_id"),
+					HaveKeyWithValue("credential_type", "access_key"),
+					HaveKeyWithValue("user_name", HavePrefix("csb-")),
+				),
+			)
+		})
+
+		It("grants full access by default", func() {
+			instanceID, err := broker.Provision(kinesisServiceName, kinesisCustomPlanName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = broker.Bind(kinesisServiceName, kinesisCustomPlanName, instanceID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(HaveKeyWithValue("role", "admin"))
+		})
+
+		DescribeTable("passes the requested role",
+			func(role string) {
+				instanceID, err := broker.Provision(kinesisServiceName, kinesisCustomPlanName, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = broker.Bind(kinesisServiceName, kinesisCustomPlanName, instanceID, map[string]any{"role": role})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(HaveKeyWithValue("role", role))
+			},
+			Entry(nil, "producer"),
+			Entry(nil, "consumer"),
+			Entry(nil, "admin"),
+		)
+
+		It("rejects unknown roles", func() {
+			instanceID, err := broker.Provision(kinesisServiceName, kinesisCustomPlanName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = broker.Bind(kinesisServiceName, kinesisCustomPlanName, instanceID, map[string]any{"role": "owner"})
+			Expect(err).To(MatchError(ContainSubstring("role must be one of the following")))
+		})
+
+		It("rejects unknown credential types", func() {
+			instanceID, err := broker.Provision(kinesisServiceName, kinesisCustomPlanName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = broker.Bind(kinesisServiceName, kinesisCustomPlanName, instanceID, map[string]any{"credential_type": "password"})
+			Expect(err).To(MatchError(ContainSubstring("credential_type must be one of the following")))
+		})
+	})
+})
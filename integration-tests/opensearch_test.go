@@ -0,0 +1,304 @@
+package integration_test
+
+import (
+	"fmt"
+
+	testframework "github.com/cloudfoundry/cloud-service-broker/v2/brokerpaktestframework"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+const (
+	openSearchServiceID                  = "b72702c9-ca73-4001-8ca5-a57112acd195"
+	openSearchServiceName                = "csb-aws-opensearch"
+	openSearchServiceDescription         = "Amazon OpenSearch Service"
+	openSearchServiceDisplayName         = "Amazon OpenSearch Service"
+	openSearchServiceSupportURL          = "https://aws.amazon.com/opensearch-service/"
+	openSearchServiceProviderDisplayName = "VMware"
+	openSearchCustomPlanName             = "custom-sample"
+	openSearchCustomPlanID               = "463543dc-c850-452f-ac2e-4af9e5f31ec7"
+)
+
+var customOpenSearchPlans = []map[string]any{
+	customOpenSearchPlan,
+}
+
+var customOpenSearchPlan = map[string]any{
+	"name":        openSearchCustomPlanName,
+	"id":          openSearchCustomPlanID,
+	"description": "Default OpenSearch plan",
+	"metadata": map[string]any{
+		"displayName": "custom-sample",
+	},
+}
+
+var _ = Describe("OpenSearch", Label("opensearch"), func() {
+	BeforeEach(func() {
+		Expect(mockTerraform.SetTFState([]testframework.TFStateValue{})).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(mockTerraform.Reset()).To(Succeed())
+	})
+
+	It("should publish OpenSearch in the catalog", func() {
+		catalog, err := broker.Catalog()
+		Expect(err).NotTo(HaveOccurred())
+
+		service := testframework.FindService(catalog, openSearchServiceName)
+		Expect(service.ID).To(Equal(openSearchServiceID))
+		Expect(service.Description).To(Equal(openSearchServiceDescription))
+		Expect(service.Tags).To(ConsistOf("aws", "opensearch", "search"))
+		Expect(service.Metadata.DisplayName).To(Equal(openSearchServiceDisplayName))
+		Expect(service.Metadata.DocumentationUrl).To(Equal(documentationURL))
+		Expect(service.Metadata.ImageUrl).To(ContainSubstring("data:image/png;base64,"))
+		Expect(service.Metadata.SupportUrl).To(Equal(openSearchServiceSupportURL))
+		Expect(service.Metadata.ProviderDisplayName).To(Equal(openSearchServiceProviderDisplayName))
+		Expect(service.Plans).To(
+			ConsistOf(
+				MatchFields(IgnoreExtras, Fields{
+					ID:   Equal(openSearchCustomPlanID),
+					Name: Equal(openSearchCustomPlanName),
+				}),
+			),
+		)
+	})
+
+	Describe("provisioning", func() {
+		DescribeTable("should check property constraints",
+			func(params map[string]any, expectedErrorMsg string) {
+				_, err := broker.Provision(openSearchServiceName, openSearchCustomPlanName, params)
+
+				Expect(err).To(MatchError(ContainSubstring(expectedErrorMsg)))
+			},
+			Entry(
+				"invalid region",
+				map[string]any{"region": "-Asia-northeast1"},
+				"region: Does not match pattern '^[a-z][a-z0-9-]+$'",
+			),
+			Entry(
+				"instance name minimum length is 3 characters",
+				map[string]any{"instance_name": stringOfLen(2)},
+				"instance_name: String length must be greater than or equal to 3",
+			),
+			Entry(
+				"instance name maximum length is 28 characters",
+				map[string]any{"instance_name": stringOfLen(29)},
+				"instance_name: String length must be less than or equal to 28",
+			),
+			Entry(
+				"instance name invalid characters",
+				map[string]any{"instance_name": "csb_opensearch"},
+				"instance_name: Does not match pattern '^[a-z][a-z0-9-]+$'",
+			),
+			Entry(
+				"Elasticsearch engine version",
+				map[string]any{"engine_version": "Elasticsearch_7.10"},
+				"engine_version: Does not match pattern '^OpenSearch_[0-9]+\\.[0-9]+$'",
+			),
+			Entry(
+				"instance_count minimum value is 1",
+				map[string]any{"instance_count": 0},
+				"instance_count: Must be greater than or equal to 1",
+			),
+			Entry(
+				"instance_count maximum value is 80",
+				map[string]any{"instance_count": 81},
+				"instance_count: Must be less than or equal to 80",
+			),
+			Entry(
+				"availability_zone_count minimum value is 2",
+				map[string]any{"availability_zone_count": 1},
+				"availability_zone_count: Must be greater than or equal to 2",
+			),
+			Entry(
+				"availability_zone_count maximum value is 3",
+				map[string]any{"availability_zone_count": 4},
+				"availability_zone_count: Must be less than or equal to 3",
+			),
+			Entry(
+				"volume_size minimum value is 10",
+				map[string]any{"volume_size": 9},
+				"volume_size: Must be greater than or equal to 10",
+			),
+			Entry(
+				"invalid volume_type",
+				map[string]any{"volume_type": "standard"},
+				"volume_type must be one of the following",
+			),
+			Entry(
+				"invalid tls_security_policy",
+				map[string]any{"tls_security_policy": "Policy-Min-TLS-1-0-2019-07"},
+				"tls_security_policy must be one of the following",
+			),
+		)
+
+		It("should provision a plan", func() {
+			instanceID, err := broker.Provision(openSearchServiceName, openSearchCustomPlanName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mockTerraform.FirstTerraformInvocationVars()).To(
+				SatisfyAll(
+					HaveKeyWithValue("instance_name", fmt.Sprintf("csb-os-%s", instanceID[:21])),
+					HaveKeyWithValue("engine_version", "OpenSearch_2.19"),
+					HaveKeyWithValue("instance_type", "t3.medium.search"),
+					HaveKeyWithValue("instance_count", BeNumerically("==", 1)),
+					HaveKeyWithValue("zone_awareness_enabled", BeFalse()),
+					HaveKeyWithValue("availability_zone_count", BeNumerically("==", 2)),
+					HaveKeyWithValue("volume_type", "gp3"),
+					HaveKeyWithValue("volume_size", BeNumerically("==", 10)),
+					HaveKeyWithValue("kms_key_id", BeEmpty()),
+					HaveKeyWithValue("tls_security_policy", "Policy-Min-TLS-1-2-2019-07"),
+					HaveKeyWithValue("region", fakeRegion),
+					HaveKeyWithValue("aws_vpc_id", BeEmpty()),
+					HaveKeyWithValue("opensearch_subnet_ids", BeEmpty()),
+					HaveKeyWithValue("opensearch_vpc_security_group_ids", BeEmpty()),
+					HaveKeyWithValue("labels", MatchKeys(IgnoreExtras, Keys{
+						"pcf-instance-id": Equal(instanceID),
+						"key1":            Equal("value1"),
+						"key2":            Equal("value2"),
+					})),
+				))
+		})
+
+		It("should allow properties to be set on provision", func() {
+			_, err := broker.Provision(openSearchServiceName, openSearchCustomPlanName, map[string]any{
+				"instance_name":                     "csb-opensearch-fake-name",
+				"engine_version":                    "OpenSearch_2.17",
+				"instance_type":                     "r6g.large.search",
+				"instance_count":                    3,
+				"zone_awareness_enabled":            true,
+				"availability_zone_count":           3,
+				"volume_type":                       "io1",
+				"volume_size":                       100,
+				"kms_key_id":                        "arn:aws:kms:us-south-10:123456789012:key/face1945-7581-4bf6-b311-39594be3dce5",
+				"tls_security_policy":               "Policy-Min-TLS-1-2-PFS-2023-10",
+				"region":                            "africa-north-4",
+				"aws_vpc_id":                        "vpc-fake",
+				"opensearch_subnet_ids":             "subnet-1,subnet-2,subnet-3",
+				"opensearch_vpc_security_group_ids": "group1,group2",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mockTerraform.FirstTerraformInvocationVars()).To(
+				SatisfyAll(
+					HaveKeyWithValue("instance_name", "csb-opensearch-fake-name"),
+					HaveKeyWithValue("engine_version", "OpenSearch_2.17"),
+					HaveKeyWithValue("instance_type", "r6g.large.search"),
+					HaveKeyWithValue("instance_count", BeNumerically("==", 3)),
+					HaveKeyWithValue("zone_awareness_enabled", BeTrue()),
+					HaveKeyWithValue("availability_zone_count", BeNumerically("==", 3)),
+					HaveKeyWithValue("volume_type", "io1"),
+					HaveKeyWithValue("volume_size", BeNumerically("==", 100)),
+					HaveKeyWithValue("kms_key_id", "arn:aws:kms:us-south-10:123456789012:key/face1945-7581-4bf6-b311-39594be3dce5"),
+					HaveKeyWithValue("tls_security_policy", "Policy-Min-TLS-1-2-PFS-2023-10"),
+					HaveKeyWithValue("region", "africa-north-4"),
+					HaveKeyWithValue("aws_vpc_id", "vpc-fake"),
+					HaveKeyWithValue("opensearch_subnet_ids", "subnet-1,subnet-2,subnet-3"),
+					HaveKeyWithValue("opensearch_vpc_security_group_ids", "group1,group2"),
+				),
+			)
+		})
+	})
+
+	Describe("updating instance", func() {
+		var instanceID string
+
+		BeforeEach(func() {
+			var err error
+			instanceID, err = broker.Provision(openSearchServiceName, openSearchCustomPlanName, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		DescribeTable(
+			"preventing updates with `prohibit_update` as it can force resource replacement or re-creation",
+			func(prop string, value any) {
+				err := broker.Update(instanceID, openSearchServiceName, openSearchCustomPlanName, map[string]any{prop: value})
+
+				Expect(err).To(MatchError(
+					ContainSubstring(
+						"attempt to update parameter that may result in service instance re-creation and data loss",
+					),
+				))
+
+				const initialProvisionInvocation = 1
+				Expect(mockTerraform.ApplyInvocations()).To(HaveLen(initialProvisionInvocation))
+			},
+			Entry("region", "region", "no-matter-what-region"),
+			Entry("instance_name", "instance_name", "marmaduke"),
+			Entry("aws_vpc_id", "aws_vpc_id", "vpc-other"),
+			Entry("opensearch_subnet_ids", "opensearch_subnet_ids", "subnet-4"),
+			Entry("opensearch_vpc_security_group_ids", "opensearch_vpc_security_group_ids", "group3"),
+			Entry("kms_key_id", "kms_key_id", "arn:aws:kms:eu-north-42:741085209630:key/a2c0ffee-cab0-4617-a28e-cabba9e06193"),
+		)
+
+		DescribeTable(
+			"allowed updates",
+			func(prop string, value any) {
+				Expect(broker.Update(instanceID, openSearchServiceName, openSearchCustomPlanName, map[string]any{prop: value})).To(Succeed())
+			},
+			Entry("engine_version", "engine_version", "OpenSearch_3.1"),
+			Entry("instance_type", "instance_type", "r6g.large.search"),
+			Entry("instance_count", "instance_count", 4),
+			Entry("zone_awareness_enabled", "zone_awareness_enabled", true),
+			Entry("availability_zone_count", "availability_zone_count", 3),
+			Entry("volume_type", "volume_type", "gp2"),
+			Entry("volume_size", "volume_size", 50),
+			Entry("tls_security_policy", "tls_security_policy", "Policy-Min-TLS-1-2-PFS-2023-10"),
+		)
+	})
+
+	Describe("binding", func() {
+		var instanceID string
+
+		BeforeEach(func() {
+			Expect(mockTerraform.SetTFState([]testframework.TFStateValue{
+				{Name: "name", Type: "string", Value: "csb-os-fake"},
+				{Name: "arn", Type: "string", Value: "arn:aws:es:us-west-2:123456789012:domain/csb-os-fake"},
+				{Name: "hostname", Type: "string", Value: "vpc-csb-os-fake.us-west-2.es.amazonaws.com"},
+				{Name: "endpoint", Type: "string", Value: "https://vpc-csb-os-fake.us-west-2.es.amazonaws.com"},
+				{Name: "dashboards_endpoint", Type: "string", Value: "https://vpc-csb-os-fake.us-west-2.es.amazonaws.com/_dashboards"},
+				{Name: "username", Type: "string", Value: "admin-user"},
+				{Name: "password", Type: "string", Value: "admin-password"},
+				{Name: "region", Type: "string", Value: "us-west-2"},
+			})).To(Succeed())
+
+			var err error
+			instanceID, err = broker.Provision(openSearchServiceName, openSearchCustomPlanName, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should pass the domain details and the binding permissions to terraform", func() {
+			_, err := broker.Bind(openSearchServiceName, openSearchCustomPlanName, instanceID, map[string]any{
+				"index_patterns": []string{"logs-*"},
+				"read_only":      true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(
+				SatisfyAll(
+					HaveKeyWithValue("endpoint", "https://vpc-csb-os-fake.us-west-2.es.amazonaws.com"),
+					HaveKeyWithValue("hostname", "vpc-csb-os-fake.us-west-2.es.amazonaws.com"),
+					HaveKeyWithValue("admin_username", "admin-user"),
+					HaveKeyWithValue("admin_password", "admin-password"),
+					HaveKeyWithValue("index_patterns", ConsistOf("logs-*")),
+					HaveKeyWithValue("read_only", BeTrue()),
+				),
+			)
+		})
+
+		It("should grant access to every index by default", func() {
+			_, err := broker.Bind(openSearchServiceName, openSearchCustomPlanName, instanceID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(
+				SatisfyAll(
+					HaveKeyWithValue("index_patterns", ConsistOf("*")),
+					HaveKeyWithValue("read_only", BeFalse()),
+				),
+			)
+		})
+	})
+})
@@ -112,6 +112,8 @@ var _ = Describe("S3", Label("s3"), func() {
 					HaveKeyWithValue("sse_default_algorithm", BeNil()),
 					HaveKeyWithValue("sse_bucket_key_enabled", false),
 					HaveKeyWithValue("require_tls", false),
+					HaveKeyWithValue("access_key_rotation", BeZero()),
+					HaveKeyWithValue("access_key_rotation_grace_period_hours", BeNumerically("==", 24)),
 				),
 			)
 		})
@@ -188,6 +190,11 @@ var _ = Describe("S3", Label("s3"), func() {
 				map[string]any{"acl": "invalidValue"},
 				"acl: acl must be one of the following",
 			),
+			Entry(
+				"access_key_rotation minimum value is 0",
+				map[string]any{"access_key_rotation": -1},
+				"access_key_rotation: Must be greater than or equal to 0",
+			),
 		)
 	})
 
@@ -226,6 +233,8 @@ var _ = Describe("S3", Label("s3"), func() {
 			Entry("unset ol_configuration_default_retention_years", map[string]any{"ol_configuration_default_retention_years": nil}),
 			Entry("update enable_versioning", map[string]any{"enable_versioning": false}),
 			Entry("update require_tls", map[string]any{"require_tls": true}),
+			Entry("rotate the binding access keys", map[string]any{"access_key_rotation": 1}),
+			Entry("update access_key_rotation_grace_period_hours", map[string]any{"access_key_rotation_grace_period_hours": 1}),
 		)
 
 		DescribeTable("should prevent updating properties flagged as `prohibit_update` because it can result in the recreation of the service instance and lost data",
@@ -334,5 +343,129 @@ var _ = Describe("S3", Label("s3"), func() {
 				}),
 			)
 		})
+
+		It("rotates the access key of an existing binding with a grace period", func() {
+			instanceID, err := broker.Provision(s3ServiceName, customS3Plan["name"].(string), nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mockTerraform.SetTFState([]testframework.TFStateValue{
+				{Name: "arn", Type: "string", Value: "arn:aws:s3:::csb-rotation"},
+				{Name: "region", Type: "string", Value: "us-west-2"},
+				{Name: "sse_all_kms_key_ids", Type: "string", Value: ""},
+				{Name: "allowed_aws_vpc_id", Type: "string", Value: ""},
+				{Name: "access_key_rotation", Type: "number", Value: 1},
+				{Name: "access_key_rotation_grace_period_ends_at", Type: "string", Value: "2026-10-20T12:00:00Z"},
+			})).To(Succeed())
+			Expect(broker.Update(instanceID, s3ServiceName, customS3Plan["name"].(string), map[string]any{
+				"access_key_rotation":                    1,
+				"access_key_rotation_grace_period_hours": 48,
+			})).To(Succeed())
+
+			_, err = broker.Bind(s3ServiceName, customS3Plan["name"].(string), instanceID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(
+				SatisfyAll(
+					HaveKeyWithValue("access_key_rotation", BeNumerically("==", 1)),
+					HaveKeyWithValue("access_key_rotation_grace_period_hours", BeNumerically("==", 48)),
+				),
+			)
+			Expect(nthTerraformInvocationVars(mockTerraform, 2)).To(
+				HaveKeyWithValue("instance_details", SatisfyAll(
+					ContainSubstring(`"access_key_rotation":1`),
+					ContainSubstring(`"access_key_rotation_grace_period_ends_at":"2026-10-20T12:00:00Z"`),
+				)),
+			)
+		})
+
+		It("binds instances that were provisioned before access keys could be rotated", func() {
+			Expect(mockTerraform.SetTFState([]testframework.TFStateValue{
+				{Name: "arn", Type: "string", Value: "arn:aws:s3:::csb-rotation"},
+				{Name: "region", Type: "string", Value: "us-west-2"},
+				{Name: "sse_all_kms_key_ids", Type: "string", Value: ""},
+				{Name: "allowed_aws_vpc_id", Type: "string", Value: ""},
+			})).To(Succeed())
+
+			instanceID, err := broker.Provision(s3ServiceName, customS3Plan["name"].(string), nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = broker.Bind(s3ServiceName, customS3Plan["name"].(string), instanceID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(
+				HaveKeyWithValue("instance_details", Not(ContainSubstring("access_key_rotation"))),
+			)
+		})
+
+		It("creates an IAM role when requested", func() {
+			instanceID, err := broker.Provision(s3ServiceName, customS3Plan["name"].(string), nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = broker.Bind(s3ServiceName, customS3Plan["name"].(string), instanceID, map[string]any{"credential_type": "iam_role"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(
+				SatisfyAll(
+					HaveKeyWithValue("credential_type", "iam_role"),
+					HaveKey("trusted_principal_arn"),
+				),
+			)
+		})
+
+		It("defaults to an access key", func() {
+			instanceID, err := broker.Provision(s3ServiceName, customS3Plan["name"].(string), nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = broker.Bind(s3ServiceName, customS3Plan["name"].(string), instanceID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(HaveKeyWithValue("credential_type", "access_key"))
+		})
+
+		It("grants read and write access by default", func() {
+			instanceID, err := broker.Provision(s3ServiceName, customS3Plan["name"].(string), nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = broker.Bind(s3ServiceName, customS3Plan["name"].(string), instanceID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(
+				SatisfyAll(
+					HaveKeyWithValue("permissions", "read_write"),
+					HaveKeyWithValue("key_prefix", ""),
+				),
+			)
+		})
+
+		DescribeTable("passes the requested permissions",
+			func(params map[string]any) {
+				instanceID, err := broker.Provision(s3ServiceName, customS3Plan["name"].(string), nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = broker.Bind(s3ServiceName, customS3Plan["name"].(string), instanceID, params)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(HaveKeyWithValue("permissions", params["permissions"]))
+			},
+			Entry("read only", map[string]any{"permissions": "read_only"}),
+			Entry("write only", map[string]any{"permissions": "write_only"}),
+			Entry("custom prefix", map[string]any{"permissions": "custom_prefix", "key_prefix": "reports/"}),
+		)
+
+		It("rejects unknown permissions", func() {
+			instanceID, err := broker.Provision(s3ServiceName, customS3Plan["name"].(string), nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = broker.Bind(s3ServiceName, customS3Plan["name"].(string), instanceID, map[string]any{"permissions": "admin"})
+			Expect(err).To(MatchError(ContainSubstring("permissions must be one of the following")))
+		})
+
+		It("rejects unknown credential types", func() {
+			instanceID, err := broker.Provision(s3ServiceName, customS3Plan["name"].(string), nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = broker.Bind(s3ServiceName, customS3Plan["name"].(string), instanceID, map[string]any{"credential_type": "session_token"})
+			Expect(err).To(MatchError(ContainSubstring("credential_type must be one of the following")))
+		})
 	})
 })
@@ -0,0 +1,254 @@
+package integration_test
+
+import (
+	"fmt"
+
+	testframework "github.com/cloudfoundry/cloud-service-broker/v2/brokerpaktestframework"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+const (
+	snsServiceID                  = "663c1fc8-8b17-4b05-a7cd-796edd78229c"
+	snsServiceName                = "csb-aws-sns"
+	snsServiceDescription         = "CSB AWS SNS"
+	snsServiceDisplayName         = "CSB AWS SNS"
+	snsServiceSupportURL          = "https://aws.amazon.com/sns/"
+	snsServiceProviderDisplayName = "VMware"
+	snsCustomStandardPlanName     = "custom-standard"
+	snsCustomStandardPlanID       = "3d2796de-4054-4a23-9984-a7fbb7a158f5"
+	snsCustomFIFOPlanName         = "custom-fifo"
+	snsCustomFIFOPlanID           = "946efcca-9e95-41d2-8b62-5cbaf3e64042"
+)
+
+var customSNSPlans = []map[string]any{
+	{
+		"name":        snsCustomStandardPlanName,
+		"id":          snsCustomStandardPlanID,
+		"description": "Custom SNS standard topic plan",
+		"metadata": map[string]any{
+			"displayName": "custom-standard",
+		},
+	},
+	{
+		"name":        snsCustomFIFOPlanName,
+		"id":          snsCustomFIFOPlanID,
+		"description": "Custom SNS FIFO topic plan",
+		"fifo":        true,
+		"metadata": map[string]any{
+			"displayName": "custom-fifo",
+		},
+	},
+}
+
+var _ = Describe("SNS", Label("SNS"), func() {
+	BeforeEach(func() {
+		Expect(mockTerraform.SetTFState([]testframework.TFStateValue{})).To(Succeed())
+
+		DeferCleanup(func() {
+			Expect(mockTerraform.Reset()).To(Succeed())
+		})
+	})
+
+	It("should publish AWS SNS in the catalog", func() {
+		catalog, err := broker.Catalog()
+		Expect(err).NotTo(HaveOccurred())
+
+		service := testframework.FindService(catalog, snsServiceName)
+		Expect(service.ID).To(Equal(snsServiceID))
+		Expect(service.Description).To(Equal(snsServiceDescription))
+		Expect(service.Tags).To(ConsistOf("aws", "sns"))
+		Expect(service.Metadata.DisplayName).To(Equal(snsServiceDisplayName))
+		Expect(service.Metadata.DocumentationUrl).To(Equal(documentationURL))
+		Expect(service.Metadata.ImageUrl).To(ContainSubstring("data:image/png;base64,"))
+		Expect(service.Metadata.SupportUrl).To(Equal(snsServiceSupportURL))
+		Expect(service.Metadata.ProviderDisplayName).To(Equal(snsServiceProviderDisplayName))
+		Expect(service.Plans).To(ConsistOf(
+			MatchFields(IgnoreExtras, Fields{
+				Name: Equal(snsCustomStandardPlanName),
+				ID:   Equal(snsCustomStandardPlanID),
+			}),
+			MatchFields(IgnoreExtras, Fields{
+				Name: Equal(snsCustomFIFOPlanName),
+				ID:   Equal(snsCustomFIFOPlanID),
+			}),
+		))
+	})
+
+	Describe("provisioning", func() {
+		DescribeTable("property constraints",
+			func(params map[string]any, expectedErrorMsg string) {
+				_, err := broker.Provision(snsServiceName, snsCustomStandardPlanName, params)
+
+				Expect(err).To(MatchError(ContainSubstring(expectedErrorMsg)))
+			},
+			Entry(
+				"invalid region",
+				map[string]any{"region": "-Asia-northeast1"},
+				"region: Does not match pattern '^[a-z][a-z0-9-]+$'",
+			),
+		)
+
+		It("should provision a topic", func() {
+			instanceID, err := broker.Provision(snsServiceName, snsCustomStandardPlanName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mockTerraform.FirstTerraformInvocationVars()).To(
+				SatisfyAll(
+					HaveKeyWithValue("labels", MatchKeys(IgnoreExtras, Keys{
+						"pcf-instance-id": Equal(instanceID),
+						"key1":            Equal("value1"),
+						"key2":            Equal("value2"),
+					})),
+					HaveKeyWithValue("instance_name", fmt.Sprintf("csb-sns-%s", instanceID)),
+					HaveKeyWithValue("region", fakeRegion),
+					HaveKeyWithValue("fifo", BeFalse()),
+					HaveKeyWithValue("content_based_deduplication", BeFalse()),
+					HaveKeyWithValue("kms_master_key_id", Equal("")),
+					HaveKeyWithValue("delivery_policy", Equal("")),
+				),
+			)
+		})
+
+		It("should provision a FIFO topic from the plan", func() {
+			_, err := broker.Provision(snsServiceName, snsCustomFIFOPlanName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mockTerraform.FirstTerraformInvocationVars()).To(HaveKeyWithValue("fifo", BeTrue()))
+		})
+
+		It("should allow properties to be set on provision", func() {
+			const deliveryPolicy = `{"http":{"defaultHealthyRetryPolicy":{"numRetries":5}}}`
+
+			_, err := broker.Provision(snsServiceName, snsCustomStandardPlanName, map[string]any{
+				"region":                      "africa-north-4",
+				"fifo":                        true,
+				"content_based_deduplication": true,
+				"kms_master_key_id":           "alias/aws/sns",
+				"delivery_policy":             deliveryPolicy,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mockTerraform.FirstTerraformInvocationVars()).To(
+				SatisfyAll(
+					HaveKeyWithValue("region", "africa-north-4"),
+					HaveKeyWithValue("fifo", BeTrue()),
+					HaveKeyWithValue("content_based_deduplication", BeTrue()),
+					HaveKeyWithValue("kms_master_key_id", "alias/aws/sns"),
+					HaveKeyWithValue("delivery_policy", deliveryPolicy),
+				),
+			)
+		})
+	})
+
+	Describe("updating instance", func() {
+		var instanceID string
+
+		BeforeEach(func() {
+			var err error
+			instanceID, err = broker.Provision(snsServiceName, snsCustomStandardPlanName, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		DescribeTable("should prevent updating properties flagged as `prohibit_update` because it can result in the recreation of the service instance",
+			func(prop string, value any) {
+				err := broker.Update(instanceID, snsServiceName, snsCustomStandardPlanName, map[string]any{prop: value})
+
+				Expect(err).To(MatchError(
+					ContainSubstring(
+						"attempt to update parameter that may result in service instance re-creation and data loss",
+					),
+				))
+
+				const initialProvisionInvocation = 1
+				Expect(mockTerraform.ApplyInvocations()).To(HaveLen(initialProvisionInvocation))
+			},
+			Entry("update region", "region", "no-matter-what-region"),
+			Entry("update fifo", "fifo", true),
+		)
+
+		DescribeTable(
+			"some allowed updates",
+			func(prop string, value any) {
+				err := broker.Update(instanceID, snsServiceName, snsCustomStandardPlanName, map[string]any{prop: value})
+
+				Expect(err).NotTo(HaveOccurred())
+			},
+			Entry(nil, "content_based_deduplication", true),
+			Entry(nil, "kms_master_key_id", "xxxx"),
+			Entry(nil, "delivery_policy", `{"http":{}}`),
+		)
+	})
+
+	Describe("bind a service ", func() {
+		It("return the bind values from terraform output", func() {
+			err := mockTerraform.SetTFState([]testframework.TFStateValue{
+				{Name: "access_key_id", Type: "string", Value: "initial.access.key.id.test"},
+				{Name: "secret_access_key", Type: "string", Value: "initial.secret.access.key.test"},
+				{Name: "subscription_arn", Type: "string", Value: ""},
+				{Name: "region", Type: "string", Value: "ap-northeast-3"},
+				{Name: "arn", Type: "string", Value: "arn:aws:sns:ap-northeast-3:123456789012:example"},
+				{Name: "topic_name", Type: "string", Value: "example_name"},
+				{Name: "fifo", Type: "bool", Value: false},
+				{Name: "kms_master_key_id", Type: "string", Value: ""},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			instanceID, err := broker.Provision(snsServiceName, snsCustomStandardPlanName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			bindResult, err := broker.Bind(snsServiceName, snsCustomStandardPlanName, instanceID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(bindResult).To(
+				Equal(map[string]any{
+					"access_key_id":     "initial.access.key.id.test",
+					"secret_access_key": "initial.secret.access.key.test",
+					"subscription_arn":  "",
+					"region":            "ap-northeast-3",
+					"arn":               "arn:aws:sns:ap-northeast-3:123456789012:example",
+					"topic_name":        "example_name",
+					"fifo":              false,
+					"kms_master_key_id": "",
+				}),
+			)
+		})
+
+		It("does not subscribe a queue by default", func() {
+			instanceID, err := broker.Provision(snsServiceName, snsCustomStandardPlanName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = broker.Bind(snsServiceName, snsCustomStandardPlanName, instanceID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(
+				SatisfyAll(
+					HaveKeyWithValue("subscription_queue_arn", ""),
+					HaveKeyWithValue("raw_message_delivery", BeFalse()),
+				),
+			)
+		})
+
+		It("passes the queue to subscribe", func() {
+			const queueARN = "arn:aws:sqs:us-west-2:123456789012:queue"
+
+			instanceID, err := broker.Provision(snsServiceName, snsCustomStandardPlanName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = broker.Bind(snsServiceName, snsCustomStandardPlanName, instanceID, map[string]any{
+				"subscription_queue_arn": queueARN,
+				"raw_message_delivery":   true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(
+				SatisfyAll(
+					HaveKeyWithValue("subscription_queue_arn", queueARN),
+					HaveKeyWithValue("raw_message_delivery", BeTrue()),
+				),
+			)
+		})
+	})
+})
@@ -97,6 +97,11 @@ var _ = Describe("SQS", Label("SQS"), func() {
 				map[string]any{"kms_data_key_reuse_period_seconds": 10},
 				"kms_data_key_reuse_period_seconds: Must be greater than or equal to 60",
 			),
+			Entry(
+				"access_key_rotation minimum value is 0",
+				map[string]any{"access_key_rotation": -1},
+				"access_key_rotation: Must be greater than or equal to 0",
+			),
 		)
 
 		It("should provision a queue", func() {
@@ -122,6 +127,8 @@ var _ = Describe("SQS", Label("SQS"), func() {
 					HaveKeyWithValue("sqs_managed_sse_enabled", BeTrue()),
 					HaveKeyWithValue("kms_master_key_id", Equal("")),
 					HaveKeyWithValue("kms_data_key_reuse_period_seconds", BeNumerically("==", 300)),
+					HaveKeyWithValue("access_key_rotation", BeZero()),
+					HaveKeyWithValue("access_key_rotation_grace_period_hours", BeNumerically("==", 24)),
 				),
 			)
 		})
@@ -225,6 +232,8 @@ var _ = Describe("SQS", Label("SQS"), func() {
 			Entry(nil, "sqs_managed_sse_enabled", false),
 			Entry(nil, "kms_master_key_id", "xxxx"),
 			Entry(nil, "kms_data_key_reuse_period_seconds", 86_400),
+			Entry(nil, "access_key_rotation", 1),
+			Entry(nil, "access_key_rotation_grace_period_hours", 1),
 		)
 
 		DescribeTable(
@@ -305,5 +314,91 @@ var _ = Describe("SQS", Label("SQS"), func() {
 				}),
 			)
 		})
+
+		It("grants full access by default", func() {
+			instanceID, err := broker.Provision(sqsServiceName, sqsCustomStandardPlanName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = broker.Bind(sqsServiceName, sqsCustomStandardPlanName, instanceID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(HaveKeyWithValue("role", "admin"))
+		})
+
+		DescribeTable("passes the requested role",
+			func(role string) {
+				instanceID, err := broker.Provision(sqsServiceName, sqsCustomStandardPlanName, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = broker.Bind(sqsServiceName, sqsCustomStandardPlanName, instanceID, map[string]any{"role": role})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(HaveKeyWithValue("role", role))
+			},
+			Entry(nil, "producer"),
+			Entry(nil, "consumer"),
+			Entry(nil, "admin"),
+		)
+
+		It("rejects unknown roles", func() {
+			instanceID, err := broker.Provision(sqsServiceName, sqsCustomStandardPlanName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = broker.Bind(sqsServiceName, sqsCustomStandardPlanName, instanceID, map[string]any{"role": "owner"})
+			Expect(err).To(MatchError(ContainSubstring("role must be one of the following")))
+		})
+
+		It("rotates the access key of an existing binding with a grace period", func() {
+			instanceID, err := broker.Provision(sqsServiceName, sqsCustomStandardPlanName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mockTerraform.SetTFState([]testframework.TFStateValue{
+				{Name: "arn", Type: "string", Value: "arn:aws:sqs:us-west-2:123456789012:csb-rotation"},
+				{Name: "region", Type: "string", Value: "us-west-2"},
+				{Name: "dlq_arn", Type: "string", Value: ""},
+				{Name: "kms_all_key_ids", Type: "string", Value: ""},
+				{Name: "access_key_rotation", Type: "number", Value: 1},
+				{Name: "access_key_rotation_grace_period_ends_at", Type: "string", Value: "2026-10-20T12:00:00Z"},
+			})).To(Succeed())
+			Expect(broker.Update(instanceID, sqsServiceName, sqsCustomStandardPlanName, map[string]any{
+				"access_key_rotation":                    1,
+				"access_key_rotation_grace_period_hours": 48,
+			})).To(Succeed())
+
+			_, err = broker.Bind(sqsServiceName, sqsCustomStandardPlanName, instanceID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(
+				SatisfyAll(
+					HaveKeyWithValue("access_key_rotation", BeNumerically("==", 1)),
+					HaveKeyWithValue("access_key_rotation_grace_period_hours", BeNumerically("==", 48)),
+				),
+			)
+			Expect(nthTerraformInvocationVars(mockTerraform, 2)).To(
+				HaveKeyWithValue("instance_details", SatisfyAll(
+					ContainSubstring(`"access_key_rotation":1`),
+					ContainSubstring(`"access_key_rotation_grace_period_ends_at":"2026-10-20T12:00:00Z"`),
+				)),
+			)
+		})
+
+		It("binds instances that were provisioned before access keys could be rotated", func() {
+			Expect(mockTerraform.SetTFState([]testframework.TFStateValue{
+				{Name: "arn", Type: "string", Value: "arn:aws:sqs:us-west-2:123456789012:csb-rotation"},
+				{Name: "region", Type: "string", Value: "us-west-2"},
+				{Name: "dlq_arn", Type: "string", Value: ""},
+				{Name: "kms_all_key_ids", Type: "string", Value: ""},
+			})).To(Succeed())
+
+			instanceID, err := broker.Provision(sqsServiceName, sqsCustomStandardPlanName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = broker.Bind(sqsServiceName, sqsCustomStandardPlanName, instanceID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(nthTerraformInvocationVars(mockTerraform, 1)).To(
+				HaveKeyWithValue("instance_details", Not(ContainSubstring("access_key_rotation"))),
+			)
+		})
 	})
 })
@@ -0,0 +1,13 @@
+package csbdocumentdb_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCsbdocumentdb(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CSB DocumentDB Suite")
+}
@@ -0,0 +1,120 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+//
+//lint:file-ignore ST1000 auto-generated
+package csbdocumentdbfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-documentdb/csbdocumentdb"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type FakeCommandRunner struct {
+	RunCommandStub        func(context.Context, any, ...options.Lister[options.RunCmdOptions]) *mongo.SingleResult
+	runCommandMutex       sync.RWMutex
+	runCommandArgsForCall []struct {
+		arg1 context.Context
+		arg2 any
+		arg3 []options.Lister[options.RunCmdOptions]
+	}
+	runCommandReturns struct {
+		result1 *mongo.SingleResult
+	}
+	runCommandReturnsOnCall map[int]struct {
+		result1 *mongo.SingleResult
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCommandRunner) RunCommand(arg1 context.Context, arg2 any, arg3 ...options.Lister[options.RunCmdOptions]) *mongo.SingleResult {
+	fake.runCommandMutex.Lock()
+	ret, specificReturn := fake.runCommandReturnsOnCall[len(fake.runCommandArgsForCall)]
+	fake.runCommandArgsForCall = append(fake.runCommandArgsForCall, struct {
+		arg1 context.Context
+		arg2 any
+		arg3 []options.Lister[options.RunCmdOptions]
+	}{arg1, arg2, arg3})
+	stub := fake.RunCommandStub
+	fakeReturns := fake.runCommandReturns
+	fake.recordInvocation("RunCommand", []interface{}{arg1, arg2, arg3})
+	fake.runCommandMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCommandRunner) RunCommandCallCount() int {
+	fake.runCommandMutex.RLock()
+	defer fake.runCommandMutex.RUnlock()
+	return len(fake.runCommandArgsForCall)
+}
+
+func (fake *FakeCommandRunner) RunCommandCalls(stub func(context.Context, any, ...options.Lister[options.RunCmdOptions]) *mongo.SingleResult) {
+	fake.runCommandMutex.Lock()
+	defer fake.runCommandMutex.Unlock()
+	fake.RunCommandStub = stub
+}
+
+func (fake *FakeCommandRunner) RunCommandArgsForCall(i int) (context.Context, any, []options.Lister[options.RunCmdOptions]) {
+	fake.runCommandMutex.RLock()
+	defer fake.runCommandMutex.RUnlock()
+	argsForCall := fake.runCommandArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCommandRunner) RunCommandReturns(result1 *mongo.SingleResult) {
+	fake.runCommandMutex.Lock()
+	defer fake.runCommandMutex.Unlock()
+	fake.RunCommandStub = nil
+	fake.runCommandReturns = struct {
+		result1 *mongo.SingleResult
+	}{result1}
+}
+
+func (fake *FakeCommandRunner) RunCommandReturnsOnCall(i int, result1 *mongo.SingleResult) {
+	fake.runCommandMutex.Lock()
+	defer fake.runCommandMutex.Unlock()
+	fake.RunCommandStub = nil
+	if fake.runCommandReturnsOnCall == nil {
+		fake.runCommandReturnsOnCall = make(map[int]struct {
+			result1 *mongo.SingleResult
+		})
+	}
+	fake.runCommandReturnsOnCall[i] = struct {
+		result1 *mongo.SingleResult
+	}{result1}
+}
+
+func (fake *FakeCommandRunner) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.runCommandMutex.RLock()
+	defer fake.runCommandMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCommandRunner) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ csbdocumentdb.CommandRunner = new(FakeCommandRunner)
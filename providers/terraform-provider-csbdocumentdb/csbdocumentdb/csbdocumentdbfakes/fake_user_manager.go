@@ -0,0 +1,349 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+//
+//lint:file-ignore ST1000 auto-generated
+package csbdocumentdbfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-documentdb/csbdocumentdb"
+)
+
+type FakeUserManager struct {
+	CreateUserStub        func(context.Context, csbdocumentdb.BindingUser) error
+	createUserMutex       sync.RWMutex
+	createUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 csbdocumentdb.BindingUser
+	}
+	createUserReturns struct {
+		result1 error
+	}
+	createUserReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteUserStub        func(context.Context, string) error
+	deleteUserMutex       sync.RWMutex
+	deleteUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteUserReturns struct {
+		result1 error
+	}
+	deleteUserReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateUserStub        func(context.Context, csbdocumentdb.BindingUser) error
+	updateUserMutex       sync.RWMutex
+	updateUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 csbdocumentdb.BindingUser
+	}
+	updateUserReturns struct {
+		result1 error
+	}
+	updateUserReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UserExistsStub        func(context.Context, string) (bool, error)
+	userExistsMutex       sync.RWMutex
+	userExistsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	userExistsReturns struct {
+		result1 bool
+		result2 error
+	}
+	userExistsReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUserManager) CreateUser(arg1 context.Context, arg2 csbdocumentdb.BindingUser) error {
+	fake.createUserMutex.Lock()
+	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
+	fake.createUserArgsForCall = append(fake.createUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 csbdocumentdb.BindingUser
+	}{arg1, arg2})
+	stub := fake.CreateUserStub
+	fakeReturns := fake.createUserReturns
+	fake.recordInvocation("CreateUser", []interface{}{arg1, arg2})
+	fake.createUserMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserManager) CreateUserCallCount() int {
+	fake.createUserMutex.RLock()
+	defer fake.createUserMutex.RUnlock()
+	return len(fake.createUserArgsForCall)
+}
+
+func (fake *FakeUserManager) CreateUserCalls(stub func(context.Context, csbdocumentdb.BindingUser) error) {
+	fake.createUserMutex.Lock()
+	defer fake.createUserMutex.Unlock()
+	fake.CreateUserStub = stub
+}
+
+func (fake *FakeUserManager) CreateUserArgsForCall(i int) (context.Context, csbdocumentdb.BindingUser) {
+	fake.createUserMutex.RLock()
+	defer fake.createUserMutex.RUnlock()
+	argsForCall := fake.createUserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserManager) CreateUserReturns(result1 error) {
+	fake.createUserMutex.Lock()
+	defer fake.createUserMutex.Unlock()
+	fake.CreateUserStub = nil
+	fake.createUserReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserManager) CreateUserReturnsOnCall(i int, result1 error) {
+	fake.createUserMutex.Lock()
+	defer fake.createUserMutex.Unlock()
+	fake.CreateUserStub = nil
+	if fake.createUserReturnsOnCall == nil {
+		fake.createUserReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createUserReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserManager) DeleteUser(arg1 context.Context, arg2 string) error {
+	fake.deleteUserMutex.Lock()
+	ret, specificReturn := fake.deleteUserReturnsOnCall[len(fake.deleteUserArgsForCall)]
+	fake.deleteUserArgsForCall = append(fake.deleteUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteUserStub
+	fakeReturns := fake.deleteUserReturns
+	fake.recordInvocation("DeleteUser", []interface{}{arg1, arg2})
+	fake.deleteUserMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserManager) DeleteUserCallCount() int {
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	return len(fake.deleteUserArgsForCall)
+}
+
+func (fake *FakeUserManager) DeleteUserCalls(stub func(context.Context, string) error) {
+	fake.deleteUserMutex.Lock()
+	defer fake.deleteUserMutex.Unlock()
+	fake.DeleteUserStub = stub
+}
+
+func (fake *FakeUserManager) DeleteUserArgsForCall(i int) (context.Context, string) {
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	argsForCall := fake.deleteUserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserManager) DeleteUserReturns(result1 error) {
+	fake.deleteUserMutex.Lock()
+	defer fake.deleteUserMutex.Unlock()
+	fake.DeleteUserStub = nil
+	fake.deleteUserReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserManager) DeleteUserReturnsOnCall(i int, result1 error) {
+	fake.deleteUserMutex.Lock()
+	defer fake.deleteUserMutex.Unlock()
+	fake.DeleteUserStub = nil
+	if fake.deleteUserReturnsOnCall == nil {
+		fake.deleteUserReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteUserReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserManager) UpdateUser(arg1 context.Context, arg2 csbdocumentdb.BindingUser) error {
+	fake.updateUserMutex.Lock()
+	ret, specificReturn := fake.updateUserReturnsOnCall[len(fake.updateUserArgsForCall)]
+	fake.updateUserArgsForCall = append(fake.updateUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 csbdocumentdb.BindingUser
+	}{arg1, arg2})
+	stub := fake.UpdateUserStub
+	fakeReturns := fake.updateUserReturns
+	fake.recordInvocation("UpdateUser", []interface{}{arg1, arg2})
+	fake.updateUserMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserManager) UpdateUserCallCount() int {
+	fake.updateUserMutex.RLock()
+	defer fake.updateUserMutex.RUnlock()
+	return len(fake.updateUserArgsForCall)
+}
+
+func (fake *FakeUserManager) UpdateUserCalls(stub func(context.Context, csbdocumentdb.BindingUser) error) {
+	fake.updateUserMutex.Lock()
+	defer fake.updateUserMutex.Unlock()
+	fake.UpdateUserStub = stub
+}
+
+func (fake *FakeUserManager) UpdateUserArgsForCall(i int) (context.Context, csbdocumentdb.BindingUser) {
+	fake.updateUserMutex.RLock()
+	defer fake.updateUserMutex.RUnlock()
+	argsForCall := fake.updateUserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserManager) UpdateUserReturns(result1 error) {
+	fake.updateUserMutex.Lock()
+	defer fake.updateUserMutex.Unlock()
+	fake.UpdateUserStub = nil
+	fake.updateUserReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserManager) UpdateUserReturnsOnCall(i int, result1 error) {
+	fake.updateUserMutex.Lock()
+	defer fake.updateUserMutex.Unlock()
+	fake.UpdateUserStub = nil
+	if fake.updateUserReturnsOnCall == nil {
+		fake.updateUserReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateUserReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserManager) UserExists(arg1 context.Context, arg2 string) (bool, error) {
+	fake.userExistsMutex.Lock()
+	ret, specificReturn := fake.userExistsReturnsOnCall[len(fake.userExistsArgsForCall)]
+	fake.userExistsArgsForCall = append(fake.userExistsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.UserExistsStub
+	fakeReturns := fake.userExistsReturns
+	fake.recordInvocation("UserExists", []interface{}{arg1, arg2})
+	fake.userExistsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserManager) UserExistsCallCount() int {
+	fake.userExistsMutex.RLock()
+	defer fake.userExistsMutex.RUnlock()
+	return len(fake.userExistsArgsForCall)
+}
+
+func (fake *FakeUserManager) UserExistsCalls(stub func(context.Context, string) (bool, error)) {
+	fake.userExistsMutex.Lock()
+	defer fake.userExistsMutex.Unlock()
+	fake.UserExistsStub = stub
+}
+
+func (fake *FakeUserManager) UserExistsArgsForCall(i int) (context.Context, string) {
+	fake.userExistsMutex.RLock()
+	defer fake.userExistsMutex.RUnlock()
+	argsForCall := fake.userExistsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserManager) UserExistsReturns(result1 bool, result2 error) {
+	fake.userExistsMutex.Lock()
+	defer fake.userExistsMutex.Unlock()
+	fake.UserExistsStub = nil
+	fake.userExistsReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserManager) UserExistsReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.userExistsMutex.Lock()
+	defer fake.userExistsMutex.Unlock()
+	fake.UserExistsStub = nil
+	if fake.userExistsReturnsOnCall == nil {
+		fake.userExistsReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.userExistsReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserManager) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createUserMutex.RLock()
+	defer fake.createUserMutex.RUnlock()
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	fake.updateUserMutex.RLock()
+	defer fake.updateUserMutex.RUnlock()
+	fake.userExistsMutex.RLock()
+	defer fake.userExistsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeUserManager) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ csbdocumentdb.UserManager = new(FakeUserManager)
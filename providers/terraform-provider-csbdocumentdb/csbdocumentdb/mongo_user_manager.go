@@ -0,0 +1,86 @@
+package csbdocumentdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	// DocumentDB only supports users in the admin database
+	adminDatabase = "admin"
+
+	userNotFoundCode = 11
+)
+
+//counterfeiter:generate -header csbdocumentdbfakes/header.txt . CommandRunner
+type CommandRunner interface {
+	RunCommand(ctx context.Context, runCommand any, opts ...options.Lister[options.RunCmdOptions]) *mongo.SingleResult
+}
+
+var _ CommandRunner = &mongo.Database{}
+
+// NewMongoUserManager manages users with the user management commands of the MongoDB API, which
+// must be run against the admin database
+func NewMongoUserManager(admin CommandRunner) UserManager {
+	return &mongoUserManager{admin: admin}
+}
+
+type mongoUserManager struct {
+	admin CommandRunner
+}
+
+func (m *mongoUserManager) CreateUser(ctx context.Context, user BindingUser) error {
+	if err := m.setUser(ctx, "createUser", user); err != nil {
+		return fmt.Errorf("error creating user %q: %w", user.Username, err)
+	}
+	tflog.Info(ctx, "created user", map[string]any{"username": user.Username, "database": user.Database, "role": user.Role})
+	return nil
+}
+
+func (m *mongoUserManager) UpdateUser(ctx context.Context, user BindingUser) error {
+	if err := m.setUser(ctx, "updateUser", user); err != nil {
+		return fmt.Errorf("error modifying user %q: %w", user.Username, err)
+	}
+	return nil
+}
+
+// setUser replaces the roles of the user, so that the result does not depend on any previous roles
+func (m *mongoUserManager) setUser(ctx context.Context, command string, user BindingUser) error {
+	return m.admin.RunCommand(ctx, bson.D{
+		{Key: command, Value: user.Username},
+		{Key: "pwd", Value: user.Password},
+		{Key: "roles", Value: bson.A{bson.D{{Key: "role", Value: user.Role}, {Key: "db", Value: user.Database}}}},
+	}).Err()
+}
+
+// DeleteUser ignores the UserNotFound error of dropUser, so that unbinding still succeeds when the user was
+// already dropped, for instance by an operator or by restoring the cluster from a snapshot
+func (m *mongoUserManager) DeleteUser(ctx context.Context, username string) error {
+	err := m.admin.RunCommand(ctx, bson.D{{Key: "dropUser", Value: username}}).Err()
+	if err != nil && !isUserNotFound(err) {
+		return fmt.Errorf("error deleting user %q: %w", username, err)
+	}
+	tflog.Info(ctx, "deleted user", map[string]any{"username": username})
+	return nil
+}
+
+func (m *mongoUserManager) UserExists(ctx context.Context, username string) (bool, error) {
+	var result struct {
+		Users []bson.Raw `bson:"users"`
+	}
+	if err := m.admin.RunCommand(ctx, bson.D{{Key: "usersInfo", Value: username}}).Decode(&result); err != nil {
+		return false, fmt.Errorf("error getting user %q: %w", username, err)
+	}
+	return len(result.Users) > 0, nil
+}
+
+func isUserNotFound(err error) bool {
+	var commandErr mongo.CommandError
+	return errors.As(err, &commandErr) && commandErr.HasErrorCode(userNotFoundCode)
+}
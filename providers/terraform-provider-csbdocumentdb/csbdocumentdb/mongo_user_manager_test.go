@@ -0,0 +1,97 @@
+package csbdocumentdb_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-documentdb/csbdocumentdb"
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-documentdb/csbdocumentdb/csbdocumentdbfakes"
+)
+
+var _ = Describe("Mongo user manager", func() {
+	var (
+		admin   *csbdocumentdbfakes.FakeCommandRunner
+		manager csbdocumentdb.UserManager
+	)
+
+	result := func(document any, err error) *mongo.SingleResult {
+		return mongo.NewSingleResultFromDocument(document, err, nil)
+	}
+
+	BeforeEach(func() {
+		admin = &csbdocumentdbfakes.FakeCommandRunner{}
+		admin.RunCommandReturns(result(bson.D{{Key: "ok", Value: 1}}, nil))
+		manager = csbdocumentdb.NewMongoUserManager(admin)
+	})
+
+	It("creates the user with a role on the database", func() {
+		user := csbdocumentdb.BindingUser{Username: "binding_user", Password: "a-password", Database: "csbdb", Role: "readWrite"}
+
+		Expect(manager.CreateUser(context.TODO(), user)).To(Succeed())
+
+		Expect(admin.RunCommandCallCount()).To(Equal(1))
+		_, command, _ := admin.RunCommandArgsForCall(0)
+		Expect(command).To(Equal(bson.D{
+			{Key: "createUser", Value: "binding_user"},
+			{Key: "pwd", Value: "a-password"},
+			{Key: "roles", Value: bson.A{bson.D{{Key: "role", Value: "readWrite"}, {Key: "db", Value: "csbdb"}}}},
+		}))
+	})
+
+	It("replaces the password and the roles when updating the user", func() {
+		user := csbdocumentdb.BindingUser{Username: "binding_user", Password: "a-password", Database: "csbdb", Role: "read"}
+
+		Expect(manager.UpdateUser(context.TODO(), user)).To(Succeed())
+
+		_, command, _ := admin.RunCommandArgsForCall(0)
+		Expect(command).To(HaveExactElements(
+			bson.E{Key: "updateUser", Value: "binding_user"},
+			bson.E{Key: "pwd", Value: "a-password"},
+			bson.E{Key: "roles", Value: bson.A{bson.D{{Key: "role", Value: "read"}, {Key: "db", Value: "csbdb"}}}},
+		))
+	})
+
+	It("reports failures to create the user", func() {
+		admin.RunCommandReturns(result(bson.D{}, mongo.CommandError{Code: 51003, Message: "User already exists"}))
+
+		err := manager.CreateUser(context.TODO(), csbdocumentdb.BindingUser{Username: "binding_user"})
+		Expect(err).To(MatchError(ContainSubstring(`error creating user "binding_user"`)))
+		Expect(err).To(MatchError(ContainSubstring("User already exists")))
+	})
+
+	It("deletes the user", func() {
+		Expect(manager.DeleteUser(context.TODO(), "binding_user")).To(Succeed())
+
+		_, command, _ := admin.RunCommandArgsForCall(0)
+		Expect(command).To(Equal(bson.D{{Key: "dropUser", Value: "binding_user"}}))
+	})
+
+	It("does not fail when the user to delete no longer exists", func() {
+		admin.RunCommandReturns(result(bson.D{}, mongo.CommandError{Code: 11, Message: "User not found"}))
+
+		Expect(manager.DeleteUser(context.TODO(), "binding_user")).To(Succeed())
+	})
+
+	It("reports other failures to delete the user", func() {
+		admin.RunCommandReturns(result(bson.D{}, mongo.CommandError{Code: 13, Message: "Unauthorized"}))
+
+		Expect(manager.DeleteUser(context.TODO(), "binding_user")).To(MatchError(ContainSubstring(`error deleting user "binding_user"`)))
+	})
+
+	DescribeTable("user existence",
+		func(users bson.A, exists bool) {
+			admin.RunCommandReturns(result(bson.D{{Key: "users", Value: users}, {Key: "ok", Value: 1}}, nil))
+
+			Expect(manager.UserExists(context.TODO(), "binding_user")).To(Equal(exists))
+
+			_, command, _ := admin.RunCommandArgsForCall(0)
+			Expect(command).To(Equal(bson.D{{Key: "usersInfo", Value: "binding_user"}}))
+		},
+		Entry("user found", bson.A{bson.D{{Key: "user", Value: "binding_user"}}}, true),
+		Entry("user not found", bson.A{}, false),
+	)
+})
@@ -0,0 +1,108 @@
+// Package csbdocumentdb is a Terraform provider specialised for the DocumentDB service of the AWS brokerpak
+package csbdocumentdb
+
+import (
+	"context"
+	"net"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	addressKey          = "address"
+	adminUsernameKey    = "admin_username"
+	adminPasswordKey    = "admin_password"
+	tlsKey              = "tls"
+	tlsCABundleURLKey   = "tls_ca_bundle_url"
+	tlsSkipVerifyKey    = "tls_skip_verify"
+	directConnectionKey = "direct_connection"
+
+	// DefaultTLSCABundleURL is the bundle of the certificate authorities that sign the DocumentDB certificates
+	// in every commercial AWS region
+	DefaultTLSCABundleURL = "https://truststore.pki.rds.amazonaws.com/global/global-bundle.pem"
+)
+
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			addressKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Address of the DocumentDB cluster endpoint in the form host:port",
+			},
+			adminUsernameKey: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			adminPasswordKey: {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			tlsKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			tlsCABundleURLKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     DefaultTLSCABundleURL,
+				Description: "URL of the PEM bundle used to verify the server certificate. When empty, the system certificates are used",
+			},
+			tlsSkipVerifyKey: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Do not verify the server certificate. Only meant for tests",
+			},
+			directConnectionKey: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Only connect to the address, rather than to every member of the replica set",
+			},
+		},
+		ConfigureContextFunc: providerConfigure,
+		ResourcesMap: map[string]*schema.Resource{
+			"csbdocumentdb_binding_user": ResourceBindingUser(),
+		},
+	}
+}
+
+func providerConfigure(_ context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+	for _, f := range []func() diag.Diagnostics{
+		func() diag.Diagnostics {
+			if _, _, err := net.SplitHostPort(d.Get(addressKey).(string)); err != nil {
+				return diag.Errorf("invalid value for %q: %s", addressKey, err)
+			}
+			return nil
+		},
+		func() diag.Diagnostics {
+			if bundleURL := d.Get(tlsCABundleURLKey).(string); bundleURL != "" {
+				if _, err := url.ParseRequestURI(bundleURL); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+			return nil
+		},
+	} {
+		if dg := f(); dg != nil {
+			return nil, dg
+		}
+	}
+
+	var settings = &documentDBSettings{
+		address:          d.Get(addressKey).(string),
+		adminUsername:    d.Get(adminUsernameKey).(string),
+		adminPassword:    d.Get(adminPasswordKey).(string),
+		tls:              d.Get(tlsKey).(bool),
+		tlsCABundleURL:   d.Get(tlsCABundleURLKey).(string),
+		tlsSkipVerify:    d.Get(tlsSkipVerifyKey).(bool),
+		directConnection: d.Get(directConnectionKey).(bool),
+	}
+
+	return settings, nil
+}
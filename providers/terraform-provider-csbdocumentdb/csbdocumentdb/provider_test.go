@@ -0,0 +1,50 @@
+package csbdocumentdb_test
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-documentdb/csbdocumentdb"
+)
+
+var _ = Describe("Provider", func() {
+	configure := func(config map[string]any) diag.Diagnostics {
+		return csbdocumentdb.Provider().Configure(context.TODO(), terraform.NewResourceConfigRaw(config))
+	}
+
+	It("validates the provider schema", func() {
+		Expect(csbdocumentdb.Provider().InternalValidate()).To(Succeed())
+	})
+
+	It("accepts a host and port address", func() {
+		Expect(configure(map[string]any{
+			"address":        "csb-docdb.cluster-fake.us-west-2.docdb.amazonaws.com:27017",
+			"admin_username": "admin",
+			"admin_password": "fake-password",
+		})).To(BeEmpty())
+	})
+
+	It("rejects an address without a port", func() {
+		d := configure(map[string]any{
+			"address":        "csb-docdb.cluster-fake.us-west-2.docdb.amazonaws.com",
+			"admin_username": "admin",
+			"admin_password": "fake-password",
+		})
+		Expect(d).To(HaveLen(1))
+		Expect(d[0].Summary).To(HavePrefix(`invalid value for "address"`))
+	})
+
+	It("rejects a CA bundle URL that is not a URL", func() {
+		d := configure(map[string]any{
+			"address":           "localhost:27017",
+			"admin_username":    "admin",
+			"admin_password":    "fake-password",
+			"tls_ca_bundle_url": "global-bundle.pem",
+		})
+		Expect(d).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,149 @@
+package csbdocumentdb
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	UsernameKey = "username"
+	PasswordKey = "password"
+	DatabaseKey = "database"
+	RoleKey     = "role"
+
+	defaultRole    = "readWrite"
+	defaultTimeout = 5 * time.Minute
+)
+
+// roles are the DocumentDB built-in roles that are scoped to a single database
+var roles = []string{"read", "readWrite", "dbAdmin"}
+
+func ResourceBindingUser() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			UsernameKey: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 63),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*$`), "must start with a letter and contain only letters, digits, underscores and hyphens"),
+				),
+			},
+			PasswordKey: {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(8, 100),
+					validation.StringMatch(regexp.MustCompile(`^[!#-.0-?A-~]+$`), `must contain only printable ASCII characters other than spaces, "/", "@" and double quotes`),
+				),
+			},
+			DatabaseKey: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Database that the role is granted on",
+			},
+			RoleKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      defaultRole,
+				ValidateFunc: validation.StringInSlice(roles, false),
+				Description:  "DocumentDB built-in role granted to the user",
+			},
+		},
+		CreateContext: resourceBindingUserCreate,
+		ReadContext:   resourceBindingUserRead,
+		UpdateContext: resourceBindingUserUpdate,
+		DeleteContext: resourceBindingUserDelete,
+		Description:   "A DocumentDB user for a single binding",
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+func resourceBindingUserCreate(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	manager, err := config.(DocumentDBConfig).GetUserManager(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, data.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	user := bindingUser(data)
+	if err := manager.CreateUser(ctx, user); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(user.Username)
+	return nil
+}
+
+// resourceBindingUserRead can only check that the user is still listed by usersInfo, which does not
+// return passwords. A user that was dropped is removed from the state, so that the next apply runs
+// createUser again.
+func resourceBindingUserRead(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	manager, err := config.(DocumentDBConfig).GetUserManager(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	exists, err := manager.UserExists(ctx, data.Id())
+	switch {
+	case err != nil:
+		return diag.FromErr(err)
+	case !exists:
+		data.SetId("")
+	}
+
+	return nil
+}
+
+func resourceBindingUserUpdate(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	manager, err := config.(DocumentDBConfig).GetUserManager(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, data.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	if err := manager.UpdateUser(ctx, bindingUser(data)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceBindingUserDelete(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	manager, err := config.(DocumentDBConfig).GetUserManager(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, data.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	if err := manager.DeleteUser(ctx, data.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func bindingUser(data *schema.ResourceData) BindingUser {
+	return BindingUser{
+		Username: data.Get(UsernameKey).(string),
+		Password: data.Get(PasswordKey).(string),
+		Database: data.Get(DatabaseKey).(string),
+		Role:     data.Get(RoleKey).(string),
+	}
+}
@@ -0,0 +1,125 @@
+package csbdocumentdb_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-documentdb/csbdocumentdb"
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-documentdb/csbdocumentdb/csbdocumentdbfakes"
+)
+
+var _ = Describe("ResourceBindingUser", func() {
+	var (
+		resource *schema.Resource
+		manager  *csbdocumentdbfakes.FakeUserManager
+		config   *csbdocumentdbfakes.FakeDocumentDBConfig
+		data     *schema.ResourceData
+	)
+
+	BeforeEach(func() {
+		resource = csbdocumentdb.ResourceBindingUser()
+		manager = &csbdocumentdbfakes.FakeUserManager{}
+		config = &csbdocumentdbfakes.FakeDocumentDBConfig{}
+		config.GetUserManagerReturns(manager, nil)
+
+		data = schema.TestResourceDataRaw(GinkgoT(), resource.Schema, map[string]any{
+			csbdocumentdb.UsernameKey: "binding_user",
+			csbdocumentdb.PasswordKey: "a-password",
+			csbdocumentdb.DatabaseKey: "csbdb",
+		})
+	})
+
+	It("creates the user with the readWrite role", func() {
+		Expect(resource.CreateContext(context.TODO(), data, config)).To(BeNil())
+
+		Expect(manager.CreateUserCallCount()).To(Equal(1))
+		_, user := manager.CreateUserArgsForCall(0)
+		Expect(user).To(Equal(csbdocumentdb.BindingUser{
+			Username: "binding_user",
+			Password: "a-password",
+			Database: "csbdb",
+			Role:     "readWrite",
+		}))
+		Expect(data.Id()).To(Equal("binding_user"))
+	})
+
+	It("reports creation failures", func() {
+		manager.CreateUserReturns(fmt.Errorf("boom"))
+
+		d := resource.CreateContext(context.TODO(), data, config)
+		Expect(d).To(HaveLen(1))
+		Expect(d[0].Summary).To(Equal("boom"))
+		Expect(data.Id()).To(BeEmpty())
+	})
+
+	It("reports failures to get the user manager", func() {
+		config.GetUserManagerReturns(nil, fmt.Errorf("no route to host"))
+
+		d := resource.CreateContext(context.TODO(), data, config)
+		Expect(d).To(HaveLen(1))
+		Expect(d[0].Summary).To(Equal("no route to host"))
+		Expect(manager.CreateUserCallCount()).To(BeZero())
+	})
+
+	It("updates the user", func() {
+		data.SetId("binding_user")
+		Expect(data.Set(csbdocumentdb.RoleKey, "read")).To(Succeed())
+
+		Expect(resource.UpdateContext(context.TODO(), data, config)).To(BeNil())
+
+		Expect(manager.UpdateUserCallCount()).To(Equal(1))
+		_, user := manager.UpdateUserArgsForCall(0)
+		Expect(user.Role).To(Equal("read"))
+	})
+
+	It("deletes the user", func() {
+		data.SetId("binding_user")
+
+		Expect(resource.DeleteContext(context.TODO(), data, config)).To(BeNil())
+
+		Expect(manager.DeleteUserCallCount()).To(Equal(1))
+		_, username := manager.DeleteUserArgsForCall(0)
+		Expect(username).To(Equal("binding_user"))
+	})
+
+	Describe("read", func() {
+		BeforeEach(func() {
+			data.SetId("binding_user")
+		})
+
+		It("keeps the user when it exists", func() {
+			manager.UserExistsReturns(true, nil)
+
+			Expect(resource.ReadContext(context.TODO(), data, config)).To(BeNil())
+			Expect(data.Id()).To(Equal("binding_user"))
+		})
+
+		It("removes the user from the state when it no longer exists", func() {
+			manager.UserExistsReturns(false, nil)
+
+			Expect(resource.ReadContext(context.TODO(), data, config)).To(BeNil())
+			Expect(data.Id()).To(BeEmpty())
+		})
+	})
+
+	DescribeTable("validation",
+		func(key, value string, valid bool) {
+			_, errs := resource.Schema[key].ValidateFunc(value, key)
+			Expect(errs == nil).To(Equal(valid))
+		},
+		Entry("username with letters, digits, underscores and hyphens", csbdocumentdb.UsernameKey, "user_1-a", true),
+		Entry("username with a leading digit", csbdocumentdb.UsernameKey, "1user", false),
+		Entry("username that is too long", csbdocumentdb.UsernameKey, "u"+strings.Repeat("1", 63), false),
+		Entry("password with special characters", csbdocumentdb.PasswordKey, "~pass_word-1.", true),
+		Entry("password that is too short", csbdocumentdb.PasswordKey, "short", false),
+		Entry("password with a slash", csbdocumentdb.PasswordKey, "pass/word", false),
+		Entry("password with an at sign", csbdocumentdb.PasswordKey, "pass@word", false),
+		Entry("built-in role", csbdocumentdb.RoleKey, "read", true),
+		Entry("cluster-wide role", csbdocumentdb.RoleKey, "root", false),
+	)
+})
@@ -0,0 +1,129 @@
+package csbdocumentdb
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+//go:generate go tool counterfeiter -generate
+
+//counterfeiter:generate -header csbdocumentdbfakes/header.txt . DocumentDBConfig
+type DocumentDBConfig interface {
+	GetUserManager(ctx context.Context) (UserManager, error)
+}
+
+// BindingUser is a user created for a binding
+type BindingUser struct {
+	Username string
+	Password string
+	Database string
+	Role     string
+}
+
+//counterfeiter:generate -header csbdocumentdbfakes/header.txt . UserManager
+type UserManager interface {
+	CreateUser(ctx context.Context, user BindingUser) error
+	UpdateUser(ctx context.Context, user BindingUser) error
+	DeleteUser(ctx context.Context, username string) error
+	UserExists(ctx context.Context, username string) (bool, error)
+}
+
+type documentDBSettings struct {
+	address          string
+	adminUsername    string
+	adminPassword    string
+	tls              bool
+	tlsCABundleURL   string
+	tlsSkipVerify    bool
+	directConnection bool
+
+	lock        sync.Mutex
+	userManager UserManager
+}
+
+// The resources type-assert the provider meta to DocumentDBConfig
+var _ DocumentDBConfig = &documentDBSettings{}
+
+// GetUserManager connects to the cluster on first use, and then reuses the connection
+func (s *documentDBSettings) GetUserManager(ctx context.Context) (UserManager, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.userManager != nil {
+		return s.userManager, nil
+	}
+
+	clientOptions := options.Client().
+		SetHosts([]string{s.address}).
+		SetAuth(options.Credential{Username: s.adminUsername, Password: s.adminPassword, AuthSource: adminDatabase}).
+		SetDirect(s.directConnection).
+		// DocumentDB does not support retryable writes
+		SetRetryWrites(false)
+
+	if s.tls {
+		tlsConfig, err := s.tlsConfig(ctx)
+		if err != nil {
+			return nil, err
+		}
+		clientOptions.SetTLSConfig(tlsConfig)
+	}
+
+	client, err := mongo.Connect(clientOptions)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to %q: %w", s.address, err)
+	}
+
+	s.userManager = NewMongoUserManager(client.Database(adminDatabase))
+	return s.userManager, nil
+}
+
+func (s *documentDBSettings) tlsConfig(ctx context.Context) (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: s.tlsSkipVerify, // #nosec G402 -- tls_skip_verify defaults to false and is documented as test-only
+	}
+	if s.tlsSkipVerify || s.tlsCABundleURL == "" {
+		return config, nil
+	}
+
+	bundle, err := downloadCABundle(ctx, s.tlsCABundleURL)
+	if err != nil {
+		return nil, err
+	}
+
+	config.RootCAs = x509.NewCertPool()
+	if !config.RootCAs.AppendCertsFromPEM(bundle) {
+		return nil, fmt.Errorf("no certificates found in the CA bundle %q", s.tlsCABundleURL)
+	}
+	return config, nil
+}
+
+func downloadCABundle(ctx context.Context, bundleURL string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, bundleURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading the CA bundle %q: %w", bundleURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error downloading the CA bundle %q: %s", bundleURL, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,22 @@
+# Run "make init" to perform "terraform init"
+
+terraform {
+  required_providers {
+    csbdocumentdb = {
+      source  = "cloudfoundry.org/cloud-service-broker/csbdocumentdb"
+      version = "1.0.0"
+    }
+  }
+}
+
+provider "csbdocumentdb" {
+  address        = "csb-documentdb-46d6f6fb.cluster-fake.us-west-2.docdb.amazonaws.com:27017"
+  admin_username = "admin"
+  admin_password = "FAKE-admin-password"
+}
+
+resource "csbdocumentdb_binding_user" "binding" {
+  username = "csb_binding_user"
+  password = "FAKE-password"
+  database = "csbdb"
+}
@@ -12,6 +12,36 @@ import (
 )
 
 type FakeDynamoDBClient struct {
+	CreateBackupStub        func(context.Context, *dynamodb.CreateBackupInput, ...func(options *dynamodb.Options)) (*dynamodb.CreateBackupOutput, error)
+	createBackupMutex       sync.RWMutex
+	createBackupArgsForCall []struct {
+		arg1 context.Context
+		arg2 *dynamodb.CreateBackupInput
+		arg3 []func(options *dynamodb.Options)
+	}
+	createBackupReturns struct {
+		result1 *dynamodb.CreateBackupOutput
+		result2 error
+	}
+	createBackupReturnsOnCall map[int]struct {
+		result1 *dynamodb.CreateBackupOutput
+		result2 error
+	}
+	CreateTableStub        func(context.Context, *dynamodb.CreateTableInput, ...func(options *dynamodb.Options)) (*dynamodb.CreateTableOutput, error)
+	createTableMutex       sync.RWMutex
+	createTableArgsForCall []struct {
+		arg1 context.Context
+		arg2 *dynamodb.CreateTableInput
+		arg3 []func(options *dynamodb.Options)
+	}
+	createTableReturns struct {
+		result1 *dynamodb.CreateTableOutput
+		result2 error
+	}
+	createTableReturnsOnCall map[int]struct {
+		result1 *dynamodb.CreateTableOutput
+		result2 error
+	}
 	DeleteTableStub        func(context.Context, *dynamodb.DeleteTableInput, ...func(options *dynamodb.Options)) (*dynamodb.DeleteTableOutput, error)
 	deleteTableMutex       sync.RWMutex
 	deleteTableArgsForCall []struct {
@@ -27,6 +57,66 @@ type FakeDynamoDBClient struct {
 		result1 *dynamodb.DeleteTableOutput
 		result2 error
 	}
+	DescribeBackupStub        func(context.Context, *dynamodb.DescribeBackupInput, ...func(options *dynamodb.Options)) (*dynamodb.DescribeBackupOutput, error)
+	describeBackupMutex       sync.RWMutex
+	describeBackupArgsForCall []struct {
+		arg1 context.Context
+		arg2 *dynamodb.DescribeBackupInput
+		arg3 []func(options *dynamodb.Options)
+	}
+	describeBackupReturns struct {
+		result1 *dynamodb.DescribeBackupOutput
+		result2 error
+	}
+	describeBackupReturnsOnCall map[int]struct {
+		result1 *dynamodb.DescribeBackupOutput
+		result2 error
+	}
+	DescribeContinuousBackupsStub        func(context.Context, *dynamodb.DescribeContinuousBackupsInput, ...func(options *dynamodb.Options)) (*dynamodb.DescribeContinuousBackupsOutput, error)
+	describeContinuousBackupsMutex       sync.RWMutex
+	describeContinuousBackupsArgsForCall []struct {
+		arg1 context.Context
+		arg2 *dynamodb.DescribeContinuousBackupsInput
+		arg3 []func(options *dynamodb.Options)
+	}
+	describeContinuousBackupsReturns struct {
+		result1 *dynamodb.DescribeContinuousBackupsOutput
+		result2 error
+	}
+	describeContinuousBackupsReturnsOnCall map[int]struct {
+		result1 *dynamodb.DescribeContinuousBackupsOutput
+		result2 error
+	}
+	DescribeTableStub        func(context.Context, *dynamodb.DescribeTableInput, ...func(*dynamodb.Options)) (*dynamodb.DescribeTableOutput, error)
+	describeTableMutex       sync.RWMutex
+	describeTableArgsForCall []struct {
+		arg1 context.Context
+		arg2 *dynamodb.DescribeTableInput
+		arg3 []func(*dynamodb.Options)
+	}
+	describeTableReturns struct {
+		result1 *dynamodb.DescribeTableOutput
+		result2 error
+	}
+	describeTableReturnsOnCall map[int]struct {
+		result1 *dynamodb.DescribeTableOutput
+		result2 error
+	}
+	DescribeTimeToLiveStub        func(context.Context, *dynamodb.DescribeTimeToLiveInput, ...func(options *dynamodb.Options)) (*dynamodb.DescribeTimeToLiveOutput, error)
+	describeTimeToLiveMutex       sync.RWMutex
+	describeTimeToLiveArgsForCall []struct {
+		arg1 context.Context
+		arg2 *dynamodb.DescribeTimeToLiveInput
+		arg3 []func(options *dynamodb.Options)
+	}
+	describeTimeToLiveReturns struct {
+		result1 *dynamodb.DescribeTimeToLiveOutput
+		result2 error
+	}
+	describeTimeToLiveReturnsOnCall map[int]struct {
+		result1 *dynamodb.DescribeTimeToLiveOutput
+		result2 error
+	}
 	ListTablesStub        func(context.Context, *dynamodb.ListTablesInput, ...func(*dynamodb.Options)) (*dynamodb.ListTablesOutput, error)
 	listTablesMutex       sync.RWMutex
 	listTablesArgsForCall []struct {
@@ -42,10 +132,202 @@ type FakeDynamoDBClient struct {
 		result1 *dynamodb.ListTablesOutput
 		result2 error
 	}
+	ListTagsOfResourceStub        func(context.Context, *dynamodb.ListTagsOfResourceInput, ...func(options *dynamodb.Options)) (*dynamodb.ListTagsOfResourceOutput, error)
+	listTagsOfResourceMutex       sync.RWMutex
+	listTagsOfResourceArgsForCall []struct {
+		arg1 context.Context
+		arg2 *dynamodb.ListTagsOfResourceInput
+		arg3 []func(options *dynamodb.Options)
+	}
+	listTagsOfResourceReturns struct {
+		result1 *dynamodb.ListTagsOfResourceOutput
+		result2 error
+	}
+	listTagsOfResourceReturnsOnCall map[int]struct {
+		result1 *dynamodb.ListTagsOfResourceOutput
+		result2 error
+	}
+	UpdateContinuousBackupsStub        func(context.Context, *dynamodb.UpdateContinuousBackupsInput, ...func(options *dynamodb.Options)) (*dynamodb.UpdateContinuousBackupsOutput, error)
+	updateContinuousBackupsMutex       sync.RWMutex
+	updateContinuousBackupsArgsForCall []struct {
+		arg1 context.Context
+		arg2 *dynamodb.UpdateContinuousBackupsInput
+		arg3 []func(options *dynamodb.Options)
+	}
+	updateContinuousBackupsReturns struct {
+		result1 *dynamodb.UpdateContinuousBackupsOutput
+		result2 error
+	}
+	updateContinuousBackupsReturnsOnCall map[int]struct {
+		result1 *dynamodb.UpdateContinuousBackupsOutput
+		result2 error
+	}
+	UpdateTableStub        func(context.Context, *dynamodb.UpdateTableInput, ...func(options *dynamodb.Options)) (*dynamodb.UpdateTableOutput, error)
+	updateTableMutex       sync.RWMutex
+	updateTableArgsForCall []struct {
+		arg1 context.Context
+		arg2 *dynamodb.UpdateTableInput
+		arg3 []func(options *dynamodb.Options)
+	}
+	updateTableReturns struct {
+		result1 *dynamodb.UpdateTableOutput
+		result2 error
+	}
+	updateTableReturnsOnCall map[int]struct {
+		result1 *dynamodb.UpdateTableOutput
+		result2 error
+	}
+	UpdateTimeToLiveStub        func(context.Context, *dynamodb.UpdateTimeToLiveInput, ...func(options *dynamodb.Options)) (*dynamodb.UpdateTimeToLiveOutput, error)
+	updateTimeToLiveMutex       sync.RWMutex
+	updateTimeToLiveArgsForCall []struct {
+		arg1 context.Context
+		arg2 *dynamodb.UpdateTimeToLiveInput
+		arg3 []func(options *dynamodb.Options)
+	}
+	updateTimeToLiveReturns struct {
+		result1 *dynamodb.UpdateTimeToLiveOutput
+		result2 error
+	}
+	updateTimeToLiveReturnsOnCall map[int]struct {
+		result1 *dynamodb.UpdateTimeToLiveOutput
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeDynamoDBClient) CreateBackup(arg1 context.Context, arg2 *dynamodb.CreateBackupInput, arg3 ...func(options *dynamodb.Options)) (*dynamodb.CreateBackupOutput, error) {
+	fake.createBackupMutex.Lock()
+	ret, specificReturn := fake.createBackupReturnsOnCall[len(fake.createBackupArgsForCall)]
+	fake.createBackupArgsForCall = append(fake.createBackupArgsForCall, struct {
+		arg1 context.Context
+		arg2 *dynamodb.CreateBackupInput
+		arg3 []func(options *dynamodb.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.CreateBackupStub
+	fakeReturns := fake.createBackupReturns
+	fake.recordInvocation("CreateBackup", []interface{}{arg1, arg2, arg3})
+	fake.createBackupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDynamoDBClient) CreateBackupCallCount() int {
+	fake.createBackupMutex.RLock()
+	defer fake.createBackupMutex.RUnlock()
+	return len(fake.createBackupArgsForCall)
+}
+
+func (fake *FakeDynamoDBClient) CreateBackupCalls(stub func(context.Context, *dynamodb.CreateBackupInput, ...func(options *dynamodb.Options)) (*dynamodb.CreateBackupOutput, error)) {
+	fake.createBackupMutex.Lock()
+	defer fake.createBackupMutex.Unlock()
+	fake.CreateBackupStub = stub
+}
+
+func (fake *FakeDynamoDBClient) CreateBackupArgsForCall(i int) (context.Context, *dynamodb.CreateBackupInput, []func(options *dynamodb.Options)) {
+	fake.createBackupMutex.RLock()
+	defer fake.createBackupMutex.RUnlock()
+	argsForCall := fake.createBackupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDynamoDBClient) CreateBackupReturns(result1 *dynamodb.CreateBackupOutput, result2 error) {
+	fake.createBackupMutex.Lock()
+	defer fake.createBackupMutex.Unlock()
+	fake.CreateBackupStub = nil
+	fake.createBackupReturns = struct {
+		result1 *dynamodb.CreateBackupOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDynamoDBClient) CreateBackupReturnsOnCall(i int, result1 *dynamodb.CreateBackupOutput, result2 error) {
+	fake.createBackupMutex.Lock()
+	defer fake.createBackupMutex.Unlock()
+	fake.CreateBackupStub = nil
+	if fake.createBackupReturnsOnCall == nil {
+		fake.createBackupReturnsOnCall = make(map[int]struct {
+			result1 *dynamodb.CreateBackupOutput
+			result2 error
+		})
+	}
+	fake.createBackupReturnsOnCall[i] = struct {
+		result1 *dynamodb.CreateBackupOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDynamoDBClient) CreateTable(arg1 context.Context, arg2 *dynamodb.CreateTableInput, arg3 ...func(options *dynamodb.Options)) (*dynamodb.CreateTableOutput, error) {
+	fake.createTableMutex.Lock()
+	ret, specificReturn := fake.createTableReturnsOnCall[len(fake.createTableArgsForCall)]
+	fake.createTableArgsForCall = append(fake.createTableArgsForCall, struct {
+		arg1 context.Context
+		arg2 *dynamodb.CreateTableInput
+		arg3 []func(options *dynamodb.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.CreateTableStub
+	fakeReturns := fake.createTableReturns
+	fake.recordInvocation("CreateTable", []interface{}{arg1, arg2, arg3})
+	fake.createTableMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDynamoDBClient) CreateTableCallCount() int {
+	fake.createTableMutex.RLock()
+	defer fake.createTableMutex.RUnlock()
+	return len(fake.createTableArgsForCall)
+}
+
+func (fake *FakeDynamoDBClient) CreateTableCalls(stub func(context.Context, *dynamodb.CreateTableInput, ...func(options *dynamodb.Options)) (*dynamodb.CreateTableOutput, error)) {
+	fake.createTableMutex.Lock()
+	defer fake.createTableMutex.Unlock()
+	fake.CreateTableStub = stub
+}
+
+func (fake *FakeDynamoDBClient) CreateTableArgsForCall(i int) (context.Context, *dynamodb.CreateTableInput, []func(options *dynamodb.Options)) {
+	fake.createTableMutex.RLock()
+	defer fake.createTableMutex.RUnlock()
+	argsForCall := fake.createTableArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDynamoDBClient) CreateTableReturns(result1 *dynamodb.CreateTableOutput, result2 error) {
+	fake.createTableMutex.Lock()
+	defer fake.createTableMutex.Unlock()
+	fake.CreateTableStub = nil
+	fake.createTableReturns = struct {
+		result1 *dynamodb.CreateTableOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDynamoDBClient) CreateTableReturnsOnCall(i int, result1 *dynamodb.CreateTableOutput, result2 error) {
+	fake.createTableMutex.Lock()
+	defer fake.createTableMutex.Unlock()
+	fake.CreateTableStub = nil
+	if fake.createTableReturnsOnCall == nil {
+		fake.createTableReturnsOnCall = make(map[int]struct {
+			result1 *dynamodb.CreateTableOutput
+			result2 error
+		})
+	}
+	fake.createTableReturnsOnCall[i] = struct {
+		result1 *dynamodb.CreateTableOutput
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDynamoDBClient) DeleteTable(arg1 context.Context, arg2 *dynamodb.DeleteTableInput, arg3 ...func(options *dynamodb.Options)) (*dynamodb.DeleteTableOutput, error) {
 	fake.deleteTableMutex.Lock()
 	ret, specificReturn := fake.deleteTableReturnsOnCall[len(fake.deleteTableArgsForCall)]
@@ -112,6 +394,270 @@ func (fake *FakeDynamoDBClient) DeleteTableReturnsOnCall(i int, result1 *dynamod
 	}{result1, result2}
 }
 
+func (fake *FakeDynamoDBClient) DescribeBackup(arg1 context.Context, arg2 *dynamodb.DescribeBackupInput, arg3 ...func(options *dynamodb.Options)) (*dynamodb.DescribeBackupOutput, error) {
+	fake.describeBackupMutex.Lock()
+	ret, specificReturn := fake.describeBackupReturnsOnCall[len(fake.describeBackupArgsForCall)]
+	fake.describeBackupArgsForCall = append(fake.describeBackupArgsForCall, struct {
+		arg1 context.Context
+		arg2 *dynamodb.DescribeBackupInput
+		arg3 []func(options *dynamodb.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.DescribeBackupStub
+	fakeReturns := fake.describeBackupReturns
+	fake.recordInvocation("DescribeBackup", []interface{}{arg1, arg2, arg3})
+	fake.describeBackupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDynamoDBClient) DescribeBackupCallCount() int {
+	fake.describeBackupMutex.RLock()
+	defer fake.describeBackupMutex.RUnlock()
+	return len(fake.describeBackupArgsForCall)
+}
+
+func (fake *FakeDynamoDBClient) DescribeBackupCalls(stub func(context.Context, *dynamodb.DescribeBackupInput, ...func(options *dynamodb.Options)) (*dynamodb.DescribeBackupOutput, error)) {
+	fake.describeBackupMutex.Lock()
+	defer fake.describeBackupMutex.Unlock()
+	fake.DescribeBackupStub = stub
+}
+
+func (fake *FakeDynamoDBClient) DescribeBackupArgsForCall(i int) (context.Context, *dynamodb.DescribeBackupInput, []func(options *dynamodb.Options)) {
+	fake.describeBackupMutex.RLock()
+	defer fake.describeBackupMutex.RUnlock()
+	argsForCall := fake.describeBackupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDynamoDBClient) DescribeBackupReturns(result1 *dynamodb.DescribeBackupOutput, result2 error) {
+	fake.describeBackupMutex.Lock()
+	defer fake.describeBackupMutex.Unlock()
+	fake.DescribeBackupStub = nil
+	fake.describeBackupReturns = struct {
+		result1 *dynamodb.DescribeBackupOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDynamoDBClient) DescribeBackupReturnsOnCall(i int, result1 *dynamodb.DescribeBackupOutput, result2 error) {
+	fake.describeBackupMutex.Lock()
+	defer fake.describeBackupMutex.Unlock()
+	fake.DescribeBackupStub = nil
+	if fake.describeBackupReturnsOnCall == nil {
+		fake.describeBackupReturnsOnCall = make(map[int]struct {
+			result1 *dynamodb.DescribeBackupOutput
+			result2 error
+		})
+	}
+	fake.describeBackupReturnsOnCall[i] = struct {
+		result1 *dynamodb.DescribeBackupOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDynamoDBClient) DescribeContinuousBackups(arg1 context.Context, arg2 *dynamodb.DescribeContinuousBackupsInput, arg3 ...func(options *dynamodb.Options)) (*dynamodb.DescribeContinuousBackupsOutput, error) {
+	fake.describeContinuousBackupsMutex.Lock()
+	ret, specificReturn := fake.describeContinuousBackupsReturnsOnCall[len(fake.describeContinuousBackupsArgsForCall)]
+	fake.describeContinuousBackupsArgsForCall = append(fake.describeContinuousBackupsArgsForCall, struct {
+		arg1 context.Context
+		arg2 *dynamodb.DescribeContinuousBackupsInput
+		arg3 []func(options *dynamodb.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.DescribeContinuousBackupsStub
+	fakeReturns := fake.describeContinuousBackupsReturns
+	fake.recordInvocation("DescribeContinuousBackups", []interface{}{arg1, arg2, arg3})
+	fake.describeContinuousBackupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDynamoDBClient) DescribeContinuousBackupsCallCount() int {
+	fake.describeContinuousBackupsMutex.RLock()
+	defer fake.describeContinuousBackupsMutex.RUnlock()
+	return len(fake.describeContinuousBackupsArgsForCall)
+}
+
+func (fake *FakeDynamoDBClient) DescribeContinuousBackupsCalls(stub func(context.Context, *dynamodb.DescribeContinuousBackupsInput, ...func(options *dynamodb.Options)) (*dynamodb.DescribeContinuousBackupsOutput, error)) {
+	fake.describeContinuousBackupsMutex.Lock()
+	defer fake.describeContinuousBackupsMutex.Unlock()
+	fake.DescribeContinuousBackupsStub = stub
+}
+
+func (fake *FakeDynamoDBClient) DescribeContinuousBackupsArgsForCall(i int) (context.Context, *dynamodb.DescribeContinuousBackupsInput, []func(options *dynamodb.Options)) {
+	fake.describeContinuousBackupsMutex.RLock()
+	defer fake.describeContinuousBackupsMutex.RUnlock()
+	argsForCall := fake.describeContinuousBackupsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDynamoDBClient) DescribeContinuousBackupsReturns(result1 *dynamodb.DescribeContinuousBackupsOutput, result2 error) {
+	fake.describeContinuousBackupsMutex.Lock()
+	defer fake.describeContinuousBackupsMutex.Unlock()
+	fake.DescribeContinuousBackupsStub = nil
+	fake.describeContinuousBackupsReturns = struct {
+		result1 *dynamodb.DescribeContinuousBackupsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDynamoDBClient) DescribeContinuousBackupsReturnsOnCall(i int, result1 *dynamodb.DescribeContinuousBackupsOutput, result2 error) {
+	fake.describeContinuousBackupsMutex.Lock()
+	defer fake.describeContinuousBackupsMutex.Unlock()
+	fake.DescribeContinuousBackupsStub = nil
+	if fake.describeContinuousBackupsReturnsOnCall == nil {
+		fake.describeContinuousBackupsReturnsOnCall = make(map[int]struct {
+			result1 *dynamodb.DescribeContinuousBackupsOutput
+			result2 error
+		})
+	}
+	fake.describeContinuousBackupsReturnsOnCall[i] = struct {
+		result1 *dynamodb.DescribeContinuousBackupsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDynamoDBClient) DescribeTable(arg1 context.Context, arg2 *dynamodb.DescribeTableInput, arg3 ...func(*dynamodb.Options)) (*dynamodb.DescribeTableOutput, error) {
+	fake.describeTableMutex.Lock()
+	ret, specificReturn := fake.describeTableReturnsOnCall[len(fake.describeTableArgsForCall)]
+	fake.describeTableArgsForCall = append(fake.describeTableArgsForCall, struct {
+		arg1 context.Context
+		arg2 *dynamodb.DescribeTableInput
+		arg3 []func(*dynamodb.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.DescribeTableStub
+	fakeReturns := fake.describeTableReturns
+	fake.recordInvocation("DescribeTable", []interface{}{arg1, arg2, arg3})
+	fake.describeTableMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDynamoDBClient) DescribeTableCallCount() int {
+	fake.describeTableMutex.RLock()
+	defer fake.describeTableMutex.RUnlock()
+	return len(fake.describeTableArgsForCall)
+}
+
+func (fake *FakeDynamoDBClient) DescribeTableCalls(stub func(context.Context, *dynamodb.DescribeTableInput, ...func(*dynamodb.Options)) (*dynamodb.DescribeTableOutput, error)) {
+	fake.describeTableMutex.Lock()
+	defer fake.describeTableMutex.Unlock()
+	fake.DescribeTableStub = stub
+}
+
+func (fake *FakeDynamoDBClient) DescribeTableArgsForCall(i int) (context.Context, *dynamodb.DescribeTableInput, []func(*dynamodb.Options)) {
+	fake.describeTableMutex.RLock()
+	defer fake.describeTableMutex.RUnlock()
+	argsForCall := fake.describeTableArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDynamoDBClient) DescribeTableReturns(result1 *dynamodb.DescribeTableOutput, result2 error) {
+	fake.describeTableMutex.Lock()
+	defer fake.describeTableMutex.Unlock()
+	fake.DescribeTableStub = nil
+	fake.describeTableReturns = struct {
+		result1 *dynamodb.DescribeTableOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDynamoDBClient) DescribeTableReturnsOnCall(i int, result1 *dynamodb.DescribeTableOutput, result2 error) {
+	fake.describeTableMutex.Lock()
+	defer fake.describeTableMutex.Unlock()
+	fake.DescribeTableStub = nil
+	if fake.describeTableReturnsOnCall == nil {
+		fake.describeTableReturnsOnCall = make(map[int]struct {
+			result1 *dynamodb.DescribeTableOutput
+			result2 error
+		})
+	}
+	fake.describeTableReturnsOnCall[i] = struct {
+		result1 *dynamodb.DescribeTableOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDynamoDBClient) DescribeTimeToLive(arg1 context.Context, arg2 *dynamodb.DescribeTimeToLiveInput, arg3 ...func(options *dynamodb.Options)) (*dynamodb.DescribeTimeToLiveOutput, error) {
+	fake.describeTimeToLiveMutex.Lock()
+	ret, specificReturn := fake.describeTimeToLiveReturnsOnCall[len(fake.describeTimeToLiveArgsForCall)]
+	fake.describeTimeToLiveArgsForCall = append(fake.describeTimeToLiveArgsForCall, struct {
+		arg1 context.Context
+		arg2 *dynamodb.DescribeTimeToLiveInput
+		arg3 []func(options *dynamodb.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.DescribeTimeToLiveStub
+	fakeReturns := fake.describeTimeToLiveReturns
+	fake.recordInvocation("DescribeTimeToLive", []interface{}{arg1, arg2, arg3})
+	fake.describeTimeToLiveMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDynamoDBClient) DescribeTimeToLiveCallCount() int {
+	fake.describeTimeToLiveMutex.RLock()
+	defer fake.describeTimeToLiveMutex.RUnlock()
+	return len(fake.describeTimeToLiveArgsForCall)
+}
+
+func (fake *FakeDynamoDBClient) DescribeTimeToLiveCalls(stub func(context.Context, *dynamodb.DescribeTimeToLiveInput, ...func(options *dynamodb.Options)) (*dynamodb.DescribeTimeToLiveOutput, error)) {
+	fake.describeTimeToLiveMutex.Lock()
+	defer fake.describeTimeToLiveMutex.Unlock()
+	fake.DescribeTimeToLiveStub = stub
+}
+
+func (fake *FakeDynamoDBClient) DescribeTimeToLiveArgsForCall(i int) (context.Context, *dynamodb.DescribeTimeToLiveInput, []func(options *dynamodb.Options)) {
+	fake.describeTimeToLiveMutex.RLock()
+	defer fake.describeTimeToLiveMutex.RUnlock()
+	argsForCall := fake.describeTimeToLiveArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDynamoDBClient) DescribeTimeToLiveReturns(result1 *dynamodb.DescribeTimeToLiveOutput, result2 error) {
+	fake.describeTimeToLiveMutex.Lock()
+	defer fake.describeTimeToLiveMutex.Unlock()
+	fake.DescribeTimeToLiveStub = nil
+	fake.describeTimeToLiveReturns = struct {
+		result1 *dynamodb.DescribeTimeToLiveOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDynamoDBClient) DescribeTimeToLiveReturnsOnCall(i int, result1 *dynamodb.DescribeTimeToLiveOutput, result2 error) {
+	fake.describeTimeToLiveMutex.Lock()
+	defer fake.describeTimeToLiveMutex.Unlock()
+	fake.DescribeTimeToLiveStub = nil
+	if fake.describeTimeToLiveReturnsOnCall == nil {
+		fake.describeTimeToLiveReturnsOnCall = make(map[int]struct {
+			result1 *dynamodb.DescribeTimeToLiveOutput
+			result2 error
+		})
+	}
+	fake.describeTimeToLiveReturnsOnCall[i] = struct {
+		result1 *dynamodb.DescribeTimeToLiveOutput
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDynamoDBClient) ListTables(arg1 context.Context, arg2 *dynamodb.ListTablesInput, arg3 ...func(*dynamodb.Options)) (*dynamodb.ListTablesOutput, error) {
 	fake.listTablesMutex.Lock()
 	ret, specificReturn := fake.listTablesReturnsOnCall[len(fake.listTablesArgsForCall)]
@@ -178,13 +724,297 @@ func (fake *FakeDynamoDBClient) ListTablesReturnsOnCall(i int, result1 *dynamodb
 	}{result1, result2}
 }
 
+func (fake *FakeDynamoDBClient) ListTagsOfResource(arg1 context.Context, arg2 *dynamodb.ListTagsOfResourceInput, arg3 ...func(options *dynamodb.Options)) (*dynamodb.ListTagsOfResourceOutput, error) {
+	fake.listTagsOfResourceMutex.Lock()
+	ret, specificReturn := fake.listTagsOfResourceReturnsOnCall[len(fake.listTagsOfResourceArgsForCall)]
+	fake.listTagsOfResourceArgsForCall = append(fake.listTagsOfResourceArgsForCall, struct {
+		arg1 context.Context
+		arg2 *dynamodb.ListTagsOfResourceInput
+		arg3 []func(options *dynamodb.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.ListTagsOfResourceStub
+	fakeReturns := fake.listTagsOfResourceReturns
+	fake.recordInvocation("ListTagsOfResource", []interface{}{arg1, arg2, arg3})
+	fake.listTagsOfResourceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDynamoDBClient) ListTagsOfResourceCallCount() int {
+	fake.listTagsOfResourceMutex.RLock()
+	defer fake.listTagsOfResourceMutex.RUnlock()
+	return len(fake.listTagsOfResourceArgsForCall)
+}
+
+func (fake *FakeDynamoDBClient) ListTagsOfResourceCalls(stub func(context.Context, *dynamodb.ListTagsOfResourceInput, ...func(options *dynamodb.Options)) (*dynamodb.ListTagsOfResourceOutput, error)) {
+	fake.listTagsOfResourceMutex.Lock()
+	defer fake.listTagsOfResourceMutex.Unlock()
+	fake.ListTagsOfResourceStub = stub
+}
+
+func (fake *FakeDynamoDBClient) ListTagsOfResourceArgsForCall(i int) (context.Context, *dynamodb.ListTagsOfResourceInput, []func(options *dynamodb.Options)) {
+	fake.listTagsOfResourceMutex.RLock()
+	defer fake.listTagsOfResourceMutex.RUnlock()
+	argsForCall := fake.listTagsOfResourceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDynamoDBClient) ListTagsOfResourceReturns(result1 *dynamodb.ListTagsOfResourceOutput, result2 error) {
+	fake.listTagsOfResourceMutex.Lock()
+	defer fake.listTagsOfResourceMutex.Unlock()
+	fake.ListTagsOfResourceStub = nil
+	fake.listTagsOfResourceReturns = struct {
+		result1 *dynamodb.ListTagsOfResourceOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDynamoDBClient) ListTagsOfResourceReturnsOnCall(i int, result1 *dynamodb.ListTagsOfResourceOutput, result2 error) {
+	fake.listTagsOfResourceMutex.Lock()
+	defer fake.listTagsOfResourceMutex.Unlock()
+	fake.ListTagsOfResourceStub = nil
+	if fake.listTagsOfResourceReturnsOnCall == nil {
+		fake.listTagsOfResourceReturnsOnCall = make(map[int]struct {
+			result1 *dynamodb.ListTagsOfResourceOutput
+			result2 error
+		})
+	}
+	fake.listTagsOfResourceReturnsOnCall[i] = struct {
+		result1 *dynamodb.ListTagsOfResourceOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDynamoDBClient) UpdateContinuousBackups(arg1 context.Context, arg2 *dynamodb.UpdateContinuousBackupsInput, arg3 ...func(options *dynamodb.Options)) (*dynamodb.UpdateContinuousBackupsOutput, error) {
+	fake.updateContinuousBackupsMutex.Lock()
+	ret, specificReturn := fake.updateContinuousBackupsReturnsOnCall[len(fake.updateContinuousBackupsArgsForCall)]
+	fake.updateContinuousBackupsArgsForCall = append(fake.updateContinuousBackupsArgsForCall, struct {
+		arg1 context.Context
+		arg2 *dynamodb.UpdateContinuousBackupsInput
+		arg3 []func(options *dynamodb.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateContinuousBackupsStub
+	fakeReturns := fake.updateContinuousBackupsReturns
+	fake.recordInvocation("UpdateContinuousBackups", []interface{}{arg1, arg2, arg3})
+	fake.updateContinuousBackupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDynamoDBClient) UpdateContinuousBackupsCallCount() int {
+	fake.updateContinuousBackupsMutex.RLock()
+	defer fake.updateContinuousBackupsMutex.RUnlock()
+	return len(fake.updateContinuousBackupsArgsForCall)
+}
+
+func (fake *FakeDynamoDBClient) UpdateContinuousBackupsCalls(stub func(context.Context, *dynamodb.UpdateContinuousBackupsInput, ...func(options *dynamodb.Options)) (*dynamodb.UpdateContinuousBackupsOutput, error)) {
+	fake.updateContinuousBackupsMutex.Lock()
+	defer fake.updateContinuousBackupsMutex.Unlock()
+	fake.UpdateContinuousBackupsStub = stub
+}
+
+func (fake *FakeDynamoDBClient) UpdateContinuousBackupsArgsForCall(i int) (context.Context, *dynamodb.UpdateContinuousBackupsInput, []func(options *dynamodb.Options)) {
+	fake.updateContinuousBackupsMutex.RLock()
+	defer fake.updateContinuousBackupsMutex.RUnlock()
+	argsForCall := fake.updateContinuousBackupsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDynamoDBClient) UpdateContinuousBackupsReturns(result1 *dynamodb.UpdateContinuousBackupsOutput, result2 error) {
+	fake.updateContinuousBackupsMutex.Lock()
+	defer fake.updateContinuousBackupsMutex.Unlock()
+	fake.UpdateContinuousBackupsStub = nil
+	fake.updateContinuousBackupsReturns = struct {
+		result1 *dynamodb.UpdateContinuousBackupsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDynamoDBClient) UpdateContinuousBackupsReturnsOnCall(i int, result1 *dynamodb.UpdateContinuousBackupsOutput, result2 error) {
+	fake.updateContinuousBackupsMutex.Lock()
+	defer fake.updateContinuousBackupsMutex.Unlock()
+	fake.UpdateContinuousBackupsStub = nil
+	if fake.updateContinuousBackupsReturnsOnCall == nil {
+		fake.updateContinuousBackupsReturnsOnCall = make(map[int]struct {
+			result1 *dynamodb.UpdateContinuousBackupsOutput
+			result2 error
+		})
+	}
+	fake.updateContinuousBackupsReturnsOnCall[i] = struct {
+		result1 *dynamodb.UpdateContinuousBackupsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDynamoDBClient) UpdateTable(arg1 context.Context, arg2 *dynamodb.UpdateTableInput, arg3 ...func(options *dynamodb.Options)) (*dynamodb.UpdateTableOutput, error) {
+	fake.updateTableMutex.Lock()
+	ret, specificReturn := fake.updateTableReturnsOnCall[len(fake.updateTableArgsForCall)]
+	fake.updateTableArgsForCall = append(fake.updateTableArgsForCall, struct {
+		arg1 context.Context
+		arg2 *dynamodb.UpdateTableInput
+		arg3 []func(options *dynamodb.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateTableStub
+	fakeReturns := fake.updateTableReturns
+	fake.recordInvocation("UpdateTable", []interface{}{arg1, arg2, arg3})
+	fake.updateTableMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDynamoDBClient) UpdateTableCallCount() int {
+	fake.updateTableMutex.RLock()
+	defer fake.updateTableMutex.RUnlock()
+	return len(fake.updateTableArgsForCall)
+}
+
+func (fake *FakeDynamoDBClient) UpdateTableCalls(stub func(context.Context, *dynamodb.UpdateTableInput, ...func(options *dynamodb.Options)) (*dynamodb.UpdateTableOutput, error)) {
+	fake.updateTableMutex.Lock()
+	defer fake.updateTableMutex.Unlock()
+	fake.UpdateTableStub = stub
+}
+
+func (fake *FakeDynamoDBClient) UpdateTableArgsForCall(i int) (context.Context, *dynamodb.UpdateTableInput, []func(options *dynamodb.Options)) {
+	fake.updateTableMutex.RLock()
+	defer fake.updateTableMutex.RUnlock()
+	argsForCall := fake.updateTableArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDynamoDBClient) UpdateTableReturns(result1 *dynamodb.UpdateTableOutput, result2 error) {
+	fake.updateTableMutex.Lock()
+	defer fake.updateTableMutex.Unlock()
+	fake.UpdateTableStub = nil
+	fake.updateTableReturns = struct {
+		result1 *dynamodb.UpdateTableOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDynamoDBClient) UpdateTableReturnsOnCall(i int, result1 *dynamodb.UpdateTableOutput, result2 error) {
+	fake.updateTableMutex.Lock()
+	defer fake.updateTableMutex.Unlock()
+	fake.UpdateTableStub = nil
+	if fake.updateTableReturnsOnCall == nil {
+		fake.updateTableReturnsOnCall = make(map[int]struct {
+			result1 *dynamodb.UpdateTableOutput
+			result2 error
+		})
+	}
+	fake.updateTableReturnsOnCall[i] = struct {
+		result1 *dynamodb.UpdateTableOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDynamoDBClient) UpdateTimeToLive(arg1 context.Context, arg2 *dynamodb.UpdateTimeToLiveInput, arg3 ...func(options *dynamodb.Options)) (*dynamodb.UpdateTimeToLiveOutput, error) {
+	fake.updateTimeToLiveMutex.Lock()
+	ret, specificReturn := fake.updateTimeToLiveReturnsOnCall[len(fake.updateTimeToLiveArgsForCall)]
+	fake.updateTimeToLiveArgsForCall = append(fake.updateTimeToLiveArgsForCall, struct {
+		arg1 context.Context
+		arg2 *dynamodb.UpdateTimeToLiveInput
+		arg3 []func(options *dynamodb.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateTimeToLiveStub
+	fakeReturns := fake.updateTimeToLiveReturns
+	fake.recordInvocation("UpdateTimeToLive", []interface{}{arg1, arg2, arg3})
+	fake.updateTimeToLiveMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDynamoDBClient) UpdateTimeToLiveCallCount() int {
+	fake.updateTimeToLiveMutex.RLock()
+	defer fake.updateTimeToLiveMutex.RUnlock()
+	return len(fake.updateTimeToLiveArgsForCall)
+}
+
+func (fake *FakeDynamoDBClient) UpdateTimeToLiveCalls(stub func(context.Context, *dynamodb.UpdateTimeToLiveInput, ...func(options *dynamodb.Options)) (*dynamodb.UpdateTimeToLiveOutput, error)) {
+	fake.updateTimeToLiveMutex.Lock()
+	defer fake.updateTimeToLiveMutex.Unlock()
+	fake.UpdateTimeToLiveStub = stub
+}
+
+func (fake *FakeDynamoDBClient) UpdateTimeToLiveArgsForCall(i int) (context.Context, *dynamodb.UpdateTimeToLiveInput, []func(options *dynamodb.Options)) {
+	fake.updateTimeToLiveMutex.RLock()
+	defer fake.updateTimeToLiveMutex.RUnlock()
+	argsForCall := fake.updateTimeToLiveArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDynamoDBClient) UpdateTimeToLiveReturns(result1 *dynamodb.UpdateTimeToLiveOutput, result2 error) {
+	fake.updateTimeToLiveMutex.Lock()
+	defer fake.updateTimeToLiveMutex.Unlock()
+	fake.UpdateTimeToLiveStub = nil
+	fake.updateTimeToLiveReturns = struct {
+		result1 *dynamodb.UpdateTimeToLiveOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDynamoDBClient) UpdateTimeToLiveReturnsOnCall(i int, result1 *dynamodb.UpdateTimeToLiveOutput, result2 error) {
+	fake.updateTimeToLiveMutex.Lock()
+	defer fake.updateTimeToLiveMutex.Unlock()
+	fake.UpdateTimeToLiveStub = nil
+	if fake.updateTimeToLiveReturnsOnCall == nil {
+		fake.updateTimeToLiveReturnsOnCall = make(map[int]struct {
+			result1 *dynamodb.UpdateTimeToLiveOutput
+			result2 error
+		})
+	}
+	fake.updateTimeToLiveReturnsOnCall[i] = struct {
+		result1 *dynamodb.UpdateTimeToLiveOutput
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDynamoDBClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.createBackupMutex.RLock()
+	defer fake.createBackupMutex.RUnlock()
+	fake.createTableMutex.RLock()
+	defer fake.createTableMutex.RUnlock()
 	fake.deleteTableMutex.RLock()
 	defer fake.deleteTableMutex.RUnlock()
+	fake.describeBackupMutex.RLock()
+	defer fake.describeBackupMutex.RUnlock()
+	fake.describeContinuousBackupsMutex.RLock()
+	defer fake.describeContinuousBackupsMutex.RUnlock()
+	fake.describeTableMutex.RLock()
+	defer fake.describeTableMutex.RUnlock()
+	fake.describeTimeToLiveMutex.RLock()
+	defer fake.describeTimeToLiveMutex.RUnlock()
 	fake.listTablesMutex.RLock()
 	defer fake.listTablesMutex.RUnlock()
+	fake.listTagsOfResourceMutex.RLock()
+	defer fake.listTagsOfResourceMutex.RUnlock()
+	fake.updateContinuousBackupsMutex.RLock()
+	defer fake.updateContinuousBackupsMutex.RUnlock()
+	fake.updateTableMutex.RLock()
+	defer fake.updateTableMutex.RUnlock()
+	fake.updateTimeToLiveMutex.RLock()
+	defer fake.updateTimeToLiveMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -26,6 +26,26 @@ type FakeDynamoDBConfig struct {
 		result1 csbdynamodbns.DynamoDBClient
 		result2 error
 	}
+	GetDeleteModeStub        func() string
+	getDeleteModeMutex       sync.RWMutex
+	getDeleteModeArgsForCall []struct {
+	}
+	getDeleteModeReturns struct {
+		result1 string
+	}
+	getDeleteModeReturnsOnCall map[int]struct {
+		result1 string
+	}
+	GetKeepTagStub        func() string
+	getKeepTagMutex       sync.RWMutex
+	getKeepTagArgsForCall []struct {
+	}
+	getKeepTagReturns struct {
+		result1 string
+	}
+	getKeepTagReturnsOnCall map[int]struct {
+		result1 string
+	}
 	GetPrefixStub        func() string
 	getPrefixMutex       sync.RWMutex
 	getPrefixArgsForCall []struct {
@@ -36,6 +56,16 @@ type FakeDynamoDBConfig struct {
 	getPrefixReturnsOnCall map[int]struct {
 		result1 string
 	}
+	GetRegionStub        func() string
+	getRegionMutex       sync.RWMutex
+	getRegionArgsForCall []struct {
+	}
+	getRegionReturns struct {
+		result1 string
+	}
+	getRegionReturnsOnCall map[int]struct {
+		result1 string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -106,6 +136,112 @@ func (fake *FakeDynamoDBConfig) GetClientReturnsOnCall(i int, result1 csbdynamod
 	}{result1, result2}
 }
 
+func (fake *FakeDynamoDBConfig) GetDeleteMode() string {
+	fake.getDeleteModeMutex.Lock()
+	ret, specificReturn := fake.getDeleteModeReturnsOnCall[len(fake.getDeleteModeArgsForCall)]
+	fake.getDeleteModeArgsForCall = append(fake.getDeleteModeArgsForCall, struct {
+	}{})
+	stub := fake.GetDeleteModeStub
+	fakeReturns := fake.getDeleteModeReturns
+	fake.recordInvocation("GetDeleteMode", []interface{}{})
+	fake.getDeleteModeMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDynamoDBConfig) GetDeleteModeCallCount() int {
+	fake.getDeleteModeMutex.RLock()
+	defer fake.getDeleteModeMutex.RUnlock()
+	return len(fake.getDeleteModeArgsForCall)
+}
+
+func (fake *FakeDynamoDBConfig) GetDeleteModeCalls(stub func() string) {
+	fake.getDeleteModeMutex.Lock()
+	defer fake.getDeleteModeMutex.Unlock()
+	fake.GetDeleteModeStub = stub
+}
+
+func (fake *FakeDynamoDBConfig) GetDeleteModeReturns(result1 string) {
+	fake.getDeleteModeMutex.Lock()
+	defer fake.getDeleteModeMutex.Unlock()
+	fake.GetDeleteModeStub = nil
+	fake.getDeleteModeReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeDynamoDBConfig) GetDeleteModeReturnsOnCall(i int, result1 string) {
+	fake.getDeleteModeMutex.Lock()
+	defer fake.getDeleteModeMutex.Unlock()
+	fake.GetDeleteModeStub = nil
+	if fake.getDeleteModeReturnsOnCall == nil {
+		fake.getDeleteModeReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.getDeleteModeReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeDynamoDBConfig) GetKeepTag() string {
+	fake.getKeepTagMutex.Lock()
+	ret, specificReturn := fake.getKeepTagReturnsOnCall[len(fake.getKeepTagArgsForCall)]
+	fake.getKeepTagArgsForCall = append(fake.getKeepTagArgsForCall, struct {
+	}{})
+	stub := fake.GetKeepTagStub
+	fakeReturns := fake.getKeepTagReturns
+	fake.recordInvocation("GetKeepTag", []interface{}{})
+	fake.getKeepTagMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDynamoDBConfig) GetKeepTagCallCount() int {
+	fake.getKeepTagMutex.RLock()
+	defer fake.getKeepTagMutex.RUnlock()
+	return len(fake.getKeepTagArgsForCall)
+}
+
+func (fake *FakeDynamoDBConfig) GetKeepTagCalls(stub func() string) {
+	fake.getKeepTagMutex.Lock()
+	defer fake.getKeepTagMutex.Unlock()
+	fake.GetKeepTagStub = stub
+}
+
+func (fake *FakeDynamoDBConfig) GetKeepTagReturns(result1 string) {
+	fake.getKeepTagMutex.Lock()
+	defer fake.getKeepTagMutex.Unlock()
+	fake.GetKeepTagStub = nil
+	fake.getKeepTagReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeDynamoDBConfig) GetKeepTagReturnsOnCall(i int, result1 string) {
+	fake.getKeepTagMutex.Lock()
+	defer fake.getKeepTagMutex.Unlock()
+	fake.GetKeepTagStub = nil
+	if fake.getKeepTagReturnsOnCall == nil {
+		fake.getKeepTagReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.getKeepTagReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeDynamoDBConfig) GetPrefix() string {
 	fake.getPrefixMutex.Lock()
 	ret, specificReturn := fake.getPrefixReturnsOnCall[len(fake.getPrefixArgsForCall)]
@@ -159,13 +295,72 @@ func (fake *FakeDynamoDBConfig) GetPrefixReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeDynamoDBConfig) GetRegion() string {
+	fake.getRegionMutex.Lock()
+	ret, specificReturn := fake.getRegionReturnsOnCall[len(fake.getRegionArgsForCall)]
+	fake.getRegionArgsForCall = append(fake.getRegionArgsForCall, struct {
+	}{})
+	stub := fake.GetRegionStub
+	fakeReturns := fake.getRegionReturns
+	fake.recordInvocation("GetRegion", []interface{}{})
+	fake.getRegionMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDynamoDBConfig) GetRegionCallCount() int {
+	fake.getRegionMutex.RLock()
+	defer fake.getRegionMutex.RUnlock()
+	return len(fake.getRegionArgsForCall)
+}
+
+func (fake *FakeDynamoDBConfig) GetRegionCalls(stub func() string) {
+	fake.getRegionMutex.Lock()
+	defer fake.getRegionMutex.Unlock()
+	fake.GetRegionStub = stub
+}
+
+func (fake *FakeDynamoDBConfig) GetRegionReturns(result1 string) {
+	fake.getRegionMutex.Lock()
+	defer fake.getRegionMutex.Unlock()
+	fake.GetRegionStub = nil
+	fake.getRegionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeDynamoDBConfig) GetRegionReturnsOnCall(i int, result1 string) {
+	fake.getRegionMutex.Lock()
+	defer fake.getRegionMutex.Unlock()
+	fake.GetRegionStub = nil
+	if fake.getRegionReturnsOnCall == nil {
+		fake.getRegionReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.getRegionReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeDynamoDBConfig) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.getClientMutex.RLock()
 	defer fake.getClientMutex.RUnlock()
+	fake.getDeleteModeMutex.RLock()
+	defer fake.getDeleteModeMutex.RUnlock()
+	fake.getKeepTagMutex.RLock()
+	defer fake.getKeepTagMutex.RUnlock()
 	fake.getPrefixMutex.RLock()
 	defer fake.getPrefixMutex.RUnlock()
+	fake.getRegionMutex.RLock()
+	defer fake.getRegionMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,163 @@
+package csbdynamodbns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	MaxTablesKey    = "max_tables"
+	MaxSizeBytesKey = "max_size_bytes"
+
+	tableCountKey         = "table_count"
+	sizeBytesKey          = "size_bytes"
+	itemCountKey          = "item_count"
+	readCapacityUnitsKey  = "read_capacity_units"
+	writeCapacityUnitsKey = "write_capacity_units"
+)
+
+func DataSourceDynamoDBNSUsage() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			AwsAccessKeyIDKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "When not specified, the default AWS credentials chain is used",
+			},
+			AwsSecretAccessKeyKey: {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			MaxTablesKey: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Fail when the namespace has more tables than this. Not enforced when not specified",
+			},
+			MaxSizeBytesKey: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Fail when the tables in the namespace use more storage than this. Not enforced when not specified",
+			},
+			tableCountKey: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			sizeBytesKey: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			itemCountKey: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			readCapacityUnitsKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Provisioned read capacity of the tables and their global secondary indexes",
+			},
+			writeCapacityUnitsKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Provisioned write capacity of the tables and their global secondary indexes",
+			},
+		},
+		ReadContext: DataSourceDynamoDBNSUsageRead,
+		Description: "Aggregated usage of the tables in the namespace",
+	}
+}
+
+type namespaceUsage struct {
+	tables             int
+	sizeBytes          int64
+	items              int64
+	readCapacityUnits  int64
+	writeCapacityUnits int64
+}
+
+func DataSourceDynamoDBNSUsageRead(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	settings := config.(DynamoDBConfig)
+	client, err := tableClient(ctx, data, settings)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tableNames, err := listPrefixedTables(ctx, client, settings.GetPrefix())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var usage namespaceUsage
+	for _, tableName := range tableNames {
+		output, err := client.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(tableName)})
+		switch {
+		case isNotFound(err):
+			// The table was deleted between listing and describing it
+			continue
+		case err != nil:
+			return diag.FromErr(err)
+		}
+		usage.add(output.Table)
+	}
+
+	data.SetId(settings.GetPrefix())
+	for k, v := range map[string]any{
+		tableCountKey:         usage.tables,
+		sizeBytesKey:          int(usage.sizeBytes),
+		itemCountKey:          int(usage.items),
+		readCapacityUnitsKey:  int(usage.readCapacityUnits),
+		writeCapacityUnitsKey: int(usage.writeCapacityUnits),
+	} {
+		if err := data.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return usage.checkLimits(data, settings.GetPrefix())
+}
+
+func (u *namespaceUsage) add(table *types.TableDescription) {
+	u.tables++
+	u.sizeBytes += aws.ToInt64(table.TableSizeBytes)
+	u.items += aws.ToInt64(table.ItemCount)
+
+	// On-demand tables report zero provisioned capacity
+	if table.ProvisionedThroughput != nil {
+		u.readCapacityUnits += aws.ToInt64(table.ProvisionedThroughput.ReadCapacityUnits)
+		u.writeCapacityUnits += aws.ToInt64(table.ProvisionedThroughput.WriteCapacityUnits)
+	}
+	for _, index := range table.GlobalSecondaryIndexes {
+		if index.ProvisionedThroughput != nil {
+			u.readCapacityUnits += aws.ToInt64(index.ProvisionedThroughput.ReadCapacityUnits)
+			u.writeCapacityUnits += aws.ToInt64(index.ProvisionedThroughput.WriteCapacityUnits)
+		}
+	}
+}
+
+func (u *namespaceUsage) checkLimits(data *schema.ResourceData, prefix string) diag.Diagnostics {
+	var d diag.Diagnostics
+	if maxTables, ok := data.GetOk(MaxTablesKey); ok && u.tables > maxTables.(int) {
+		d = append(d, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "namespace table limit exceeded",
+			Detail:   fmt.Sprintf("the namespace of prefix %q has %d tables, which is more than the limit of %d", prefix, u.tables, maxTables.(int)),
+		})
+	}
+	if maxSizeBytes, ok := data.GetOk(MaxSizeBytesKey); ok && u.sizeBytes > int64(maxSizeBytes.(int)) {
+		d = append(d, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "namespace size limit exceeded",
+			Detail:   fmt.Sprintf("the tables in the namespace of prefix %q use %d bytes, which is more than the limit of %d bytes", prefix, u.sizeBytes, maxSizeBytes.(int)),
+		})
+	}
+	return d
+}
@@ -0,0 +1,105 @@
+package csbdynamodbns_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/smithy-go/ptr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pborman/uuid"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-dynamodbns/csbdynamodbns"
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-dynamodbns/csbdynamodbns/csbdynamodbnsfakes"
+)
+
+var _ = Describe("DataSourceDynamoDBNSUsage", func() {
+	var (
+		client *csbdynamodbnsfakes.FakeDynamoDBClient
+		config *csbdynamodbnsfakes.FakeDynamoDBConfig
+		data   *schema.ResourceData
+	)
+
+	BeforeEach(func() {
+		client = &csbdynamodbnsfakes.FakeDynamoDBClient{}
+
+		config = &csbdynamodbnsfakes.FakeDynamoDBConfig{}
+		config.GetClientReturns(client, nil)
+		config.GetPrefixReturns(fmt.Sprintf("csb-%s-", uuid.New()))
+
+		data = csbdynamodbns.DataSourceDynamoDBNSUsage().TestResourceData()
+
+		prefix := config.GetPrefix()
+		client.ListTablesReturnsOnCall(0, &dynamodb.ListTablesOutput{
+			TableNames:             []string{prefix + "one", fmt.Sprintf("csb-%s-two", uuid.New())},
+			LastEvaluatedTableName: ptr.String("two"),
+		}, nil)
+		client.ListTablesReturnsOnCall(1, &dynamodb.ListTablesOutput{
+			TableNames: []string{prefix + "three", prefix + "four"},
+		}, nil)
+		client.DescribeTableReturnsOnCall(0, &dynamodb.DescribeTableOutput{Table: &types.TableDescription{
+			TableSizeBytes:        ptr.Int64(1000),
+			ItemCount:             ptr.Int64(10),
+			ProvisionedThroughput: &types.ProvisionedThroughputDescription{ReadCapacityUnits: ptr.Int64(5), WriteCapacityUnits: ptr.Int64(6)},
+			GlobalSecondaryIndexes: []types.GlobalSecondaryIndexDescription{{
+				ProvisionedThroughput: &types.ProvisionedThroughputDescription{ReadCapacityUnits: ptr.Int64(1), WriteCapacityUnits: ptr.Int64(2)},
+			}},
+		}}, nil)
+		client.DescribeTableReturnsOnCall(1, &dynamodb.DescribeTableOutput{Table: &types.TableDescription{
+			TableSizeBytes:        ptr.Int64(2000),
+			ItemCount:             ptr.Int64(20),
+			ProvisionedThroughput: &types.ProvisionedThroughputDescription{ReadCapacityUnits: ptr.Int64(0), WriteCapacityUnits: ptr.Int64(0)},
+		}}, nil)
+		client.DescribeTableReturnsOnCall(2, nil, &types.ResourceNotFoundException{Message: ptr.String("gone")})
+	})
+
+	It("aggregates the usage of the tables in the namespace", func() {
+		d := csbdynamodbns.DataSourceDynamoDBNSUsageRead(context.TODO(), data, config)
+		Expect(d).To(BeNil())
+
+		Expect(client.DescribeTableCallCount()).To(Equal(3))
+		Expect(data.Id()).To(Equal(config.GetPrefix()))
+		Expect(data.Get("table_count")).To(Equal(2))
+		Expect(data.Get("size_bytes")).To(Equal(3000))
+		Expect(data.Get("item_count")).To(Equal(30))
+		Expect(data.Get("read_capacity_units")).To(Equal(6))
+		Expect(data.Get("write_capacity_units")).To(Equal(8))
+	})
+
+	It("succeeds when the usage is within the limits", func() {
+		Expect(data.Set(csbdynamodbns.MaxTablesKey, 2)).To(Succeed())
+		Expect(data.Set(csbdynamodbns.MaxSizeBytesKey, 3000)).To(Succeed())
+
+		d := csbdynamodbns.DataSourceDynamoDBNSUsageRead(context.TODO(), data, config)
+		Expect(d).To(BeNil())
+	})
+
+	It("fails when the table limit is exceeded", func() {
+		Expect(data.Set(csbdynamodbns.MaxTablesKey, 1)).To(Succeed())
+
+		d := csbdynamodbns.DataSourceDynamoDBNSUsageRead(context.TODO(), data, config)
+		Expect(d).To(HaveLen(1))
+		Expect(d[0].Summary).To(Equal("namespace table limit exceeded"))
+		Expect(d[0].Detail).To(Equal(fmt.Sprintf("the namespace of prefix %q has 2 tables, which is more than the limit of 1", config.GetPrefix())))
+	})
+
+	It("fails when the size limit is exceeded", func() {
+		Expect(data.Set(csbdynamodbns.MaxSizeBytesKey, 2999)).To(Succeed())
+
+		d := csbdynamodbns.DataSourceDynamoDBNSUsageRead(context.TODO(), data, config)
+		Expect(d).To(HaveLen(1))
+		Expect(d[0].Summary).To(Equal("namespace size limit exceeded"))
+		Expect(d[0].Detail).To(Equal(fmt.Sprintf("the tables in the namespace of prefix %q use 3000 bytes, which is more than the limit of 2999 bytes", config.GetPrefix())))
+	})
+
+	It("reports errors", func() {
+		client.ListTablesReturnsOnCall(1, nil, fmt.Errorf("connection issues"))
+
+		d := csbdynamodbns.DataSourceDynamoDBNSUsageRead(context.TODO(), data, config)
+		Expect(d.HasError()).To(BeTrue())
+		Expect(d[0].Summary).To(Equal("connection issues"))
+	})
+})
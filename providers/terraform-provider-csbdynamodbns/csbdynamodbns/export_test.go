@@ -0,0 +1,16 @@
+package csbdynamodbns
+
+import "time"
+
+// ShortenDelays makes retries and waits fast enough for unit tests
+func ShortenDelays() (restore func()) {
+	base, maxRetry, minWait, maxWait := retryBaseDelay, retryMaxDelay, waiterMinDelay, waiterMaxDelay
+	retryBaseDelay, retryMaxDelay = time.Millisecond, 4*time.Millisecond
+	waiterMinDelay, waiterMaxDelay = time.Millisecond, 4*time.Millisecond
+
+	return func() {
+		retryBaseDelay, retryMaxDelay, waiterMinDelay, waiterMaxDelay = base, maxRetry, minWait, maxWait
+	}
+}
+
+var DiffGlobalSecondaryIndexes = diffGlobalSecondaryIndexes
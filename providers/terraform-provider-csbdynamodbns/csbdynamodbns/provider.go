@@ -5,15 +5,31 @@ import (
 	"context"
 	"net/url"
 	"regexp"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
 	awsRegionKey         = "region"
 	dynamoDBPrefixKey    = "prefix"
 	customEndpointURLKey = "custom_endpoint_url"
+	deleteModeKey        = "delete_mode"
+	keepTagKey           = "keep_tag"
+	roleARNKey           = "role_arn"
+	externalIDKey        = "external_id"
+	sessionNameKey       = "session_name"
+	webIdentityTokenKey  = "web_identity_token_file"
+	maxRetryAttemptsKey  = "max_retry_attempts"
+	maxRetryBackoffKey   = "max_retry_backoff"
+	httpTimeoutKey       = "http_timeout"
+	logRequestsKey       = "log_requests"
+
+	DeleteModeDelete            = "delete"
+	DeleteModeDryRun            = "dry_run"
+	DeleteModeRefuseIfProtected = "refuse_if_protected"
 )
 
 var identifierRegexp = regexp.MustCompile(`^[\w_.-]{1,64}$`)
@@ -33,10 +49,69 @@ func Provider() *schema.Provider {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			deleteModeKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      DeleteModeDelete,
+				ValidateFunc: validation.StringInSlice([]string{DeleteModeDelete, DeleteModeDryRun, DeleteModeRefuseIfProtected}, false),
+				Description:  "How tables are handled on deletion: \"delete\", \"dry_run\" or \"refuse_if_protected\"",
+			},
+			keepTagKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Tables with this tag are not deleted when the delete mode is \"refuse_if_protected\"",
+			},
+			roleARNKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ARN of a role to assume with STS instead of using the access keys of the resources directly",
+			},
+			externalIDKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{roleARNKey},
+			},
+			sessionNameKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{roleARNKey},
+			},
+			webIdentityTokenKey: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				RequiredWith:  []string{roleARNKey},
+				ConflictsWith: []string{externalIDKey},
+				Description:   "Path to a file containing an OIDC token used to assume the role with web identity",
+			},
+			maxRetryAttemptsKey: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum number of attempts for each request to AWS. The SDK default is used when not specified",
+			},
+			maxRetryBackoffKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Maximum delay between attempts, for example \"30s\". The SDK default is used when not specified",
+			},
+			httpTimeoutKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Timeout for each HTTP request to AWS, for example \"1m\". There is no timeout when not specified",
+			},
+			logRequestsKey: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Log every request to AWS and its response at debug level, with credentials redacted",
+			},
 		},
 		ConfigureContextFunc: providerConfigure,
 		ResourcesMap: map[string]*schema.Resource{
 			"csbdynamodbns_instance": ResourceDynamoDBNSInstance(),
+			"csbdynamodbns_table":    ResourceDynamoDBNSTable(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"csbdynamodbns_usage": DataSourceDynamoDBNSUsage(),
 		},
 	}
 }
@@ -47,6 +122,9 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (any, diag.Dia
 		region            string
 		prefix            string
 		customEndpointURL string
+		maxRetryAttempts  int
+		maxRetryBackoff   time.Duration
+		httpTimeout       time.Duration
 	)
 
 	for _, f := range []func() diag.Diagnostics{
@@ -68,6 +146,21 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (any, diag.Dia
 			}
 			return nil
 		},
+		func() diag.Diagnostics {
+			maxRetryAttempts = d.Get(maxRetryAttemptsKey).(int)
+			if maxRetryAttempts < 0 {
+				return diag.Errorf("invalid value %d for %q, it must not be negative", maxRetryAttempts, maxRetryAttemptsKey)
+			}
+			return nil
+		},
+		func() (dg diag.Diagnostics) {
+			maxRetryBackoff, dg = getDuration(d, maxRetryBackoffKey)
+			return
+		},
+		func() (dg diag.Diagnostics) {
+			httpTimeout, dg = getDuration(d, httpTimeoutKey)
+			return
+		},
 	} {
 		if dg := f(); dg != nil {
 			return nil, dg
@@ -78,6 +171,18 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (any, diag.Dia
 		region:            region,
 		prefix:            prefix,
 		customEndpointURL: customEndpointURL,
+		deleteMode:        d.Get(deleteModeKey).(string),
+		keepTag:           d.Get(keepTagKey).(string),
+		role: roleSettings{
+			arn:                  d.Get(roleARNKey).(string),
+			externalID:           d.Get(externalIDKey).(string),
+			sessionName:          d.Get(sessionNameKey).(string),
+			webIdentityTokenFile: d.Get(webIdentityTokenKey).(string),
+		},
+		maxRetryAttempts: maxRetryAttempts,
+		maxRetryBackoff:  maxRetryBackoff,
+		httpTimeout:      httpTimeout,
+		logRequests:      d.Get(logRequestsKey).(bool),
 	}
 
 	return settings, diags
@@ -92,3 +197,17 @@ func getIdentifier(d *schema.ResourceData, key string) (string, diag.Diagnostics
 
 	return s, nil
 }
+
+func getDuration(d *schema.ResourceData, key string) (time.Duration, diag.Diagnostics) {
+	s, ok := d.GetOk(key)
+	if !ok {
+		return 0, nil
+	}
+
+	duration, err := time.ParseDuration(s.(string))
+	if err != nil || duration <= 0 {
+		return 0, diag.Errorf("invalid value %q for %q, it must be a positive duration such as \"30s\"", s, key)
+	}
+
+	return duration, nil
+}
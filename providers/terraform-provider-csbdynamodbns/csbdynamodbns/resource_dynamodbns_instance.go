@@ -2,10 +2,14 @@ package csbdynamodbns
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -13,6 +17,18 @@ import (
 const (
 	AwsAccessKeyIDKey     = "access_key_id"
 	AwsSecretAccessKeyKey = "secret_access_key"
+	TablesKey             = "tables"
+	BackupBeforeDeleteKey = "backup_before_delete"
+
+	tableNameKey        = "name"
+	tableStatusKey      = "status"
+	tableBillingModeKey = "billing_mode"
+	tableItemCountKey   = "item_count"
+
+	defaultDeleteTimeout = 30 * time.Minute
+
+	// These are the characters other than letters and digits that are valid in table names
+	namespaceSeparators = "-_."
 )
 
 //go:generate go tool counterfeiter -generate
@@ -20,12 +36,93 @@ const (
 //counterfeiter:generate -header csbdynamodbnsfakes/header.txt . DynamoDBClient
 type DynamoDBClient interface {
 	dynamodb.ListTablesAPIClient
+	dynamodb.DescribeTableAPIClient
 	DeleteTable(context.Context, *dynamodb.DeleteTableInput, ...func(options *dynamodb.Options)) (*dynamodb.DeleteTableOutput, error)
+	CreateBackup(context.Context, *dynamodb.CreateBackupInput, ...func(options *dynamodb.Options)) (*dynamodb.CreateBackupOutput, error)
+	DescribeBackup(context.Context, *dynamodb.DescribeBackupInput, ...func(options *dynamodb.Options)) (*dynamodb.DescribeBackupOutput, error)
+	ListTagsOfResource(context.Context, *dynamodb.ListTagsOfResourceInput, ...func(options *dynamodb.Options)) (*dynamodb.ListTagsOfResourceOutput, error)
+	CreateTable(context.Context, *dynamodb.CreateTableInput, ...func(options *dynamodb.Options)) (*dynamodb.CreateTableOutput, error)
+	UpdateTable(context.Context, *dynamodb.UpdateTableInput, ...func(options *dynamodb.Options)) (*dynamodb.UpdateTableOutput, error)
+	DescribeTimeToLive(context.Context, *dynamodb.DescribeTimeToLiveInput, ...func(options *dynamodb.Options)) (*dynamodb.DescribeTimeToLiveOutput, error)
+	UpdateTimeToLive(context.Context, *dynamodb.UpdateTimeToLiveInput, ...func(options *dynamodb.Options)) (*dynamodb.UpdateTimeToLiveOutput, error)
+	DescribeContinuousBackups(context.Context, *dynamodb.DescribeContinuousBackupsInput, ...func(options *dynamodb.Options)) (*dynamodb.DescribeContinuousBackupsOutput, error)
+	UpdateContinuousBackups(context.Context, *dynamodb.UpdateContinuousBackupsInput, ...func(options *dynamodb.Options)) (*dynamodb.UpdateContinuousBackupsOutput, error)
 }
 
 var _ DynamoDBClient = &dynamodb.Client{}
 
 func ResourceDynamoDBNSInstance() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			AwsAccessKeyIDKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{AwsSecretAccessKeyKey},
+				Description:  "When not specified, the default AWS credentials chain or the provider role is used",
+			},
+			AwsSecretAccessKeyKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{AwsAccessKeyIDKey},
+			},
+			BackupBeforeDeleteKey: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to take an on-demand backup of each table before deleting it",
+			},
+			TablesKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Tables that currently exist in the namespace",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						tableNameKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tableStatusKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tableBillingModeKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tableItemCountKey: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		CreateContext: resourceDynamoDBNSInstanceCreate,
+		UpdateContext: resourceDynamoDBNSInstanceUpdate,
+		ReadContext:   ResourceDynamoDBNSInstanceRead,
+		DeleteContext: ResourceDynamoDBMaintenanceDelete,
+		Description:   "Handles DynamoDB namespace housekeeping",
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDynamoDBNSInstanceImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceDynamoDBNSInstanceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceDynamoDBNSInstanceStateUpgradeV0,
+			},
+		},
+	}
+}
+
+// resourceDynamoDBNSInstanceV0 is the schema as released before the ID identified the namespace,
+// when the ID was the access key ID and both credentials were required
+func resourceDynamoDBNSInstanceV0() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			AwsAccessKeyIDKey: {
@@ -37,17 +134,102 @@ func ResourceDynamoDBNSInstance() *schema.Resource {
 				Required: true,
 			},
 		},
-		CreateContext: setResourceID,
-		UpdateContext: setResourceID,
-		ReadContext:   setResourceID,
-		DeleteContext: ResourceDynamoDBMaintenanceDelete,
-		Description:   "Handles DynamoDB namespace housekeeping",
 	}
 }
 
-func setResourceID(_ context.Context, data *schema.ResourceData, _ any) diag.Diagnostics {
-	keyID := data.Get(AwsAccessKeyIDKey).(string)
-	data.SetId(keyID)
+// resourceDynamoDBNSInstanceStateUpgradeV0 replaces the access key ID with the region and prefix
+// as the ID, so that existing instances are not replaced when the key is rotated. It is the only
+// place where the ID of an existing instance changes. Version 0 never took backups, so the new
+// attribute is set to match rather than showing up as a change in the next plan.
+func resourceDynamoDBNSInstanceStateUpgradeV0(_ context.Context, rawState map[string]any, config any) (map[string]any, error) {
+	if rawState == nil {
+		rawState = map[string]any{}
+	}
+	rawState["id"] = resourceID(config.(DynamoDBConfig))
+	rawState[BackupBeforeDeleteKey] = false
+	return rawState, nil
+}
+
+// resourceDynamoDBNSInstanceImport accepts an ID of the form "region/prefix". As the provider
+// is configured for a single namespace, the ID must match the provider configuration.
+func resourceDynamoDBNSInstanceImport(_ context.Context, data *schema.ResourceData, config any) ([]*schema.ResourceData, error) {
+	settings := config.(DynamoDBConfig)
+
+	region, prefix, ok := strings.Cut(data.Id(), "/")
+	if !ok || region == "" || prefix == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected \"region/prefix\"", data.Id())
+	}
+	if region != settings.GetRegion() || prefix != settings.GetPrefix() {
+		return nil, fmt.Errorf("import ID %q does not match the provider region %q and prefix %q", data.Id(), settings.GetRegion(), settings.GetPrefix())
+	}
+
+	setResourceID(data, settings)
+	if err := data.Set(BackupBeforeDeleteKey, false); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{data}, nil
+}
+
+// resourceDynamoDBNSInstanceCreate does not call AWS: the access key was created moments ago and,
+// as IAM is eventually consistent, may not be accepted yet. The next refresh lists the tables.
+func resourceDynamoDBNSInstanceCreate(_ context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	setResourceID(data, config.(DynamoDBConfig))
+	if err := data.Set(TablesKey, []map[string]any{}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceDynamoDBNSInstanceUpdate keeps the tables from the state, for the same reason as Create:
+// an update usually comes with a new access key
+func resourceDynamoDBNSInstanceUpdate(_ context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	setResourceID(data, config.(DynamoDBConfig))
+	return nil
+}
+
+// setResourceID identifies the namespace rather than the credentials, so that rotating the key
+// does not change the ID
+func setResourceID(data *schema.ResourceData, settings DynamoDBConfig) {
+	data.SetId(resourceID(settings))
+}
+
+func resourceID(settings DynamoDBConfig) string {
+	return fmt.Sprintf("%s/%s", settings.GetRegion(), settings.GetPrefix())
+}
+
+// ResourceDynamoDBNSInstanceRead lists the tables in the namespace so that changes made by
+// the service users show up as drift in the computed "tables" attribute
+func ResourceDynamoDBNSInstanceRead(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	settings := config.(DynamoDBConfig)
+	client, err := settings.GetClient(ctx, data.Get(AwsAccessKeyIDKey).(string), data.Get(AwsSecretAccessKeyKey).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tableNames, err := listPrefixedTables(ctx, client, settings.GetPrefix())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tables := make([]map[string]any, 0, len(tableNames))
+	for _, tableName := range tableNames {
+		output, err := client.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(tableName)})
+		switch {
+		case isNotFound(err):
+			// The table was deleted between listing and describing it
+			continue
+		case err != nil:
+			return diag.FromErr(err)
+		}
+		tables = append(tables, flattenTableDescription(output.Table))
+	}
+
+	if err := data.Set(TablesKey, tables); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -57,9 +239,10 @@ func ResourceDynamoDBMaintenanceDelete(ctx context.Context, data *schema.Resourc
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	paginator := dynamodb.NewListTablesPaginator(client, &dynamodb.ListTablesInput{}, func(o *dynamodb.ListTablesPaginatorOptions) {
-		o.StopOnDuplicateToken = true
-	})
+	paginator := newListTablesPaginator(client)
+
+	ctx, cancel := context.WithTimeout(ctx, data.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
 	d := diag.Diagnostics{}
 	for paginator.HasMorePages() {
@@ -68,17 +251,84 @@ func ResourceDynamoDBMaintenanceDelete(ctx context.Context, data *schema.Resourc
 			// We have to return immediately in order to avoid an infinite loop
 			return append(d, diag.Diagnostic{Severity: diag.Error, Summary: err.Error()})
 		}
+		var tableNames []string
 		for _, tableName := range page.TableNames {
-			if strings.HasPrefix(tableName, settings.GetPrefix()) {
-				_, err := client.DeleteTable(ctx, &dynamodb.DeleteTableInput{TableName: aws.String(tableName)})
-				if err != nil {
-					d = append(d, diag.Diagnostic{Severity: diag.Error, Summary: err.Error()})
-				}
+			if inNamespace(tableName, settings.GetPrefix()) {
+				tableNames = append(tableNames, tableName)
 			}
 		}
+
+		switch settings.GetDeleteMode() {
+		case DeleteModeDryRun:
+			d = append(d, dryRunDiagnostics(tableNames)...)
+			continue
+		case DeleteModeRefuseIfProtected:
+			var protectionDiags diag.Diagnostics
+			tableNames, protectionDiags = excludeProtectedTables(ctx, client, tableNames, settings.GetKeepTag())
+			d = append(d, protectionDiags...)
+		}
+
+		d = append(d, deleteTables(ctx, client, tableNames, data.Get(BackupBeforeDeleteKey).(bool))...)
 	}
 	if len(d) > 0 {
 		return d
 	}
 	return nil
 }
+
+func newListTablesPaginator(client DynamoDBClient) *dynamodb.ListTablesPaginator {
+	return dynamodb.NewListTablesPaginator(client, &dynamodb.ListTablesInput{}, func(o *dynamodb.ListTablesPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+}
+
+func listPrefixedTables(ctx context.Context, client DynamoDBClient, prefix string) ([]string, error) {
+	var result []string
+	paginator := newListTablesPaginator(client)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, tableName := range page.TableNames {
+			if inNamespace(tableName, prefix) {
+				result = append(result, tableName)
+			}
+		}
+	}
+	return result, nil
+}
+
+// inNamespace checks that the table name starts with the prefix, and that the prefix is followed by a
+// separator, so that a prefix like "csb-abc" does not also match tables in the "csb-abcd" namespace
+func inNamespace(tableName, prefix string) bool {
+	rest, found := strings.CutPrefix(tableName, prefix)
+	switch {
+	case !found:
+		return false
+	case rest == "", strings.ContainsAny(prefix[len(prefix)-1:], namespaceSeparators):
+		return true
+	default:
+		return strings.ContainsAny(rest[:1], namespaceSeparators)
+	}
+}
+
+func flattenTableDescription(table *types.TableDescription) map[string]any {
+	// Tables created with provisioned capacity before billing modes existed have no summary
+	billingMode := types.BillingModeProvisioned
+	if table.BillingModeSummary != nil && table.BillingModeSummary.BillingMode != "" {
+		billingMode = table.BillingModeSummary.BillingMode
+	}
+
+	return map[string]any{
+		tableNameKey:        aws.ToString(table.TableName),
+		tableStatusKey:      string(table.TableStatus),
+		tableBillingModeKey: string(billingMode),
+		tableItemCountKey:   int(aws.ToInt64(table.ItemCount)),
+	}
+}
+
+func isNotFound(err error) bool {
+	var notFound *types.ResourceNotFoundException
+	return errors.As(err, &notFound)
+}
@@ -3,9 +3,15 @@ package csbdynamodbns_test
 import (
 	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/smithy-go"
 	"github.com/aws/smithy-go/ptr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -28,12 +34,16 @@ var _ = Describe("ResourceDynamoDBNSInstance", func() {
 
 		config = &csbdynamodbnsfakes.FakeDynamoDBConfig{}
 		config.GetClientReturns(client, nil)
+		config.GetRegionReturns("us-west-2")
 		config.GetPrefixReturns(fmt.Sprintf("csb-%s-", uuid.New()))
+		config.GetDeleteModeReturns(csbdynamodbns.DeleteModeDelete)
 
 		data = csbdynamodbns.ResourceDynamoDBNSInstance().TestResourceData()
 		Expect(data.Set(csbdynamodbns.AwsAccessKeyIDKey, "id")).NotTo(HaveOccurred())
 		Expect(data.Set(csbdynamodbns.AwsSecretAccessKeyKey, "key")).NotTo(HaveOccurred())
 
+		client.DescribeTableReturns(nil, &types.ResourceNotFoundException{Message: ptr.String("not found")})
+		DeferCleanup(csbdynamodbns.ShortenDelays())
 	})
 
 	Context("various tables exist", func() {
@@ -59,11 +69,14 @@ var _ = Describe("ResourceDynamoDBNSInstance", func() {
 			Expect(client.ListTablesCallCount()).To(Equal(1))
 
 			Expect(client.DeleteTableCallCount()).To(Equal(3))
+			Expect(client.CreateBackupCallCount()).To(BeZero())
 		})
 
 		It("accumulates table deletion errors", func() {
-			client.DeleteTableReturnsOnCall(0, nil, fmt.Errorf("table 0 deletion failed"))
-			client.DeleteTableReturnsOnCall(2, nil, fmt.Errorf("table 2 deletion failed"))
+			client.DeleteTableStub = failDeletionOf(map[string]error{
+				config.GetPrefix() + "-one":   fmt.Errorf("table 0 deletion failed"),
+				config.GetPrefix() + "-seven": fmt.Errorf("table 2 deletion failed"),
+			})
 
 			d := csbdynamodbns.ResourceDynamoDBMaintenanceDelete(context.TODO(), data, config)
 			Expect(d).NotTo(BeNil())
@@ -72,6 +85,261 @@ var _ = Describe("ResourceDynamoDBNSInstance", func() {
 			Expect(d[0].Summary).To(Equal("table 0 deletion failed"))
 			Expect(d[1].Summary).To(Equal("table 2 deletion failed"))
 		})
+
+		It("waits for every deleted table to be gone", func() {
+			client.DescribeTableReturnsOnCall(0, &dynamodb.DescribeTableOutput{Table: &types.TableDescription{TableStatus: types.TableStatusDeleting}}, nil)
+			client.DescribeTableReturnsOnCall(1, &dynamodb.DescribeTableOutput{Table: &types.TableDescription{TableStatus: types.TableStatusDeleting}}, nil)
+
+			d := csbdynamodbns.ResourceDynamoDBMaintenanceDelete(context.TODO(), data, config)
+			Expect(d).To(BeNil())
+			Expect(client.DescribeTableCallCount()).To(Equal(5))
+		})
+
+		It("retries deletions that hit the concurrency limit or a table in use", func() {
+			var calls sync.Map
+			client.DeleteTableStub = func(_ context.Context, input *dynamodb.DeleteTableInput, _ ...func(*dynamodb.Options)) (*dynamodb.DeleteTableOutput, error) {
+				attempt, _ := calls.LoadOrStore(*input.TableName, new(atomic.Int32))
+				switch attempt.(*atomic.Int32).Add(1) {
+				case 1:
+					return nil, &types.LimitExceededException{Message: ptr.String("too many operations")}
+				case 2:
+					return nil, &types.ResourceInUseException{Message: ptr.String("table is being updated")}
+				default:
+					return &dynamodb.DeleteTableOutput{}, nil
+				}
+			}
+
+			d := csbdynamodbns.ResourceDynamoDBMaintenanceDelete(context.TODO(), data, config)
+			Expect(d).To(BeNil())
+			Expect(client.DeleteTableCallCount()).To(Equal(9))
+		})
+
+		It("treats tables that are already gone as deleted", func() {
+			client.DeleteTableReturns(nil, &types.ResourceNotFoundException{Message: ptr.String("not found")})
+
+			d := csbdynamodbns.ResourceDynamoDBMaintenanceDelete(context.TODO(), data, config)
+			Expect(d).To(BeNil())
+			Expect(client.DescribeTableCallCount()).To(BeZero())
+		})
+
+		It("gives up when the timeout expires", func() {
+			client.DeleteTableReturns(nil, &types.LimitExceededException{Message: ptr.String("too many operations")})
+			ctx, cancel := context.WithTimeout(context.TODO(), 20*time.Millisecond)
+			defer cancel()
+
+			d := csbdynamodbns.ResourceDynamoDBMaintenanceDelete(ctx, data, config)
+			Expect(d).To(HaveLen(3))
+			Expect(d[0].Summary).To(HavePrefix(fmt.Sprintf(`timed out deleting table "%s-one"`, config.GetPrefix())))
+		})
+
+		It("reports tables that do not disappear in time", func() {
+			client.DescribeTableReturns(&dynamodb.DescribeTableOutput{Table: &types.TableDescription{TableStatus: types.TableStatusDeleting}}, nil)
+			ctx, cancel := context.WithTimeout(context.TODO(), 20*time.Millisecond)
+			defer cancel()
+
+			d := csbdynamodbns.ResourceDynamoDBMaintenanceDelete(ctx, data, config)
+			Expect(d).To(HaveLen(3))
+			Expect(d[0].Summary).To(HavePrefix(fmt.Sprintf(`error waiting for table "%s-one" to be deleted`, config.GetPrefix())))
+		})
+	})
+
+	Context("prefix without a trailing separator", func() {
+		BeforeEach(func() {
+			config.GetPrefixReturns("csb-abc")
+			client.ListTablesReturns(&dynamodb.ListTablesOutput{TableNames: []string{
+				"csb-abc",
+				"csb-abc-one",
+				"csb-abcd-two",
+				"csb-abc_three",
+				"csb-abc.four",
+				"csb-ab-five",
+			}}, nil)
+		})
+
+		It("only deletes tables in the namespace", func() {
+			d := csbdynamodbns.ResourceDynamoDBMaintenanceDelete(context.TODO(), data, config)
+			Expect(d).To(BeNil())
+
+			var deleted []string
+			for i := range client.DeleteTableCallCount() {
+				_, input, _ := client.DeleteTableArgsForCall(i)
+				deleted = append(deleted, *input.TableName)
+			}
+			Expect(deleted).To(ConsistOf("csb-abc", "csb-abc-one", "csb-abc_three", "csb-abc.four"))
+		})
+	})
+
+	Context("dry run", func() {
+		BeforeEach(func() {
+			config.GetDeleteModeReturns(csbdynamodbns.DeleteModeDryRun)
+			client.ListTablesReturns(&dynamodb.ListTablesOutput{TableNames: []string{
+				fmt.Sprintf("%s-one", config.GetPrefix()),
+				fmt.Sprintf("csb-%s-two", uuid.New()),
+				fmt.Sprintf("%s-three", config.GetPrefix()),
+			}}, nil)
+		})
+
+		It("reports the tables that would be deleted without deleting them", func() {
+			d := csbdynamodbns.ResourceDynamoDBMaintenanceDelete(context.TODO(), data, config)
+			Expect(client.DeleteTableCallCount()).To(BeZero())
+			Expect(d.HasError()).To(BeFalse())
+			Expect(d).To(HaveLen(2))
+			Expect(d[0].Severity).To(Equal(diag.Warning))
+			Expect(d[0].Summary).To(Equal(fmt.Sprintf(`dry run: table "%s-one" would have been deleted`, config.GetPrefix())))
+			Expect(d[1].Summary).To(Equal(fmt.Sprintf(`dry run: table "%s-three" would have been deleted`, config.GetPrefix())))
+		})
+	})
+
+	Context("refuse if protected", func() {
+		var prefix string
+
+		BeforeEach(func() {
+			prefix = config.GetPrefix()
+			config.GetDeleteModeReturns(csbdynamodbns.DeleteModeRefuseIfProtected)
+			config.GetKeepTagReturns("csb-keep")
+			client.ListTablesReturns(&dynamodb.ListTablesOutput{TableNames: []string{
+				fmt.Sprintf("%s-protected", prefix),
+				fmt.Sprintf("%s-tagged", prefix),
+				fmt.Sprintf("%s-disposable", prefix),
+			}}, nil)
+			client.DescribeTableReturnsOnCall(0, &dynamodb.DescribeTableOutput{Table: &types.TableDescription{
+				TableArn:                  ptr.String("arn:protected"),
+				DeletionProtectionEnabled: ptr.Bool(true),
+			}}, nil)
+			client.DescribeTableReturnsOnCall(1, &dynamodb.DescribeTableOutput{Table: &types.TableDescription{
+				TableArn:                  ptr.String("arn:tagged"),
+				DeletionProtectionEnabled: ptr.Bool(false),
+			}}, nil)
+			client.DescribeTableReturnsOnCall(2, &dynamodb.DescribeTableOutput{Table: &types.TableDescription{
+				TableArn: ptr.String("arn:disposable"),
+			}}, nil)
+			client.ListTagsOfResourceStub = func(_ context.Context, input *dynamodb.ListTagsOfResourceInput, _ ...func(*dynamodb.Options)) (*dynamodb.ListTagsOfResourceOutput, error) {
+				switch {
+				case *input.ResourceArn == "arn:tagged" && input.NextToken == nil:
+					return &dynamodb.ListTagsOfResourceOutput{
+						Tags:      []types.Tag{{Key: ptr.String("team"), Value: ptr.String("a-team")}},
+						NextToken: ptr.String("next"),
+					}, nil
+				case *input.ResourceArn == "arn:tagged":
+					return &dynamodb.ListTagsOfResourceOutput{Tags: []types.Tag{{Key: ptr.String("csb-keep"), Value: ptr.String("")}}}, nil
+				default:
+					return &dynamodb.ListTagsOfResourceOutput{}, nil
+				}
+			}
+		})
+
+		It("skips protected and tagged tables and reports them as warnings", func() {
+			d := csbdynamodbns.ResourceDynamoDBMaintenanceDelete(context.TODO(), data, config)
+			Expect(d.HasError()).To(BeFalse())
+			Expect(d).To(HaveLen(2))
+			Expect(d[0].Severity).To(Equal(diag.Warning))
+			Expect(d[0].Summary).To(Equal(fmt.Sprintf(`table "%s-protected" was not deleted`, prefix)))
+			Expect(d[0].Detail).To(Equal("deletion protection is enabled"))
+			Expect(d[1].Summary).To(Equal(fmt.Sprintf(`table "%s-tagged" was not deleted`, prefix)))
+			Expect(d[1].Detail).To(Equal(`the table is tagged with "csb-keep"`))
+
+			Expect(client.DeleteTableCallCount()).To(Equal(1))
+			_, input, _ := client.DeleteTableArgsForCall(0)
+			Expect(*input.TableName).To(Equal(fmt.Sprintf("%s-disposable", prefix)))
+		})
+
+		It("does not check tags when no keep tag is configured", func() {
+			config.GetKeepTagReturns("")
+
+			d := csbdynamodbns.ResourceDynamoDBMaintenanceDelete(context.TODO(), data, config)
+			Expect(d).To(HaveLen(1))
+			Expect(client.ListTagsOfResourceCallCount()).To(BeZero())
+			Expect(client.DeleteTableCallCount()).To(Equal(2))
+		})
+
+		It("does not delete tables that cannot be checked", func() {
+			client.DescribeTableReturnsOnCall(2, nil, fmt.Errorf("access denied"))
+
+			d := csbdynamodbns.ResourceDynamoDBMaintenanceDelete(context.TODO(), data, config)
+			Expect(d.HasError()).To(BeTrue())
+			Expect(d[2].Summary).To(Equal("access denied"))
+			Expect(client.DeleteTableCallCount()).To(BeZero())
+		})
+	})
+
+	Context("backup before delete", func() {
+		BeforeEach(func() {
+			prefix := config.GetPrefix()
+			Expect(data.Set(csbdynamodbns.BackupBeforeDeleteKey, true)).To(Succeed())
+			client.ListTablesReturns(&dynamodb.ListTablesOutput{TableNames: []string{
+				fmt.Sprintf("%s-one", prefix),
+				fmt.Sprintf("csb-%s-two", uuid.New()),
+				fmt.Sprintf("%s-three", prefix),
+			}}, nil)
+			client.CreateBackupStub = func(_ context.Context, input *dynamodb.CreateBackupInput, _ ...func(*dynamodb.Options)) (*dynamodb.CreateBackupOutput, error) {
+				return &dynamodb.CreateBackupOutput{BackupDetails: &types.BackupDetails{
+					BackupArn:    ptr.String(fmt.Sprintf("arn:aws:dynamodb:us-west-2:123456789012:table/%s/backup/01", *input.TableName)),
+					BackupStatus: types.BackupStatusCreating,
+				}}, nil
+			}
+			client.DescribeBackupReturns(&dynamodb.DescribeBackupOutput{BackupDescription: &types.BackupDescription{
+				BackupDetails: &types.BackupDetails{BackupStatus: types.BackupStatusAvailable},
+			}}, nil)
+		})
+
+		It("backs up every table before deleting it and reports the backup ARNs", func() {
+			d := csbdynamodbns.ResourceDynamoDBMaintenanceDelete(context.TODO(), data, config)
+			Expect(d.HasError()).To(BeFalse())
+			Expect(client.CreateBackupCallCount()).To(Equal(2))
+			Expect(client.DeleteTableCallCount()).To(Equal(2))
+
+			_, input, _ := client.CreateBackupArgsForCall(0)
+			Expect(*input.BackupName).To(MatchRegexp(`^%s-(one|three)-\d{8}T\d{6}Z$`, config.GetPrefix()))
+
+			Expect(d).To(HaveLen(2))
+			Expect(d[0].Severity).To(Equal(diag.Warning))
+			Expect(d[0].Summary).To(Equal(fmt.Sprintf(`table "%s-one" was backed up before deletion`, config.GetPrefix())))
+			Expect(d[0].Detail).To(Equal(fmt.Sprintf("backup ARN: arn:aws:dynamodb:us-west-2:123456789012:table/%s-one/backup/01", config.GetPrefix())))
+			Expect(d[1].Detail).To(ContainSubstring("-three/backup/01"))
+		})
+
+		It("waits for the backups to become available", func() {
+			client.DescribeBackupReturnsOnCall(0, &dynamodb.DescribeBackupOutput{BackupDescription: &types.BackupDescription{
+				BackupDetails: &types.BackupDetails{BackupStatus: types.BackupStatusCreating},
+			}}, nil)
+
+			d := csbdynamodbns.ResourceDynamoDBMaintenanceDelete(context.TODO(), data, config)
+			Expect(d.HasError()).To(BeFalse())
+			Expect(client.DescribeBackupCallCount()).To(Equal(3))
+		})
+
+		It("does not delete a table when its backup fails", func() {
+			client.CreateBackupStub = nil
+			client.CreateBackupReturns(nil, fmt.Errorf("backups are not allowed"))
+
+			d := csbdynamodbns.ResourceDynamoDBMaintenanceDelete(context.TODO(), data, config)
+			Expect(d).To(HaveLen(2))
+			Expect(d[0].Summary).To(Equal("backups are not allowed"))
+			Expect(client.DeleteTableCallCount()).To(BeZero())
+		})
+
+		It("does not delete a table when its backup is deleted", func() {
+			client.DescribeBackupReturns(&dynamodb.DescribeBackupOutput{BackupDescription: &types.BackupDescription{
+				BackupDetails: &types.BackupDetails{BackupStatus: types.BackupStatusDeleted},
+			}}, nil)
+
+			d := csbdynamodbns.ResourceDynamoDBMaintenanceDelete(context.TODO(), data, config)
+			Expect(d.HasError()).To(BeTrue())
+			Expect(d).To(ContainElement(HaveField("Summary", MatchRegexp(`backup ".*-one/backup/01" was deleted before it became available`))))
+			Expect(client.DeleteTableCallCount()).To(BeZero())
+		})
+
+		It("reports the backup ARN even when the deletion fails", func() {
+			client.DeleteTableStub = failDeletionOf(map[string]error{
+				config.GetPrefix() + "-one": fmt.Errorf("table one deletion failed"),
+			})
+
+			d := csbdynamodbns.ResourceDynamoDBMaintenanceDelete(context.TODO(), data, config)
+			Expect(d).To(HaveLen(3))
+			Expect(d[0].Severity).To(Equal(diag.Warning))
+			Expect(d[1].Summary).To(Equal("table one deletion failed"))
+			Expect(d[2].Severity).To(Equal(diag.Warning))
+		})
 	})
 
 	Context("multi-page output with various errors", func() {
@@ -88,7 +356,9 @@ var _ = Describe("ResourceDynamoDBNSInstance", func() {
 				LastEvaluatedTableName: ptr.String("mossiness-of-mackerel-mousse"),
 			}, nil)
 			client.ListTablesReturnsOnCall(1, nil, fmt.Errorf("connection issues"))
-			client.DeleteTableReturnsOnCall(0, nil, fmt.Errorf("table 0 deletion failed"))
+			client.DeleteTableStub = failDeletionOf(map[string]error{
+				fmt.Sprintf("%s-etiam-ducunt-ad-castus-nuptia", prefix): fmt.Errorf("table 0 deletion failed"),
+			})
 		})
 
 		It("reports all the errors", func() {
@@ -111,4 +381,170 @@ var _ = Describe("ResourceDynamoDBNSInstance", func() {
 		Expect(d[0].Summary).To(Equal("ouch"))
 	})
 
+	Describe("Read", func() {
+		BeforeEach(func() {
+			prefix := config.GetPrefix()
+			client.ListTablesReturns(&dynamodb.ListTablesOutput{TableNames: []string{
+				fmt.Sprintf("%s-one", prefix),
+				fmt.Sprintf("csb-%s-two", uuid.New()),
+				fmt.Sprintf("%s-three", prefix),
+			}}, nil)
+			for i, suffix := range []string{"-one", "-three"} {
+				client.DescribeTableReturnsOnCall(i, &dynamodb.DescribeTableOutput{Table: &types.TableDescription{
+					TableName:          ptr.String(prefix + suffix),
+					TableStatus:        types.TableStatusActive,
+					BillingModeSummary: &types.BillingModeSummary{BillingMode: types.BillingModePayPerRequest},
+					ItemCount:          ptr.Int64(42),
+				}}, nil)
+			}
+		})
+
+		It("describes every table with the given prefix", func() {
+			d := csbdynamodbns.ResourceDynamoDBNSInstanceRead(context.TODO(), data, config)
+			Expect(d).To(BeNil())
+			Expect(client.DescribeTableCallCount()).To(Equal(2))
+
+			Expect(data.Get(csbdynamodbns.TablesKey)).To(ConsistOf(
+				map[string]any{"name": config.GetPrefix() + "-one", "status": "ACTIVE", "billing_mode": "PAY_PER_REQUEST", "item_count": 42},
+				map[string]any{"name": config.GetPrefix() + "-three", "status": "ACTIVE", "billing_mode": "PAY_PER_REQUEST", "item_count": 42},
+			))
+		})
+
+		It("leaves the ID alone, as the state upgrade is what migrates it", func() {
+			data.SetId("AKIAOLDKEY")
+
+			Expect(csbdynamodbns.ResourceDynamoDBNSInstanceRead(context.TODO(), data, config)).To(BeNil())
+			Expect(data.Id()).To(Equal("AKIAOLDKEY"))
+		})
+
+		It("defaults to provisioned billing when the table has no billing mode summary", func() {
+			client.DescribeTableReturnsOnCall(0, &dynamodb.DescribeTableOutput{Table: &types.TableDescription{
+				TableName:   ptr.String("legacy"),
+				TableStatus: types.TableStatusUpdating,
+			}}, nil)
+
+			d := csbdynamodbns.ResourceDynamoDBNSInstanceRead(context.TODO(), data, config)
+			Expect(d).To(BeNil())
+			Expect(data.Get(csbdynamodbns.TablesKey + ".0.billing_mode")).To(Equal("PROVISIONED"))
+			Expect(data.Get(csbdynamodbns.TablesKey + ".0.item_count")).To(Equal(0))
+		})
+
+		It("skips tables that disappear while being described", func() {
+			client.DescribeTableReturnsOnCall(0, nil, &types.ResourceNotFoundException{Message: ptr.String("gone")})
+
+			d := csbdynamodbns.ResourceDynamoDBNSInstanceRead(context.TODO(), data, config)
+			Expect(d).To(BeNil())
+			Expect(data.Get(csbdynamodbns.TablesKey)).To(HaveLen(1))
+		})
+
+		It("reports describe errors", func() {
+			client.DescribeTableReturnsOnCall(1, nil, fmt.Errorf("access denied"))
+
+			d := csbdynamodbns.ResourceDynamoDBNSInstanceRead(context.TODO(), data, config)
+			Expect(d.HasError()).To(BeTrue())
+			Expect(d[0].Summary).To(Equal("access denied"))
+		})
+
+		It("reports list errors", func() {
+			client.ListTablesReturns(nil, fmt.Errorf("ouch"))
+
+			d := csbdynamodbns.ResourceDynamoDBNSInstanceRead(context.TODO(), data, config)
+			Expect(d.HasError()).To(BeTrue())
+			Expect(d[0].Summary).To(Equal("ouch"))
+			Expect(client.DescribeTableCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("Create", func() {
+		It("does not call AWS, as a new access key may not be accepted yet", func() {
+			client.ListTablesReturnsOnCall(0, nil, &smithy.GenericAPIError{Code: "UnrecognizedClientException", Message: "The security token included in the request is invalid."})
+
+			d := csbdynamodbns.ResourceDynamoDBNSInstance().CreateContext(context.TODO(), data, config)
+			Expect(d).To(BeNil())
+			Expect(data.Id()).To(Equal("us-west-2/" + config.GetPrefix()))
+			Expect(data.Get(csbdynamodbns.TablesKey)).To(BeEmpty())
+			Expect(client.ListTablesCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("Update", func() {
+		It("keeps the tables from the state without calling AWS", func() {
+			Expect(data.Set(csbdynamodbns.TablesKey, []map[string]any{{"name": "csb-table", "status": "ACTIVE", "billing_mode": "PROVISIONED", "item_count": 1}})).To(Succeed())
+
+			d := csbdynamodbns.ResourceDynamoDBNSInstance().UpdateContext(context.TODO(), data, config)
+			Expect(d).To(BeNil())
+			Expect(data.Get(csbdynamodbns.TablesKey)).To(HaveLen(1))
+			Expect(config.GetClientCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("Import", func() {
+		var resource *schema.Resource
+
+		BeforeEach(func() {
+			resource = csbdynamodbns.ResourceDynamoDBNSInstance()
+			data = resource.TestResourceData()
+		})
+
+		It("accepts the region and prefix of the provider", func() {
+			data.SetId("us-west-2/" + config.GetPrefix())
+
+			result, err := resource.Importer.StateContext(context.TODO(), data, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(HaveLen(1))
+			Expect(result[0].Id()).To(Equal("us-west-2/" + config.GetPrefix()))
+			Expect(result[0].Get(csbdynamodbns.BackupBeforeDeleteKey)).To(BeFalse())
+		})
+
+		It("rejects malformed IDs", func() {
+			data.SetId(config.GetPrefix())
+
+			_, err := resource.Importer.StateContext(context.TODO(), data, config)
+			Expect(err).To(MatchError(fmt.Sprintf(`invalid import ID %q, expected "region/prefix"`, config.GetPrefix())))
+		})
+
+		It("rejects namespaces that the provider is not configured for", func() {
+			data.SetId("eu-west-1/" + config.GetPrefix())
+
+			_, err := resource.Importer.StateContext(context.TODO(), data, config)
+			Expect(err).To(MatchError(ContainSubstring("does not match the provider region")))
+		})
+	})
+
+	Describe("state upgrade", func() {
+		It("replaces the access key ID with the region and prefix", func() {
+			resource := csbdynamodbns.ResourceDynamoDBNSInstance()
+			Expect(resource.SchemaVersion).To(Equal(1))
+			Expect(resource.StateUpgraders).To(HaveLen(1))
+			Expect(resource.StateUpgraders[0].Version).To(Equal(0))
+			Expect(resource.StateUpgraders[0].Type.AttributeTypes()).To(SatisfyAll(
+				HaveLen(3),
+				HaveKey("id"),
+				HaveKey("access_key_id"),
+				HaveKey("secret_access_key"),
+			))
+
+			state, err := resource.StateUpgraders[0].Upgrade(context.TODO(), map[string]any{
+				"id":                "AKIAOLDKEY",
+				"access_key_id":     "AKIAOLDKEY",
+				"secret_access_key": "old-secret",
+			}, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state).To(Equal(map[string]any{
+				"id":                   "us-west-2/" + config.GetPrefix(),
+				"access_key_id":        "AKIAOLDKEY",
+				"secret_access_key":    "old-secret",
+				"backup_before_delete": false,
+			}))
+		})
+	})
 })
+
+func failDeletionOf(failures map[string]error) func(context.Context, *dynamodb.DeleteTableInput, ...func(*dynamodb.Options)) (*dynamodb.DeleteTableOutput, error) {
+	return func(_ context.Context, input *dynamodb.DeleteTableInput, _ ...func(*dynamodb.Options)) (*dynamodb.DeleteTableOutput, error) {
+		if err, ok := failures[*input.TableName]; ok {
+			return nil, err
+		}
+		return &dynamodb.DeleteTableOutput{}, nil
+	}
+}
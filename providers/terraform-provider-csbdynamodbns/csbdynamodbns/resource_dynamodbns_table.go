@@ -0,0 +1,733 @@
+package csbdynamodbns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	hashKeyKey              = "hash_key"
+	rangeKeyKey             = "range_key"
+	attributeKey            = "attribute"
+	attributeTypeKey        = "type"
+	readCapacityKey         = "read_capacity"
+	writeCapacityKey        = "write_capacity"
+	globalSecondaryIndexKey = "global_secondary_index"
+	localSecondaryIndexKey  = "local_secondary_index"
+	projectionTypeKey       = "projection_type"
+	nonKeyAttributesKey     = "non_key_attributes"
+	ttlAttributeKey         = "ttl_attribute"
+	streamViewTypeKey       = "stream_view_type"
+	streamARNKey            = "stream_arn"
+	pointInTimeRecoveryKey  = "point_in_time_recovery"
+	arnKey                  = "arn"
+
+	defaultTableTimeout = 30 * time.Minute
+)
+
+func ResourceDynamoDBNSTable() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			AwsAccessKeyIDKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "When not specified, the default AWS credentials chain is used",
+			},
+			AwsSecretAccessKeyKey: {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			tableNameKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the table, which must belong to the namespace of the provider prefix",
+			},
+			hashKeyKey: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			rangeKeyKey: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			attributeKey: {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						tableNameKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attributeTypeKey: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(enumValues(types.ScalarAttributeType("").Values()), false),
+						},
+					},
+				},
+			},
+			tableBillingModeKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(types.BillingModePayPerRequest),
+				ValidateFunc: validation.StringInSlice(enumValues(types.BillingMode("").Values()), false),
+			},
+			readCapacityKey: {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			writeCapacityKey: {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			globalSecondaryIndexKey: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: indexSchema(true),
+				},
+			},
+			localSecondaryIndexKey: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: indexSchema(false),
+				},
+			},
+			ttlAttributeKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the attribute holding the expiry time of items. Time to live is disabled when not specified",
+			},
+			streamViewTypeKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(enumValues(types.StreamViewType("").Values()), false),
+				Description:  "Streams are disabled when not specified",
+			},
+			pointInTimeRecoveryKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			arnKey: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			streamARNKey: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CreateContext: resourceDynamoDBNSTableCreate,
+		ReadContext:   ResourceDynamoDBNSTableRead,
+		UpdateContext: resourceDynamoDBNSTableUpdate,
+		DeleteContext: resourceDynamoDBNSTableDelete,
+		CustomizeDiff: validateTable,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDynamoDBNSTableImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTableTimeout),
+			Update: schema.DefaultTimeout(defaultTableTimeout),
+			Delete: schema.DefaultTimeout(defaultTableTimeout),
+		},
+		Description: "A DynamoDB table in the namespace",
+	}
+}
+
+func indexSchema(global bool) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		tableNameKey: {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		rangeKeyKey: {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		projectionTypeKey: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(enumValues(types.ProjectionType("").Values()), false),
+		},
+		nonKeyAttributesKey: {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+
+	if global {
+		s[hashKeyKey] = &schema.Schema{Type: schema.TypeString, Required: true}
+		s[readCapacityKey] = &schema.Schema{Type: schema.TypeInt, Optional: true}
+		s[writeCapacityKey] = &schema.Schema{Type: schema.TypeInt, Optional: true}
+	} else {
+		s[rangeKeyKey].Optional, s[rangeKeyKey].Required = false, true
+	}
+
+	return s
+}
+
+// validateTable fails the plan early when the table would be outside the namespace
+// or when capacity is missing for provisioned billing
+func validateTable(_ context.Context, diff *schema.ResourceDiff, config any) error {
+	settings := config.(DynamoDBConfig)
+	if tableName := diff.Get(tableNameKey).(string); diff.NewValueKnown(tableNameKey) && !inNamespace(tableName, settings.GetPrefix()) {
+		return fmt.Errorf("table name %q is not in the namespace of prefix %q", tableName, settings.GetPrefix())
+	}
+
+	if diff.Get(tableBillingModeKey).(string) == string(types.BillingModeProvisioned) &&
+		(diff.Get(readCapacityKey).(int) < 1 || diff.Get(writeCapacityKey).(int) < 1) {
+		return fmt.Errorf("%q and %q must be set when %q is %q", readCapacityKey, writeCapacityKey, tableBillingModeKey, types.BillingModeProvisioned)
+	}
+
+	return nil
+}
+
+func resourceDynamoDBNSTableCreate(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	settings := config.(DynamoDBConfig)
+	tableName := data.Get(tableNameKey).(string)
+	if err := checkTableNamespace(tableName, settings); err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := tableClient(ctx, data, settings)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, data.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	input := &dynamodb.CreateTableInput{
+		TableName:              aws.String(tableName),
+		AttributeDefinitions:   expandAttributeDefinitions(data),
+		KeySchema:              expandKeySchema(data.Get(hashKeyKey).(string), data.Get(rangeKeyKey).(string)),
+		BillingMode:            types.BillingMode(data.Get(tableBillingModeKey).(string)),
+		ProvisionedThroughput:  expandProvisionedThroughput(data, data.Get(tableBillingModeKey).(string)),
+		GlobalSecondaryIndexes: expandGlobalSecondaryIndexes(data.Get(globalSecondaryIndexKey).(*schema.Set).List(), data.Get(tableBillingModeKey).(string)),
+		LocalSecondaryIndexes:  expandLocalSecondaryIndexes(data),
+		StreamSpecification:    expandStreamSpecification(data.Get(streamViewTypeKey).(string)),
+	}
+	if err := retryOnContention(ctx, fmt.Sprintf("creating table %q", tableName), func() error {
+		_, err := client.CreateTable(ctx, input)
+		return err
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+	data.SetId(tableName)
+
+	if err := waitForTableActive(ctx, client, tableName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := updateTimeToLive(ctx, client, tableName, "", data.Get(ttlAttributeKey).(string)); err != nil {
+		return diag.FromErr(err)
+	}
+	if data.Get(pointInTimeRecoveryKey).(bool) {
+		if err := updatePointInTimeRecovery(ctx, client, tableName, true); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ResourceDynamoDBNSTableRead(ctx, data, config)
+}
+
+func resourceDynamoDBNSTableImport(_ context.Context, data *schema.ResourceData, config any) ([]*schema.ResourceData, error) {
+	if err := checkTableNamespace(data.Id(), config.(DynamoDBConfig)); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{data}, nil
+}
+
+func ResourceDynamoDBNSTableRead(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	if err := checkTableNamespace(data.Id(), config.(DynamoDBConfig)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := tableClient(ctx, data, config.(DynamoDBConfig))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := client.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(data.Id())})
+	switch {
+	case isNotFound(err):
+		data.SetId("")
+		return nil
+	case err != nil:
+		return diag.FromErr(err)
+	}
+	table := output.Table
+
+	ttl, err := client.DescribeTimeToLive(ctx, &dynamodb.DescribeTimeToLiveInput{TableName: table.TableName})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	backups, err := client.DescribeContinuousBackups(ctx, &dynamodb.DescribeContinuousBackupsInput{TableName: table.TableName})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	billingMode := flattenTableDescription(table)[tableBillingModeKey].(string)
+	hashKey, rangeKey := flattenKeySchema(table.KeySchema)
+	values := map[string]any{
+		tableNameKey:            aws.ToString(table.TableName),
+		arnKey:                  aws.ToString(table.TableArn),
+		hashKeyKey:              hashKey,
+		rangeKeyKey:             rangeKey,
+		attributeKey:            flattenAttributeDefinitions(table.AttributeDefinitions),
+		tableBillingModeKey:     billingMode,
+		readCapacityKey:         0,
+		writeCapacityKey:        0,
+		globalSecondaryIndexKey: flattenGlobalSecondaryIndexes(table.GlobalSecondaryIndexes, billingMode),
+		localSecondaryIndexKey:  flattenLocalSecondaryIndexes(table.LocalSecondaryIndexes),
+		ttlAttributeKey:         flattenTimeToLive(ttl.TimeToLiveDescription),
+		streamViewTypeKey:       "",
+		streamARNKey:            aws.ToString(table.LatestStreamArn),
+		pointInTimeRecoveryKey:  flattenPointInTimeRecovery(backups.ContinuousBackupsDescription),
+	}
+	if billingMode == string(types.BillingModeProvisioned) && table.ProvisionedThroughput != nil {
+		values[readCapacityKey] = int(aws.ToInt64(table.ProvisionedThroughput.ReadCapacityUnits))
+		values[writeCapacityKey] = int(aws.ToInt64(table.ProvisionedThroughput.WriteCapacityUnits))
+	}
+	if table.StreamSpecification != nil && aws.ToBool(table.StreamSpecification.StreamEnabled) {
+		values[streamViewTypeKey] = string(table.StreamSpecification.StreamViewType)
+	}
+
+	for k, v := range values {
+		if err := data.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceDynamoDBNSTableUpdate(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	client, err := tableClient(ctx, data, config.(DynamoDBConfig))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, data.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	tableName := data.Id()
+	billingMode := data.Get(tableBillingModeKey).(string)
+
+	// DynamoDB only allows one index to be created or deleted per update
+	oldIndexes, newIndexes := data.GetChange(globalSecondaryIndexKey)
+	deleted, created, updated := diffGlobalSecondaryIndexes(oldIndexes.(*schema.Set).List(), newIndexes.(*schema.Set).List())
+	for _, name := range deleted {
+		if err := updateTable(ctx, client, &dynamodb.UpdateTableInput{
+			TableName:                   aws.String(tableName),
+			GlobalSecondaryIndexUpdates: []types.GlobalSecondaryIndexUpdate{{Delete: &types.DeleteGlobalSecondaryIndexAction{IndexName: aws.String(name)}}},
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if data.HasChanges(tableBillingModeKey, readCapacityKey, writeCapacityKey) || len(updated) > 0 {
+		input := &dynamodb.UpdateTableInput{TableName: aws.String(tableName)}
+		if data.HasChange(tableBillingModeKey) {
+			input.BillingMode = types.BillingMode(billingMode)
+		}
+		if data.HasChanges(tableBillingModeKey, readCapacityKey, writeCapacityKey) {
+			input.ProvisionedThroughput = expandProvisionedThroughput(data, billingMode)
+		}
+		for _, index := range expandGlobalSecondaryIndexes(updated, billingMode) {
+			if index.ProvisionedThroughput != nil {
+				input.GlobalSecondaryIndexUpdates = append(input.GlobalSecondaryIndexUpdates, types.GlobalSecondaryIndexUpdate{
+					Update: &types.UpdateGlobalSecondaryIndexAction{IndexName: index.IndexName, ProvisionedThroughput: index.ProvisionedThroughput},
+				})
+			}
+		}
+		if err := updateTable(ctx, client, input); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	for _, index := range expandGlobalSecondaryIndexes(created, billingMode) {
+		if err := updateTable(ctx, client, &dynamodb.UpdateTableInput{
+			TableName:            aws.String(tableName),
+			AttributeDefinitions: expandAttributeDefinitions(data),
+			GlobalSecondaryIndexUpdates: []types.GlobalSecondaryIndexUpdate{{Create: &types.CreateGlobalSecondaryIndexAction{
+				IndexName:             index.IndexName,
+				KeySchema:             index.KeySchema,
+				Projection:            index.Projection,
+				ProvisionedThroughput: index.ProvisionedThroughput,
+			}}},
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if data.HasChange(streamViewTypeKey) {
+		// The view type of a stream cannot be changed, so the stream has to be disabled first
+		oldViewType, newViewType := data.GetChange(streamViewTypeKey)
+		if oldViewType.(string) != "" {
+			if err := updateTable(ctx, client, &dynamodb.UpdateTableInput{
+				TableName:           aws.String(tableName),
+				StreamSpecification: &types.StreamSpecification{StreamEnabled: aws.Bool(false)},
+			}); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if newViewType.(string) != "" {
+			if err := updateTable(ctx, client, &dynamodb.UpdateTableInput{
+				TableName:           aws.String(tableName),
+				StreamSpecification: expandStreamSpecification(newViewType.(string)),
+			}); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if data.HasChange(ttlAttributeKey) {
+		oldAttribute, newAttribute := data.GetChange(ttlAttributeKey)
+		if err := updateTimeToLive(ctx, client, tableName, oldAttribute.(string), newAttribute.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if data.HasChange(pointInTimeRecoveryKey) {
+		if err := updatePointInTimeRecovery(ctx, client, tableName, data.Get(pointInTimeRecoveryKey).(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ResourceDynamoDBNSTableRead(ctx, data, config)
+}
+
+func resourceDynamoDBNSTableDelete(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	// The ID comes from the state, which can be edited or imported, so it is checked again before anything is deleted
+	if err := checkTableNamespace(data.Id(), config.(DynamoDBConfig)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := tableClient(ctx, data, config.(DynamoDBConfig))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, data.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	if _, err := deleteTable(ctx, client, data.Id(), false); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func checkTableNamespace(tableName string, settings DynamoDBConfig) error {
+	if !inNamespace(tableName, settings.GetPrefix()) {
+		return fmt.Errorf("table name %q is not in the namespace of prefix %q", tableName, settings.GetPrefix())
+	}
+	return nil
+}
+
+func tableClient(ctx context.Context, data *schema.ResourceData, settings DynamoDBConfig) (DynamoDBClient, error) {
+	return settings.GetClient(ctx, data.Get(AwsAccessKeyIDKey).(string), data.Get(AwsSecretAccessKeyKey).(string))
+}
+
+func updateTable(ctx context.Context, client DynamoDBClient, input *dynamodb.UpdateTableInput) error {
+	tableName := aws.ToString(input.TableName)
+	if err := retryOnContention(ctx, fmt.Sprintf("updating table %q", tableName), func() error {
+		_, err := client.UpdateTable(ctx, input)
+		return err
+	}); err != nil {
+		return err
+	}
+	return waitForTableActive(ctx, client, tableName)
+}
+
+func updateTimeToLive(ctx context.Context, client DynamoDBClient, tableName, oldAttribute, newAttribute string) error {
+	for _, spec := range []types.TimeToLiveSpecification{
+		{AttributeName: aws.String(oldAttribute), Enabled: aws.Bool(false)},
+		{AttributeName: aws.String(newAttribute), Enabled: aws.Bool(true)},
+	} {
+		if aws.ToString(spec.AttributeName) == "" {
+			continue
+		}
+		if _, err := client.UpdateTimeToLive(ctx, &dynamodb.UpdateTimeToLiveInput{
+			TableName:               aws.String(tableName),
+			TimeToLiveSpecification: &spec,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func updatePointInTimeRecovery(ctx context.Context, client DynamoDBClient, tableName string, enabled bool) error {
+	_, err := client.UpdateContinuousBackups(ctx, &dynamodb.UpdateContinuousBackupsInput{
+		TableName:                        aws.String(tableName),
+		PointInTimeRecoverySpecification: &types.PointInTimeRecoverySpecification{PointInTimeRecoveryEnabled: aws.Bool(enabled)},
+	})
+	return err
+}
+
+// waitForTableActive waits until the table and all of its global secondary indexes are active
+func waitForTableActive(ctx context.Context, client DynamoDBClient, tableName string) error {
+	delay := waiterMinDelay
+	for {
+		output, err := client.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(tableName)})
+		if err != nil {
+			return fmt.Errorf("error waiting for table %q to become active: %w", tableName, err)
+		}
+		if isTableActive(output.Table) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for table %q to become active", tableName)
+		case <-time.After(delay):
+		}
+		delay = min(2*delay, waiterMaxDelay)
+	}
+}
+
+func isTableActive(table *types.TableDescription) bool {
+	if table == nil || table.TableStatus != types.TableStatusActive {
+		return false
+	}
+	for _, index := range table.GlobalSecondaryIndexes {
+		if index.IndexStatus != types.IndexStatusActive {
+			return false
+		}
+	}
+	return true
+}
+
+// diffGlobalSecondaryIndexes works out which indexes need to be deleted and created, and which
+// only need their capacity to be updated, which is the only thing that can be changed in place
+func diffGlobalSecondaryIndexes(oldIndexes, newIndexes []any) (deleted []string, created, updated []any) {
+	byName := func(indexes []any) map[string]map[string]any {
+		result := make(map[string]map[string]any, len(indexes))
+		for _, index := range indexes {
+			m := index.(map[string]any)
+			result[m[tableNameKey].(string)] = m
+		}
+		return result
+	}
+	oldByName, newByName := byName(oldIndexes), byName(newIndexes)
+
+	for name, oldIndex := range oldByName {
+		newIndex, ok := newByName[name]
+		switch {
+		case !ok:
+			deleted = append(deleted, name)
+		case !sameIndexDefinition(oldIndex, newIndex):
+			deleted = append(deleted, name)
+			created = append(created, newIndex)
+		case oldIndex[readCapacityKey] != newIndex[readCapacityKey] || oldIndex[writeCapacityKey] != newIndex[writeCapacityKey]:
+			updated = append(updated, newIndex)
+		}
+	}
+	for name, newIndex := range newByName {
+		if _, ok := oldByName[name]; !ok {
+			created = append(created, newIndex)
+		}
+	}
+
+	return deleted, created, updated
+}
+
+func sameIndexDefinition(a, b map[string]any) bool {
+	for _, key := range []string{hashKeyKey, rangeKeyKey, projectionTypeKey} {
+		if a[key] != b[key] {
+			return false
+		}
+	}
+	return fmt.Sprint(a[nonKeyAttributesKey]) == fmt.Sprint(b[nonKeyAttributesKey])
+}
+
+func expandAttributeDefinitions(data *schema.ResourceData) []types.AttributeDefinition {
+	var result []types.AttributeDefinition
+	for _, attribute := range data.Get(attributeKey).(*schema.Set).List() {
+		m := attribute.(map[string]any)
+		result = append(result, types.AttributeDefinition{
+			AttributeName: aws.String(m[tableNameKey].(string)),
+			AttributeType: types.ScalarAttributeType(m[attributeTypeKey].(string)),
+		})
+	}
+	return result
+}
+
+func expandKeySchema(hashKey, rangeKey string) []types.KeySchemaElement {
+	result := []types.KeySchemaElement{{AttributeName: aws.String(hashKey), KeyType: types.KeyTypeHash}}
+	if rangeKey != "" {
+		result = append(result, types.KeySchemaElement{AttributeName: aws.String(rangeKey), KeyType: types.KeyTypeRange})
+	}
+	return result
+}
+
+func expandProvisionedThroughput(m interface{ Get(string) any }, billingMode string) *types.ProvisionedThroughput {
+	if billingMode != string(types.BillingModeProvisioned) {
+		return nil
+	}
+	return &types.ProvisionedThroughput{
+		ReadCapacityUnits:  aws.Int64(int64(m.Get(readCapacityKey).(int))),
+		WriteCapacityUnits: aws.Int64(int64(m.Get(writeCapacityKey).(int))),
+	}
+}
+
+func expandProjection(m map[string]any) *types.Projection {
+	projection := &types.Projection{ProjectionType: types.ProjectionType(m[projectionTypeKey].(string))}
+	for _, attribute := range m[nonKeyAttributesKey].([]any) {
+		projection.NonKeyAttributes = append(projection.NonKeyAttributes, attribute.(string))
+	}
+	return projection
+}
+
+func expandGlobalSecondaryIndexes(indexes []any, billingMode string) []types.GlobalSecondaryIndex {
+	var result []types.GlobalSecondaryIndex
+	for _, index := range indexes {
+		m := index.(map[string]any)
+		result = append(result, types.GlobalSecondaryIndex{
+			IndexName:             aws.String(m[tableNameKey].(string)),
+			KeySchema:             expandKeySchema(m[hashKeyKey].(string), m[rangeKeyKey].(string)),
+			Projection:            expandProjection(m),
+			ProvisionedThroughput: expandProvisionedThroughput(mapGetter(m), billingMode),
+		})
+	}
+	return result
+}
+
+func expandLocalSecondaryIndexes(data *schema.ResourceData) []types.LocalSecondaryIndex {
+	var result []types.LocalSecondaryIndex
+	for _, index := range data.Get(localSecondaryIndexKey).(*schema.Set).List() {
+		m := index.(map[string]any)
+		result = append(result, types.LocalSecondaryIndex{
+			IndexName:  aws.String(m[tableNameKey].(string)),
+			KeySchema:  expandKeySchema(data.Get(hashKeyKey).(string), m[rangeKeyKey].(string)),
+			Projection: expandProjection(m),
+		})
+	}
+	return result
+}
+
+func expandStreamSpecification(viewType string) *types.StreamSpecification {
+	if viewType == "" {
+		return nil
+	}
+	return &types.StreamSpecification{StreamEnabled: aws.Bool(true), StreamViewType: types.StreamViewType(viewType)}
+}
+
+func flattenKeySchema(keySchema []types.KeySchemaElement) (hashKey, rangeKey string) {
+	for _, element := range keySchema {
+		switch element.KeyType {
+		case types.KeyTypeHash:
+			hashKey = aws.ToString(element.AttributeName)
+		case types.KeyTypeRange:
+			rangeKey = aws.ToString(element.AttributeName)
+		}
+	}
+	return hashKey, rangeKey
+}
+
+func flattenAttributeDefinitions(definitions []types.AttributeDefinition) []any {
+	var result []any
+	for _, definition := range definitions {
+		result = append(result, map[string]any{
+			tableNameKey:     aws.ToString(definition.AttributeName),
+			attributeTypeKey: string(definition.AttributeType),
+		})
+	}
+	return result
+}
+
+func flattenProjection(m map[string]any, projection *types.Projection) {
+	m[projectionTypeKey] = ""
+	m[nonKeyAttributesKey] = []any{}
+	if projection != nil {
+		m[projectionTypeKey] = string(projection.ProjectionType)
+		for _, attribute := range projection.NonKeyAttributes {
+			m[nonKeyAttributesKey] = append(m[nonKeyAttributesKey].([]any), attribute)
+		}
+	}
+}
+
+func flattenGlobalSecondaryIndexes(indexes []types.GlobalSecondaryIndexDescription, billingMode string) []any {
+	var result []any
+	for _, index := range indexes {
+		hashKey, rangeKey := flattenKeySchema(index.KeySchema)
+		m := map[string]any{
+			tableNameKey:     aws.ToString(index.IndexName),
+			hashKeyKey:       hashKey,
+			rangeKeyKey:      rangeKey,
+			readCapacityKey:  0,
+			writeCapacityKey: 0,
+		}
+		flattenProjection(m, index.Projection)
+		if billingMode == string(types.BillingModeProvisioned) && index.ProvisionedThroughput != nil {
+			m[readCapacityKey] = int(aws.ToInt64(index.ProvisionedThroughput.ReadCapacityUnits))
+			m[writeCapacityKey] = int(aws.ToInt64(index.ProvisionedThroughput.WriteCapacityUnits))
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+func flattenLocalSecondaryIndexes(indexes []types.LocalSecondaryIndexDescription) []any {
+	var result []any
+	for _, index := range indexes {
+		_, rangeKey := flattenKeySchema(index.KeySchema)
+		m := map[string]any{
+			tableNameKey: aws.ToString(index.IndexName),
+			rangeKeyKey:  rangeKey,
+		}
+		flattenProjection(m, index.Projection)
+		result = append(result, m)
+	}
+	return result
+}
+
+func flattenTimeToLive(description *types.TimeToLiveDescription) string {
+	if description == nil {
+		return ""
+	}
+	switch description.TimeToLiveStatus {
+	case types.TimeToLiveStatusEnabled, types.TimeToLiveStatusEnabling:
+		return aws.ToString(description.AttributeName)
+	default:
+		return ""
+	}
+}
+
+func flattenPointInTimeRecovery(description *types.ContinuousBackupsDescription) bool {
+	return description != nil &&
+		description.PointInTimeRecoveryDescription != nil &&
+		description.PointInTimeRecoveryDescription.PointInTimeRecoveryStatus == types.PointInTimeRecoveryStatusEnabled
+}
+
+// mapGetter allows the attributes of nested blocks to be read in the same way as top level attributes
+type mapGetter map[string]any
+
+func (m mapGetter) Get(key string) any {
+	return m[key]
+}
+
+func enumValues[T ~string](values []T) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		result = append(result, string(v))
+	}
+	return result
+}
@@ -0,0 +1,310 @@
+package csbdynamodbns_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/smithy-go/ptr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/pborman/uuid"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-dynamodbns/csbdynamodbns"
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-dynamodbns/csbdynamodbns/csbdynamodbnsfakes"
+)
+
+var _ = Describe("ResourceDynamoDBNSTable", func() {
+	var (
+		client    *csbdynamodbnsfakes.FakeDynamoDBClient
+		config    *csbdynamodbnsfakes.FakeDynamoDBConfig
+		resource  *schema.Resource
+		tableName string
+	)
+
+	BeforeEach(func() {
+		client = &csbdynamodbnsfakes.FakeDynamoDBClient{}
+
+		config = &csbdynamodbnsfakes.FakeDynamoDBConfig{}
+		config.GetClientReturns(client, nil)
+		config.GetPrefixReturns(fmt.Sprintf("csb-%s-", uuid.New()))
+
+		resource = csbdynamodbns.ResourceDynamoDBNSTable()
+		tableName = config.GetPrefix() + "orders"
+
+		DeferCleanup(csbdynamodbns.ShortenDelays())
+	})
+
+	Describe("Create", func() {
+		var data *schema.ResourceData
+
+		BeforeEach(func() {
+			data = schema.TestResourceDataRaw(GinkgoT(), resource.Schema, map[string]any{
+				"access_key_id":     "id",
+				"secret_access_key": "key",
+				"name":              tableName,
+				"hash_key":          "pk",
+				"range_key":         "sk",
+				"attribute": []any{
+					map[string]any{"name": "pk", "type": "S"},
+					map[string]any{"name": "sk", "type": "N"},
+					map[string]any{"name": "gsi_pk", "type": "S"},
+				},
+				"billing_mode":   "PROVISIONED",
+				"read_capacity":  5,
+				"write_capacity": 10,
+				"global_secondary_index": []any{
+					map[string]any{"name": "by_gsi_pk", "hash_key": "gsi_pk", "projection_type": "INCLUDE", "non_key_attributes": []any{"total"}, "read_capacity": 1, "write_capacity": 2},
+				},
+				"local_secondary_index": []any{
+					map[string]any{"name": "by_sk", "range_key": "sk", "projection_type": "KEYS_ONLY"},
+				},
+				"ttl_attribute":          "expires_at",
+				"stream_view_type":       "NEW_IMAGE",
+				"point_in_time_recovery": true,
+			})
+
+			client.DescribeTableReturns(&dynamodb.DescribeTableOutput{Table: &types.TableDescription{
+				TableName:   ptr.String(tableName),
+				TableStatus: types.TableStatusActive,
+			}}, nil)
+			client.DescribeTimeToLiveReturns(&dynamodb.DescribeTimeToLiveOutput{}, nil)
+			client.DescribeContinuousBackupsReturns(&dynamodb.DescribeContinuousBackupsOutput{}, nil)
+		})
+
+		It("creates the table with the requested configuration", func() {
+			d := resource.CreateContext(context.TODO(), data, config)
+			Expect(d).To(BeNil())
+			Expect(data.Id()).To(Equal(tableName))
+
+			Expect(client.CreateTableCallCount()).To(Equal(1))
+			_, input, _ := client.CreateTableArgsForCall(0)
+			Expect(*input.TableName).To(Equal(tableName))
+			Expect(input.AttributeDefinitions).To(HaveLen(3))
+			Expect(input.KeySchema).To(Equal([]types.KeySchemaElement{
+				{AttributeName: ptr.String("pk"), KeyType: types.KeyTypeHash},
+				{AttributeName: ptr.String("sk"), KeyType: types.KeyTypeRange},
+			}))
+			Expect(input.BillingMode).To(Equal(types.BillingModeProvisioned))
+			Expect(input.ProvisionedThroughput).To(Equal(&types.ProvisionedThroughput{ReadCapacityUnits: ptr.Int64(5), WriteCapacityUnits: ptr.Int64(10)}))
+			Expect(input.GlobalSecondaryIndexes).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"IndexName":             PointTo(Equal("by_gsi_pk")),
+				"KeySchema":             Equal([]types.KeySchemaElement{{AttributeName: ptr.String("gsi_pk"), KeyType: types.KeyTypeHash}}),
+				"Projection":            Equal(&types.Projection{ProjectionType: types.ProjectionTypeInclude, NonKeyAttributes: []string{"total"}}),
+				"ProvisionedThroughput": Equal(&types.ProvisionedThroughput{ReadCapacityUnits: ptr.Int64(1), WriteCapacityUnits: ptr.Int64(2)}),
+			})))
+			Expect(input.LocalSecondaryIndexes).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"IndexName": PointTo(Equal("by_sk")),
+				"KeySchema": Equal([]types.KeySchemaElement{
+					{AttributeName: ptr.String("pk"), KeyType: types.KeyTypeHash},
+					{AttributeName: ptr.String("sk"), KeyType: types.KeyTypeRange},
+				}),
+			})))
+			Expect(input.StreamSpecification).To(Equal(&types.StreamSpecification{StreamEnabled: ptr.Bool(true), StreamViewType: types.StreamViewTypeNewImage}))
+
+			Expect(client.UpdateTimeToLiveCallCount()).To(Equal(1))
+			_, ttlInput, _ := client.UpdateTimeToLiveArgsForCall(0)
+			Expect(ttlInput.TimeToLiveSpecification).To(Equal(&types.TimeToLiveSpecification{AttributeName: ptr.String("expires_at"), Enabled: ptr.Bool(true)}))
+
+			Expect(client.UpdateContinuousBackupsCallCount()).To(Equal(1))
+			_, pitrInput, _ := client.UpdateContinuousBackupsArgsForCall(0)
+			Expect(*pitrInput.PointInTimeRecoverySpecification.PointInTimeRecoveryEnabled).To(BeTrue())
+		})
+
+		It("waits for the table to become active", func() {
+			client.DescribeTableReturnsOnCall(0, &dynamodb.DescribeTableOutput{Table: &types.TableDescription{TableStatus: types.TableStatusCreating}}, nil)
+			client.DescribeTableReturnsOnCall(1, &dynamodb.DescribeTableOutput{Table: &types.TableDescription{
+				TableStatus:            types.TableStatusActive,
+				GlobalSecondaryIndexes: []types.GlobalSecondaryIndexDescription{{IndexStatus: types.IndexStatusCreating}},
+			}}, nil)
+
+			d := resource.CreateContext(context.TODO(), data, config)
+			Expect(d).To(BeNil())
+			Expect(client.DescribeTableCallCount()).To(Equal(4))
+			Expect(client.UpdateTimeToLiveCallCount()).To(Equal(1))
+		})
+
+		It("refuses to create tables outside the namespace", func() {
+			Expect(data.Set("name", fmt.Sprintf("%sorders", config.GetPrefix()[:len(config.GetPrefix())-1]))).To(Succeed())
+
+			d := resource.CreateContext(context.TODO(), data, config)
+			Expect(d.HasError()).To(BeTrue())
+			Expect(d[0].Summary).To(ContainSubstring("is not in the namespace of prefix"))
+			Expect(client.CreateTableCallCount()).To(BeZero())
+		})
+
+		It("reports creation errors", func() {
+			client.CreateTableReturns(nil, fmt.Errorf("table already exists"))
+
+			d := resource.CreateContext(context.TODO(), data, config)
+			Expect(d.HasError()).To(BeTrue())
+			Expect(d[0].Summary).To(Equal("table already exists"))
+			Expect(data.Id()).To(BeEmpty())
+		})
+	})
+
+	Describe("Read", func() {
+		var data *schema.ResourceData
+
+		BeforeEach(func() {
+			data = resource.TestResourceData()
+			data.SetId(tableName)
+
+			client.DescribeTableReturns(&dynamodb.DescribeTableOutput{Table: &types.TableDescription{
+				TableName:   ptr.String(tableName),
+				TableArn:    ptr.String("arn:aws:dynamodb:us-west-2:123456789012:table/" + tableName),
+				TableStatus: types.TableStatusActive,
+				AttributeDefinitions: []types.AttributeDefinition{
+					{AttributeName: ptr.String("pk"), AttributeType: types.ScalarAttributeTypeS},
+					{AttributeName: ptr.String("gsi_pk"), AttributeType: types.ScalarAttributeTypeB},
+				},
+				KeySchema:             []types.KeySchemaElement{{AttributeName: ptr.String("pk"), KeyType: types.KeyTypeHash}},
+				BillingModeSummary:    &types.BillingModeSummary{BillingMode: types.BillingModeProvisioned},
+				ProvisionedThroughput: &types.ProvisionedThroughputDescription{ReadCapacityUnits: ptr.Int64(3), WriteCapacityUnits: ptr.Int64(4)},
+				GlobalSecondaryIndexes: []types.GlobalSecondaryIndexDescription{{
+					IndexName:             ptr.String("by_gsi_pk"),
+					KeySchema:             []types.KeySchemaElement{{AttributeName: ptr.String("gsi_pk"), KeyType: types.KeyTypeHash}},
+					Projection:            &types.Projection{ProjectionType: types.ProjectionTypeAll},
+					ProvisionedThroughput: &types.ProvisionedThroughputDescription{ReadCapacityUnits: ptr.Int64(1), WriteCapacityUnits: ptr.Int64(1)},
+				}},
+				StreamSpecification: &types.StreamSpecification{StreamEnabled: ptr.Bool(true), StreamViewType: types.StreamViewTypeKeysOnly},
+				LatestStreamArn:     ptr.String("arn:stream"),
+			}}, nil)
+			client.DescribeTimeToLiveReturns(&dynamodb.DescribeTimeToLiveOutput{TimeToLiveDescription: &types.TimeToLiveDescription{
+				AttributeName:    ptr.String("expires_at"),
+				TimeToLiveStatus: types.TimeToLiveStatusEnabled,
+			}}, nil)
+			client.DescribeContinuousBackupsReturns(&dynamodb.DescribeContinuousBackupsOutput{ContinuousBackupsDescription: &types.ContinuousBackupsDescription{
+				PointInTimeRecoveryDescription: &types.PointInTimeRecoveryDescription{PointInTimeRecoveryStatus: types.PointInTimeRecoveryStatusEnabled},
+			}}, nil)
+		})
+
+		It("reads the table configuration into the state", func() {
+			d := csbdynamodbns.ResourceDynamoDBNSTableRead(context.TODO(), data, config)
+			Expect(d).To(BeNil())
+
+			Expect(data.Get("name")).To(Equal(tableName))
+			Expect(data.Get("arn")).To(Equal("arn:aws:dynamodb:us-west-2:123456789012:table/" + tableName))
+			Expect(data.Get("hash_key")).To(Equal("pk"))
+			Expect(data.Get("range_key")).To(BeEmpty())
+			Expect(data.Get("attribute").(*schema.Set).List()).To(ConsistOf(
+				map[string]any{"name": "pk", "type": "S"},
+				map[string]any{"name": "gsi_pk", "type": "B"},
+			))
+			Expect(data.Get("billing_mode")).To(Equal("PROVISIONED"))
+			Expect(data.Get("read_capacity")).To(Equal(3))
+			Expect(data.Get("write_capacity")).To(Equal(4))
+			Expect(data.Get("global_secondary_index").(*schema.Set).List()).To(ConsistOf(map[string]any{
+				"name":               "by_gsi_pk",
+				"hash_key":           "gsi_pk",
+				"range_key":          "",
+				"projection_type":    "ALL",
+				"non_key_attributes": []any{},
+				"read_capacity":      1,
+				"write_capacity":     1,
+			}))
+			Expect(data.Get("stream_view_type")).To(Equal("KEYS_ONLY"))
+			Expect(data.Get("stream_arn")).To(Equal("arn:stream"))
+			Expect(data.Get("ttl_attribute")).To(Equal("expires_at"))
+			Expect(data.Get("point_in_time_recovery")).To(BeTrue())
+		})
+
+		It("removes tables that no longer exist from the state", func() {
+			client.DescribeTableReturns(nil, &types.ResourceNotFoundException{Message: ptr.String("not found")})
+
+			d := csbdynamodbns.ResourceDynamoDBNSTableRead(context.TODO(), data, config)
+			Expect(d).To(BeNil())
+			Expect(data.Id()).To(BeEmpty())
+		})
+
+		It("reports errors", func() {
+			client.DescribeTimeToLiveReturns(nil, fmt.Errorf("access denied"))
+
+			d := csbdynamodbns.ResourceDynamoDBNSTableRead(context.TODO(), data, config)
+			Expect(d.HasError()).To(BeTrue())
+			Expect(d[0].Summary).To(Equal("access denied"))
+		})
+
+		It("refuses to read tables outside the namespace", func() {
+			data.SetId("other-orders")
+
+			d := csbdynamodbns.ResourceDynamoDBNSTableRead(context.TODO(), data, config)
+			Expect(d.HasError()).To(BeTrue())
+			Expect(d[0].Summary).To(ContainSubstring("is not in the namespace of prefix"))
+			Expect(client.DescribeTableCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("Delete", func() {
+		It("deletes the table and waits for it to be gone", func() {
+			data := resource.TestResourceData()
+			data.SetId(tableName)
+			client.DescribeTableReturns(nil, &types.ResourceNotFoundException{Message: ptr.String("not found")})
+
+			d := resource.DeleteContext(context.TODO(), data, config)
+			Expect(d).To(BeNil())
+			Expect(client.DeleteTableCallCount()).To(Equal(1))
+			_, input, _ := client.DeleteTableArgsForCall(0)
+			Expect(*input.TableName).To(Equal(tableName))
+			Expect(client.DescribeTableCallCount()).To(Equal(1))
+		})
+
+		It("refuses to delete tables outside the namespace", func() {
+			data := resource.TestResourceData()
+			data.SetId(fmt.Sprintf("%sorders", config.GetPrefix()[:len(config.GetPrefix())-1]))
+
+			d := resource.DeleteContext(context.TODO(), data, config)
+			Expect(d.HasError()).To(BeTrue())
+			Expect(d[0].Summary).To(ContainSubstring("is not in the namespace of prefix"))
+			Expect(client.DeleteTableCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("Import", func() {
+		It("accepts tables in the namespace", func() {
+			data := resource.TestResourceData()
+			data.SetId(tableName)
+
+			result, err := resource.Importer.StateContext(context.TODO(), data, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(HaveLen(1))
+			Expect(result[0].Id()).To(Equal(tableName))
+		})
+
+		It("rejects tables outside the namespace", func() {
+			data := resource.TestResourceData()
+			data.SetId("other-orders")
+
+			_, err := resource.Importer.StateContext(context.TODO(), data, config)
+			Expect(err).To(MatchError(fmt.Sprintf("table name %q is not in the namespace of prefix %q", "other-orders", config.GetPrefix())))
+		})
+	})
+
+	Describe("global secondary index changes", func() {
+		index := func(name, hashKey string, capacity int) map[string]any {
+			return map[string]any{
+				"name":               name,
+				"hash_key":           hashKey,
+				"range_key":          "",
+				"projection_type":    "ALL",
+				"non_key_attributes": []any{},
+				"read_capacity":      capacity,
+				"write_capacity":     capacity,
+			}
+		}
+
+		It("works out which indexes to delete, create and update", func() {
+			deleted, created, updated := csbdynamodbns.DiffGlobalSecondaryIndexes(
+				[]any{index("removed", "a", 1), index("redefined", "b", 1), index("resized", "c", 1), index("unchanged", "d", 1)},
+				[]any{index("redefined", "x", 1), index("resized", "c", 2), index("unchanged", "d", 1), index("added", "e", 1)},
+			)
+
+			Expect(deleted).To(ConsistOf("removed", "redefined"))
+			Expect(created).To(ConsistOf(index("redefined", "x", 1), index("added", "e", 1)))
+			Expect(updated).To(ConsistOf(index("resized", "c", 2)))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package csbdynamodbns
 
 import (
 	"context"
+	"time"
 
 	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-dynamodbns/dynaclient"
 )
@@ -9,22 +10,64 @@ import (
 //counterfeiter:generate -header csbdynamodbnsfakes/header.txt . DynamoDBConfig
 type DynamoDBConfig interface {
 	GetClient(ctx context.Context, keyID, secretKey string) (DynamoDBClient, error)
+	GetRegion() string
 	GetPrefix() string
+	GetDeleteMode() string
+	GetKeepTag() string
 }
 
 type dynamoDBNamespaceSettings struct {
 	region            string
 	prefix            string
 	customEndpointURL string
+	deleteMode        string
+	keepTag           string
+	role              roleSettings
+	maxRetryAttempts  int
+	maxRetryBackoff   time.Duration
+	httpTimeout       time.Duration
+	logRequests       bool
+}
+
+type roleSettings struct {
+	arn                  string
+	externalID           string
+	sessionName          string
+	webIdentityTokenFile string
 }
 
 // Fail fast if the interface is not implemented
 var _ DynamoDBConfig = &dynamoDBNamespaceSettings{}
 
+func (d *dynamoDBNamespaceSettings) GetRegion() string {
+	return d.region
+}
+
 func (d *dynamoDBNamespaceSettings) GetPrefix() string {
 	return d.prefix
 }
 
+func (d *dynamoDBNamespaceSettings) GetDeleteMode() string {
+	return d.deleteMode
+}
+
+func (d *dynamoDBNamespaceSettings) GetKeepTag() string {
+	return d.keepTag
+}
+
 func (d *dynamoDBNamespaceSettings) GetClient(ctx context.Context, keyID, secretKey string) (DynamoDBClient, error) {
-	return dynaclient.New(ctx, d.region, keyID, secretKey, d.customEndpointURL)
+	return dynaclient.NewFromConfig(ctx, dynaclient.Config{
+		Region:               d.region,
+		KeyID:                keyID,
+		SecretKey:            secretKey,
+		CustomEndpointURL:    d.customEndpointURL,
+		RoleARN:              d.role.arn,
+		ExternalID:           d.role.externalID,
+		SessionName:          d.role.sessionName,
+		WebIdentityTokenFile: d.role.webIdentityTokenFile,
+		MaxAttempts:          d.maxRetryAttempts,
+		MaxBackoff:           d.maxRetryBackoff,
+		HTTPTimeout:          d.httpTimeout,
+		LogRequests:          d.logRequests,
+	})
 }
@@ -0,0 +1,268 @@
+package csbdynamodbns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// DynamoDB limits the number of concurrent control plane operations per account,
+// so we only delete a handful of tables at once
+const maxConcurrentDeletions = 10
+
+// These are variables rather than constants so that tests can shorten them
+var (
+	retryBaseDelay = time.Second
+	retryMaxDelay  = 30 * time.Second
+	waiterMinDelay = 5 * time.Second
+	waiterMaxDelay = 30 * time.Second
+)
+
+// deleteTables deletes the tables concurrently and waits for them to be gone. When requested,
+// each table is backed up first. Diagnostics are returned in the same order as the table names.
+func deleteTables(ctx context.Context, client DynamoDBClient, tableNames []string, backupBeforeDelete bool) diag.Diagnostics {
+	type result struct {
+		backupARN string
+		err       error
+	}
+
+	var (
+		wg        sync.WaitGroup
+		semaphore = make(chan struct{}, maxConcurrentDeletions)
+		results   = make([]result, len(tableNames))
+	)
+
+	for i, tableName := range tableNames {
+		wg.Go(func() {
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			results[i].backupARN, results[i].err = deleteTable(ctx, client, tableName, backupBeforeDelete)
+		})
+	}
+	wg.Wait()
+
+	var d diag.Diagnostics
+	for i, r := range results {
+		if r.backupARN != "" {
+			d = append(d, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("table %q was backed up before deletion", tableNames[i]),
+				Detail:   fmt.Sprintf("backup ARN: %s", r.backupARN),
+			})
+		}
+		if r.err != nil {
+			d = append(d, diag.Diagnostic{Severity: diag.Error, Summary: r.err.Error()})
+		}
+	}
+	return d
+}
+
+// deleteTable returns the ARN of the backup, if one was taken, even when the deletion itself fails
+func deleteTable(ctx context.Context, client DynamoDBClient, tableName string, backupBeforeDelete bool) (string, error) {
+	var backupARN string
+	if backupBeforeDelete {
+		var err error
+		if backupARN, err = backupTable(ctx, client, tableName); err != nil {
+			return backupARN, err
+		}
+	}
+
+	err := retryOnContention(ctx, fmt.Sprintf("deleting table %q", tableName), func() error {
+		_, err := client.DeleteTable(ctx, &dynamodb.DeleteTableInput{TableName: aws.String(tableName)})
+		return err
+	})
+	switch {
+	case isNotFound(err):
+		// Somebody else deleted it first
+		return backupARN, nil
+	case err != nil:
+		return backupARN, err
+	default:
+		return backupARN, waitForTableDeletion(ctx, client, tableName)
+	}
+}
+
+func backupTable(ctx context.Context, client DynamoDBClient, tableName string) (string, error) {
+	var output *dynamodb.CreateBackupOutput
+	err := retryOnContention(ctx, fmt.Sprintf("backing up table %q", tableName), func() (err error) {
+		output, err = client.CreateBackup(ctx, &dynamodb.CreateBackupInput{
+			TableName:  aws.String(tableName),
+			BackupName: aws.String(backupName(tableName, time.Now())),
+		})
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	if output.BackupDetails == nil {
+		return "", fmt.Errorf("no backup details returned for table %q", tableName)
+	}
+
+	backupARN := aws.ToString(output.BackupDetails.BackupArn)
+	tflog.Info(ctx, "created backup of table before deletion", map[string]any{"table": tableName, "backup_arn": backupARN})
+
+	if err := waitForBackup(ctx, client, backupARN); err != nil {
+		return backupARN, err
+	}
+	tflog.Info(ctx, "backup is available", map[string]any{"table": tableName, "backup_arn": backupARN})
+	return backupARN, nil
+}
+
+// backupName is derived from the table name, and truncated so that the result is within the 255 character limit
+func backupName(tableName string, now time.Time) string {
+	const maxTableNameLen = 255 - len("-20060102T150405Z")
+	return fmt.Sprintf("%s-%s", tableName[:min(len(tableName), maxTableNameLen)], now.UTC().Format("20060102T150405Z"))
+}
+
+func waitForBackup(ctx context.Context, client DynamoDBClient, backupARN string) error {
+	delay := waiterMinDelay
+	for {
+		output, err := client.DescribeBackup(ctx, &dynamodb.DescribeBackupInput{BackupArn: aws.String(backupARN)})
+		if err != nil {
+			return fmt.Errorf("error waiting for backup %q to become available: %w", backupARN, err)
+		}
+
+		if output.BackupDescription != nil && output.BackupDescription.BackupDetails != nil {
+			switch output.BackupDescription.BackupDetails.BackupStatus {
+			case types.BackupStatusAvailable:
+				return nil
+			case types.BackupStatusDeleted:
+				return fmt.Errorf("backup %q was deleted before it became available", backupARN)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for backup %q to become available", backupARN)
+		case <-time.After(delay):
+		}
+		delay = min(2*delay, waiterMaxDelay)
+	}
+}
+
+// dryRunDiagnostics reports the tables that would have been deleted
+func dryRunDiagnostics(tableNames []string) diag.Diagnostics {
+	var d diag.Diagnostics
+	for _, tableName := range tableNames {
+		d = append(d, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("dry run: table %q would have been deleted", tableName),
+		})
+	}
+	return d
+}
+
+// excludeProtectedTables filters out tables that have deletion protection enabled or that are
+// tagged with the keep tag, and reports them as warnings. Tables that cannot be checked are
+// reported as errors and are not deleted.
+func excludeProtectedTables(ctx context.Context, client DynamoDBClient, tableNames []string, keepTag string) ([]string, diag.Diagnostics) {
+	var (
+		result []string
+		d      diag.Diagnostics
+	)
+
+	for _, tableName := range tableNames {
+		reason, err := protectionReason(ctx, client, tableName, keepTag)
+		switch {
+		case isNotFound(err):
+			continue
+		case err != nil:
+			d = append(d, diag.Diagnostic{Severity: diag.Error, Summary: err.Error()})
+		case reason != "":
+			d = append(d, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("table %q was not deleted", tableName),
+				Detail:   reason,
+			})
+		default:
+			result = append(result, tableName)
+		}
+	}
+
+	return result, d
+}
+
+// protectionReason returns a description of why the table must not be deleted, or an empty string
+func protectionReason(ctx context.Context, client DynamoDBClient, tableName, keepTag string) (string, error) {
+	output, err := client.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(tableName)})
+	if err != nil {
+		return "", err
+	}
+	if aws.ToBool(output.Table.DeletionProtectionEnabled) {
+		return "deletion protection is enabled", nil
+	}
+	if keepTag == "" {
+		return "", nil
+	}
+
+	input := &dynamodb.ListTagsOfResourceInput{ResourceArn: output.Table.TableArn}
+	for {
+		tags, err := client.ListTagsOfResource(ctx, input)
+		if err != nil {
+			return "", err
+		}
+		for _, tag := range tags.Tags {
+			if aws.ToString(tag.Key) == keepTag {
+				return fmt.Sprintf("the table is tagged with %q", keepTag), nil
+			}
+		}
+		if tags.NextToken == nil {
+			return "", nil
+		}
+		input.NextToken = tags.NextToken
+	}
+}
+
+// retryOnContention runs the operation until it succeeds, fails with an error that is not
+// worth retrying, or the context is done
+func retryOnContention(ctx context.Context, description string, operation func() error) error {
+	delay := retryBaseDelay
+	for {
+		err := operation()
+		if err == nil || !isContentionError(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out %s: %w", description, err)
+		case <-time.After(delay):
+		}
+		delay = min(2*delay, retryMaxDelay)
+	}
+}
+
+func waitForTableDeletion(ctx context.Context, client DynamoDBClient, tableName string) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("no deadline set for waiting on the deletion of table %q", tableName)
+	}
+
+	waiter := dynamodb.NewTableNotExistsWaiter(client, func(o *dynamodb.TableNotExistsWaiterOptions) {
+		o.MinDelay = waiterMinDelay
+		o.MaxDelay = waiterMaxDelay
+	})
+	if err := waiter.Wait(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(tableName)}, time.Until(deadline)); err != nil {
+		return fmt.Errorf("error waiting for table %q to be deleted: %w", tableName, err)
+	}
+	return nil
+}
+
+// isContentionError identifies errors caused by the account-wide limit on concurrent
+// control plane operations, or by the table being created or updated at the time
+func isContentionError(err error) bool {
+	var (
+		limitExceeded *types.LimitExceededException
+		resourceInUse *types.ResourceInUseException
+	)
+	return errors.As(err, &limitExceeded) || errors.As(err, &resourceInUse)
+}
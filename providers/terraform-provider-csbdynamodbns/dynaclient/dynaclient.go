@@ -3,35 +3,122 @@ package dynaclient
 
 import (
 	"context"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go/middleware"
 )
 
+// Config describes how to connect to DynamoDB
+type Config struct {
+	Region            string
+	KeyID             string
+	SecretKey         string
+	CustomEndpointURL string
+
+	// When RoleARN is set, the credentials are obtained by assuming the role, either with the
+	// static key or default credentials, or with the token in WebIdentityTokenFile when it is set
+	RoleARN              string
+	ExternalID           string
+	SessionName          string
+	WebIdentityTokenFile string
+
+	// Zero values mean that the SDK defaults are used
+	MaxAttempts int
+	MaxBackoff  time.Duration
+	HTTPTimeout time.Duration
+
+	// When LogRequests is set, every request and response is logged at debug level through tflog
+	LogRequests bool
+}
+
+// New creates a DynamoDB client. When no key is specified, the default AWS credentials chain is used.
 func New(ctx context.Context, region, keyID, secretKey, customEndpointURL string) (*dynamodb.Client, error) {
-	cfg, err := config.LoadDefaultConfig(ctx,
-		config.WithRegion(region),
-		config.WithCredentialsProvider(
+	return NewFromConfig(ctx, Config{
+		Region:            region,
+		KeyID:             keyID,
+		SecretKey:         secretKey,
+		CustomEndpointURL: customEndpointURL,
+	})
+}
+
+// NewFromConfig creates a DynamoDB client, assuming a role when one is configured
+func NewFromConfig(ctx context.Context, c Config) (*dynamodb.Client, error) {
+	opts := []func(*config.LoadOptions) error{
+		config.WithRegion(c.Region),
+		config.WithRetryer(c.retryer),
+	}
+	if c.HTTPTimeout > 0 {
+		opts = append(opts, config.WithHTTPClient(awshttp.NewBuildableClient().WithTimeout(c.HTTPTimeout)))
+	}
+	if c.LogRequests {
+		opts = append(opts, config.WithAPIOptions([]func(*middleware.Stack) error{addRequestLogger}))
+	}
+	if c.KeyID != "" || c.SecretKey != "" {
+		opts = append(opts, config.WithCredentialsProvider(
 			aws.NewCredentialsCache(
 				credentials.NewStaticCredentialsProvider(
-					keyID,
-					secretKey,
+					c.KeyID,
+					c.SecretKey,
 					"",
 				),
 			),
-		),
-	)
+		))
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, opts...)
 	if err != nil {
 		return nil, err
 	}
 
+	if c.RoleARN != "" {
+		cfg.Credentials = aws.NewCredentialsCache(c.roleCredentialsProvider(cfg))
+	}
+
 	// For testing we use a custom endpoint
-	var opts []func(*dynamodb.Options)
-	if customEndpointURL != "" {
-		opts = append(opts, dynamodb.WithEndpointResolverV2(endpointResolverV2{endpoint: customEndpointURL}))
+	var clientOpts []func(*dynamodb.Options)
+	if c.CustomEndpointURL != "" {
+		clientOpts = append(clientOpts, dynamodb.WithEndpointResolverV2(endpointResolverV2[dynamodb.EndpointParameters]{endpoint: c.CustomEndpointURL}))
+	}
+
+	return dynamodb.NewFromConfig(cfg, clientOpts...), nil
+}
+
+func (c Config) retryer() aws.Retryer {
+	return retry.NewStandard(func(o *retry.StandardOptions) {
+		if c.MaxAttempts > 0 {
+			o.MaxAttempts = c.MaxAttempts
+		}
+		if c.MaxBackoff > 0 {
+			o.MaxBackoff = c.MaxBackoff
+		}
+	})
+}
+
+func (c Config) roleCredentialsProvider(cfg aws.Config) aws.CredentialsProvider {
+	var stsOpts []func(*sts.Options)
+	if c.CustomEndpointURL != "" {
+		stsOpts = append(stsOpts, sts.WithEndpointResolverV2(endpointResolverV2[sts.EndpointParameters]{endpoint: c.CustomEndpointURL}))
+	}
+	stsClient := sts.NewFromConfig(cfg, stsOpts...)
+
+	if c.WebIdentityTokenFile != "" {
+		return stscreds.NewWebIdentityRoleProvider(stsClient, c.RoleARN, stscreds.IdentityTokenFile(c.WebIdentityTokenFile), func(o *stscreds.WebIdentityRoleOptions) {
+			o.RoleSessionName = c.SessionName
+		})
 	}
 
-	return dynamodb.NewFromConfig(cfg, opts...), nil
+	return stscreds.NewAssumeRoleProvider(stsClient, c.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+		o.RoleSessionName = c.SessionName
+		if c.ExternalID != "" {
+			o.ExternalID = aws.String(c.ExternalID)
+		}
+	})
 }
@@ -0,0 +1,13 @@
+package dynaclient_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestDynaclient(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Dynaclient Suite")
+}
@@ -0,0 +1,242 @@
+package dynaclient_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-dynamodbns/dynaclient"
+)
+
+// standIn plays the part of both STS and DynamoDB, and records what it receives
+type standIn struct {
+	lock         sync.Mutex
+	stsRequests  []url.Values
+	dynamoAuthzs []string
+}
+
+func (s *standIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if target := r.Header.Get("X-Amz-Target"); strings.HasPrefix(target, "DynamoDB_") {
+		s.dynamoAuthzs = append(s.dynamoAuthzs, r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		fmt.Fprint(w, `{"TableNames":["csb-table"]}`)
+		return
+	}
+
+	Expect(r.ParseForm()).To(Succeed())
+	s.stsRequests = append(s.stsRequests, r.PostForm)
+	action := r.PostForm.Get("Action")
+	w.Header().Set("Content-Type", "text/xml")
+	fmt.Fprintf(w, `<%[1]sResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <%[1]sResult>
+    <Credentials>
+      <AccessKeyId>ASIAASSUMEDKEY</AccessKeyId>
+      <SecretAccessKey>assumed-secret</SecretAccessKey>
+      <SessionToken>assumed-token</SessionToken>
+      <Expiration>2099-01-01T00:00:00Z</Expiration>
+    </Credentials>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::123456789012:assumed-role/housekeeping/session</Arn>
+      <AssumedRoleId>AROAEXAMPLE:session</AssumedRoleId>
+    </AssumedRoleUser>
+  </%[1]sResult>
+</%[1]sResponse>`, action)
+}
+
+var _ = Describe("New", func() {
+	var (
+		handler *standIn
+		server  *httptest.Server
+	)
+
+	BeforeEach(func() {
+		handler = &standIn{}
+		server = httptest.NewServer(handler)
+		DeferCleanup(server.Close)
+	})
+
+	It("uses the static credentials", func() {
+		client, err := dynaclient.New(context.TODO(), "us-west-2", "AKIASTATICKEY", "static-secret", server.URL)
+		Expect(err).NotTo(HaveOccurred())
+
+		output, err := client.ListTables(context.TODO(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.TableNames).To(ConsistOf("csb-table"))
+
+		Expect(handler.stsRequests).To(BeEmpty())
+		Expect(handler.dynamoAuthzs).To(ConsistOf(ContainSubstring("Credential=AKIASTATICKEY/")))
+	})
+
+	It("assumes a role through the custom endpoint", func() {
+		client, err := dynaclient.NewFromConfig(context.TODO(), dynaclient.Config{
+			Region:            "us-west-2",
+			KeyID:             "AKIASTATICKEY",
+			SecretKey:         "static-secret",
+			CustomEndpointURL: server.URL,
+			RoleARN:           "arn:aws:iam::123456789012:role/housekeeping",
+			ExternalID:        "external-id",
+			SessionName:       "csb-session",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = client.ListTables(context.TODO(), nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(handler.stsRequests).To(HaveLen(1))
+		Expect(handler.stsRequests[0].Get("Action")).To(Equal("AssumeRole"))
+		Expect(handler.stsRequests[0].Get("RoleArn")).To(Equal("arn:aws:iam::123456789012:role/housekeeping"))
+		Expect(handler.stsRequests[0].Get("ExternalId")).To(Equal("external-id"))
+		Expect(handler.stsRequests[0].Get("RoleSessionName")).To(Equal("csb-session"))
+		Expect(handler.dynamoAuthzs).To(ConsistOf(ContainSubstring("Credential=ASIAASSUMEDKEY/")))
+	})
+
+	It("assumes a role with a web identity token", func() {
+		tokenFile := filepath.Join(GinkgoT().TempDir(), "token")
+		Expect(os.WriteFile(tokenFile, []byte("fake-oidc-token"), 0o600)).To(Succeed())
+
+		client, err := dynaclient.NewFromConfig(context.TODO(), dynaclient.Config{
+			Region:               "us-west-2",
+			CustomEndpointURL:    server.URL,
+			RoleARN:              "arn:aws:iam::123456789012:role/housekeeping",
+			SessionName:          "csb-session",
+			WebIdentityTokenFile: tokenFile,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = client.ListTables(context.TODO(), nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(handler.stsRequests).To(HaveLen(1))
+		Expect(handler.stsRequests[0].Get("Action")).To(Equal("AssumeRoleWithWebIdentity"))
+		Expect(handler.stsRequests[0].Get("WebIdentityToken")).To(Equal("fake-oidc-token"))
+		Expect(handler.stsRequests[0].Get("RoleSessionName")).To(Equal("csb-session"))
+		Expect(handler.dynamoAuthzs).To(ConsistOf(ContainSubstring("Credential=ASIAASSUMEDKEY/")))
+	})
+
+	It("fails when the role cannot be assumed", func() {
+		client, err := dynaclient.NewFromConfig(context.TODO(), dynaclient.Config{
+			Region:               "us-west-2",
+			CustomEndpointURL:    server.URL,
+			RoleARN:              "arn:aws:iam::123456789012:role/housekeeping",
+			WebIdentityTokenFile: "/does/not/exist",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = client.ListTables(context.TODO(), nil)
+		Expect(err).To(MatchError(ContainSubstring("failed to retrieve jwt")))
+		Expect(handler.dynamoAuthzs).To(BeEmpty())
+	})
+})
+
+var _ = Describe("NewFromConfig", func() {
+	It("makes the configured number of attempts", func() {
+		var attempts atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts.Add(1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		DeferCleanup(server.Close)
+
+		client, err := dynaclient.NewFromConfig(context.TODO(), dynaclient.Config{
+			Region:            "us-west-2",
+			KeyID:             "AKIASTATICKEY",
+			SecretKey:         "static-secret",
+			CustomEndpointURL: server.URL,
+			MaxAttempts:       4,
+			MaxBackoff:        time.Millisecond,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = client.ListTables(context.TODO(), nil)
+		Expect(err).To(MatchError(ContainSubstring("StatusCode: 503")))
+		Expect(attempts.Load()).To(BeEquivalentTo(4))
+	})
+
+	It("times out slow HTTP requests", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(200 * time.Millisecond)
+		}))
+		DeferCleanup(server.Close)
+
+		client, err := dynaclient.NewFromConfig(context.TODO(), dynaclient.Config{
+			Region:            "us-west-2",
+			KeyID:             "AKIASTATICKEY",
+			SecretKey:         "static-secret",
+			CustomEndpointURL: server.URL,
+			MaxAttempts:       1,
+			HTTPTimeout:       20 * time.Millisecond,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = client.ListTables(context.TODO(), nil)
+		Expect(err).To(MatchError(ContainSubstring("Client.Timeout exceeded")))
+	})
+
+	It("logs requests with the credentials redacted", func() {
+		server := httptest.NewServer(&standIn{})
+		DeferCleanup(server.Close)
+
+		var output bytes.Buffer
+		ctx := tflogtest.RootLogger(context.TODO(), &output)
+
+		client, err := dynaclient.NewFromConfig(ctx, dynaclient.Config{
+			Region:            "us-west-2",
+			KeyID:             "AKIASTATICKEY",
+			SecretKey:         "static-secret",
+			CustomEndpointURL: server.URL,
+			LogRequests:       true,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = client.ListTables(ctx, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		entries, err := tflogtest.MultilineJSONDecode(&output)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(ConsistOf(SatisfyAll(
+			HaveKeyWithValue("@message", "AWS request"),
+			HaveKeyWithValue("service", "DynamoDB"),
+			HaveKeyWithValue("operation", "ListTables"),
+			HaveKeyWithValue("status", BeEquivalentTo(http.StatusOK)),
+			HaveKeyWithValue("request_headers", HaveKeyWithValue("Authorization", "<redacted>")),
+		)))
+		Expect(output.String()).NotTo(ContainSubstring("AKIASTATICKEY"))
+	})
+
+	It("does not log requests by default", func() {
+		server := httptest.NewServer(&standIn{})
+		DeferCleanup(server.Close)
+
+		var output bytes.Buffer
+		ctx := tflogtest.RootLogger(context.TODO(), &output)
+
+		client, err := dynaclient.NewFromConfig(ctx, dynaclient.Config{
+			Region:            "us-west-2",
+			KeyID:             "AKIASTATICKEY",
+			SecretKey:         "static-secret",
+			CustomEndpointURL: server.URL,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = client.ListTables(ctx, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.String()).To(BeEmpty())
+	})
+})
@@ -5,17 +5,22 @@ import (
 	"net/url"
 
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	smithyendpoints "github.com/aws/smithy-go/endpoints"
 )
 
 // Fail fast if the interface is not implemented
-var _ dynamodb.EndpointResolverV2 = endpointResolverV2{}
+var (
+	_ dynamodb.EndpointResolverV2 = endpointResolverV2[dynamodb.EndpointParameters]{}
+	_ sts.EndpointResolverV2      = endpointResolverV2[sts.EndpointParameters]{}
+)
 
-type endpointResolverV2 struct {
+// endpointResolverV2 resolves every request of a service to the same endpoint, whatever its parameters
+type endpointResolverV2[P any] struct {
 	endpoint string
 }
 
-func (e endpointResolverV2) ResolveEndpoint(ctx context.Context, params dynamodb.EndpointParameters) (smithyendpoints.Endpoint, error) {
+func (e endpointResolverV2[P]) ResolveEndpoint(ctx context.Context, params P) (smithyendpoints.Endpoint, error) {
 	u, err := url.Parse(e.endpoint)
 	if err != nil {
 		return smithyendpoints.Endpoint{}, err
@@ -0,0 +1,71 @@
+package dynaclient
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const redacted = "<redacted>"
+
+// These headers carry signatures or session tokens, so they are never logged
+var sensitiveHeaders = []string{"Authorization", "X-Amz-Security-Token"}
+
+// Fail fast if the interface is not implemented
+var _ middleware.DeserializeMiddleware = requestLogger{}
+
+// requestLogger logs every attempt of every request, with its response, through tflog. It sits in the
+// deserialize step so that it runs after the retryer and the signer. Bodies are not logged.
+type requestLogger struct{}
+
+func (requestLogger) ID() string {
+	return "CSBRequestLogger"
+}
+
+func (requestLogger) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (middleware.DeserializeOutput, middleware.Metadata, error) {
+	start := time.Now()
+	out, metadata, err := next.HandleDeserialize(ctx, in)
+
+	fields := map[string]any{
+		"service":     awsmiddleware.GetServiceID(ctx),
+		"operation":   awsmiddleware.GetOperationName(ctx),
+		"duration_ms": time.Since(start).Milliseconds(),
+	}
+	if req, ok := in.Request.(*smithyhttp.Request); ok {
+		fields["method"] = req.Method
+		fields["url"] = req.URL.String()
+		fields["request_headers"] = redactHeaders(req.Header)
+	}
+	if resp, ok := out.RawResponse.(*smithyhttp.Response); ok {
+		fields["status"] = resp.StatusCode
+		fields["response_headers"] = redactHeaders(resp.Header)
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+	}
+	tflog.Debug(ctx, "AWS request", fields)
+
+	return out, metadata, err
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	result := make(map[string]string, len(header))
+	for name := range header {
+		result[name] = header.Get(name)
+	}
+	for _, name := range sensitiveHeaders {
+		if _, ok := result[name]; ok {
+			result[name] = redacted
+		}
+	}
+	return result
+}
+
+func addRequestLogger(stack *middleware.Stack) error {
+	return stack.Deserialize.Add(requestLogger{}, middleware.After)
+}
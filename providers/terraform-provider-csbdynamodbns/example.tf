@@ -19,3 +19,37 @@ resource "csbdynamodbns_instance" "service_instance" {
   access_key_id     = "FAKE-access-key-id"
   secret_access_key = "FAKE-secret-access-key"
 }
+
+resource "csbdynamodbns_table" "orders" {
+  access_key_id     = "FAKE-access-key-id"
+  secret_access_key = "FAKE-secret-access-key"
+
+  name      = "csb-46d6f6fb-c746-4488-8ed9-bc05bff03eb8-orders"
+  hash_key  = "customer_id"
+  range_key = "order_id"
+
+  attribute {
+    name = "customer_id"
+    type = "S"
+  }
+
+  attribute {
+    name = "order_id"
+    type = "S"
+  }
+
+  attribute {
+    name = "status"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name            = "by_status"
+    hash_key        = "status"
+    projection_type = "KEYS_ONLY"
+  }
+
+  ttl_attribute          = "expires_at"
+  stream_view_type       = "NEW_AND_OLD_IMAGES"
+  point_in_time_recovery = true
+}
@@ -0,0 +1,37 @@
+// Command generate-catalog writes an engine version catalogue from AWS. It uses the default
+// AWS credentials chain, and needs the rds:DescribeDBEngineVersions permission.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-majorengineversion/csbmajorengineversion"
+)
+
+func main() {
+	var region, engines, output string
+
+	flag.StringVar(&region, "region", "us-west-2", "AWS region to describe the engine versions in")
+	flag.StringVar(&engines, "engines", "postgres,mysql,aurora-postgresql,aurora-mysql,sqlserver-ee,sqlserver-se,sqlserver-ex,sqlserver-web,docdb", "comma separated list of engines")
+	flag.StringVar(&output, "output", "engine_versions.json", "file to write the catalogue to")
+	flag.Parse()
+
+	catalog, err := csbmajorengineversion.GenerateCatalog(context.Background(), csbmajorengineversion.ClientConfig{Region: region}, strings.Split(engines, ","))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	data, err := json.MarshalIndent(catalog, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(output, append(data, '\n'), 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,137 @@
+package csbmajorengineversion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+const (
+	// CatalogModeFallback means that the catalogue is only used when the API call fails
+	CatalogModeFallback = "fallback"
+	// CatalogModeOffline means that the catalogue is used instead of the API
+	CatalogModeOffline = "offline"
+)
+
+// Catalog is an offline copy of the DescribeDBEngineVersions output for some engines
+type Catalog struct {
+	GeneratedFrom string                   `json:"generated_from"`
+	Engines       map[string]CatalogEngine `json:"engines"`
+}
+
+type CatalogEngine struct {
+	DefaultVersion string           `json:"default_version,omitempty"`
+	Versions       []CatalogVersion `json:"versions"`
+}
+
+type CatalogVersion struct {
+	EngineVersion       string                 `json:"engine_version"`
+	MajorEngineVersion  string                 `json:"major_engine_version"`
+	Status              string                 `json:"status,omitempty"`
+	ValidUpgradeTargets []CatalogUpgradeTarget `json:"valid_upgrade_targets,omitempty"`
+}
+
+type CatalogUpgradeTarget struct {
+	EngineVersion         string `json:"engine_version"`
+	IsMajorVersionUpgrade bool   `json:"is_major_version_upgrade"`
+}
+
+// LoadCatalog reads a catalogue file, such as one written by cmd/generate-catalog
+func LoadCatalog(path string) (*Catalog, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read engine version catalogue: %w", err)
+	}
+
+	var catalog Catalog
+	if err := json.Unmarshal(data, &catalog); err != nil {
+		return nil, fmt.Errorf("failed to parse engine version catalogue %q: %w", path, err)
+	}
+
+	return &catalog, nil
+}
+
+// GenerateCatalog describes every version of the engines, so that the result can be saved as a catalogue
+func GenerateCatalog(ctx context.Context, clientConfig ClientConfig, engines []string) (*Catalog, error) {
+	catalog := Catalog{
+		GeneratedFrom: fmt.Sprintf("DescribeDBEngineVersions in %s", clientConfig.Region),
+		Engines:       make(map[string]CatalogEngine, len(engines)),
+	}
+
+	for _, engine := range engines {
+		descriptor := NewEngineDescriptor(engine, clientConfig, nil, "")
+
+		versions, err := descriptor.listEngineVersions(ctx, &rds.DescribeDBEngineVersionsInput{IncludeAll: aws.Bool(true)})
+		if err != nil {
+			return nil, err
+		}
+		defaultVersion, err := descriptor.DefaultVersion(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		catalogEngine := CatalogEngine{DefaultVersion: defaultVersion}
+		for _, version := range versions {
+			catalogEngine.Versions = append(catalogEngine.Versions, fromDBEngineVersion(version))
+		}
+		catalog.Engines[engine] = catalogEngine
+	}
+
+	return &catalog, nil
+}
+
+// describe filters the catalogue like the API filters the engine versions
+func (c *Catalog) describe(engine string, input *rds.DescribeDBEngineVersionsInput) []types.DBEngineVersion {
+	catalogEngine := c.Engines[engine]
+	filter := aws.ToString(input.EngineVersion)
+
+	var result []types.DBEngineVersion
+	for _, version := range catalogEngine.Versions {
+		switch {
+		case aws.ToBool(input.DefaultOnly) && version.EngineVersion != catalogEngine.DefaultVersion:
+		case filter != "" && version.EngineVersion != filter && !strings.HasPrefix(version.EngineVersion, filter+"."):
+		default:
+			result = append(result, version.toDBEngineVersion(engine))
+		}
+	}
+
+	return result
+}
+
+func fromDBEngineVersion(version types.DBEngineVersion) CatalogVersion {
+	result := CatalogVersion{
+		EngineVersion:      aws.ToString(version.EngineVersion),
+		MajorEngineVersion: aws.ToString(version.MajorEngineVersion),
+		Status:             aws.ToString(version.Status),
+	}
+	for _, target := range version.ValidUpgradeTarget {
+		result.ValidUpgradeTargets = append(result.ValidUpgradeTargets, CatalogUpgradeTarget{
+			EngineVersion:         aws.ToString(target.EngineVersion),
+			IsMajorVersionUpgrade: aws.ToBool(target.IsMajorVersionUpgrade),
+		})
+	}
+	return result
+}
+
+func (v CatalogVersion) toDBEngineVersion(engine string) types.DBEngineVersion {
+	result := types.DBEngineVersion{
+		Engine:             aws.String(engine),
+		EngineVersion:      aws.String(v.EngineVersion),
+		MajorEngineVersion: aws.String(v.MajorEngineVersion),
+		Status:             aws.String(v.Status),
+	}
+	for _, target := range v.ValidUpgradeTargets {
+		result.ValidUpgradeTarget = append(result.ValidUpgradeTarget, types.UpgradeTarget{
+			Engine:                aws.String(engine),
+			EngineVersion:         aws.String(target.EngineVersion),
+			IsMajorVersionUpgrade: aws.Bool(target.IsMajorVersionUpgrade),
+		})
+	}
+	return result
+}
@@ -0,0 +1,193 @@
+package csbmajorengineversion_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-majorengineversion/csbmajorengineversion"
+)
+
+var _ = Describe("Engine version catalogue", func() {
+	const catalogJSON = `{
+  "generated_from": "test",
+  "engines": {
+    "postgres": {
+      "default_version": "15.3",
+      "versions": [
+        {
+          "engine_version": "14.2",
+          "major_engine_version": "14",
+          "status": "deprecated",
+          "valid_upgrade_targets": [{"engine_version": "15.3", "is_major_version_upgrade": true}]
+        },
+        {"engine_version": "15.3", "major_engine_version": "15", "status": "available"}
+      ]
+    }
+  }
+}`
+
+	var (
+		standIn     *rdsStandIn
+		server      *httptest.Server
+		catalogPath string
+	)
+
+	BeforeEach(func() {
+		standIn = &rdsStandIn{versions: []engineVersion{
+			{Engine: "postgres", EngineVersion: "16.1", MajorEngineVersion: "16", Default: true},
+		}}
+		server = httptest.NewServer(standIn)
+		DeferCleanup(server.Close)
+
+		catalogPath = filepath.Join(GinkgoT().TempDir(), "catalog.json")
+		Expect(os.WriteFile(catalogPath, []byte(catalogJSON), 0o600)).To(Succeed())
+	})
+
+	configure := func(config map[string]any) any {
+		config["engine"] = "postgres"
+		config["region"] = "us-west-2"
+		config["access_key_id"] = "AKIASTATICKEY"
+		config["secret_access_key"] = "static-secret"
+		data := schema.TestResourceDataRaw(GinkgoT(), csbmajorengineversion.ProviderSchema(), config)
+		meta, diags := csbmajorengineversion.ProviderConfigureContext(context.TODO(), data)
+		Expect(diags).To(BeEmpty())
+		return meta
+	}
+
+	read := func(meta any, resource *schema.Resource, version string) *schema.ResourceData {
+		data := resource.TestResourceData()
+		Expect(data.Set("engine_version", version)).To(Succeed())
+		Expect(resource.ReadContext(context.TODO(), data, meta)).To(BeEmpty())
+		return data
+	}
+
+	It("uses the catalogue instead of the API when offline", func() {
+		meta := configure(map[string]any{
+			"engine_version_catalog":      catalogPath,
+			"engine_version_catalog_mode": "offline",
+			"custom_endpoint_url":         server.URL,
+		})
+
+		Expect(read(meta, csbmajorengineversion.DataSourceMajorEngineVersion(), "14").Get("major_version")).To(Equal("14"))
+
+		data := read(meta, csbmajorengineversion.DataSourceUpgradeTargets(), "14.2")
+		Expect(data.Get("deprecated")).To(BeTrue())
+		Expect(data.Get("default_version")).To(Equal("15.3"))
+		Expect(data.Get("upgrade_targets")).To(ConsistOf(map[string]any{"engine_version": "15.3", "is_major_version_upgrade": true}))
+
+		Expect(standIn.rdsRequests).To(BeEmpty())
+	})
+
+	It("reports versions that are not in the catalogue like the API does", func() {
+		meta := configure(map[string]any{
+			"engine_version_catalog":      catalogPath,
+			"engine_version_catalog_mode": "offline",
+		})
+
+		data := csbmajorengineversion.DataSourceMajorEngineVersion().TestResourceData()
+		Expect(data.Set("engine_version", "ANY-VALUE-AT-ALL")).To(Succeed())
+		diags := csbmajorengineversion.DataSourceMajorEngineVersion().ReadContext(context.TODO(), data, meta)
+		Expect(diags).To(HaveLen(1))
+		Expect(diags[0].Summary).To(Equal("invalid parameter combination. API does not return any db engine version - engine postgres - engine version ANY-VALUE-AT-ALL"))
+	})
+
+	It("prefers the API when the catalogue is a fallback", func() {
+		meta := configure(map[string]any{
+			"engine_version_catalog": catalogPath,
+			"custom_endpoint_url":    server.URL,
+		})
+
+		Expect(read(meta, csbmajorengineversion.DataSourceMajorEngineVersion(), "16").Get("major_version")).To(Equal("16"))
+		Expect(standIn.rdsRequests).To(HaveLen(1))
+	})
+
+	It("falls back to the catalogue when the API fails", func() {
+		server.Close()
+		meta := configure(map[string]any{
+			"engine_version_catalog": catalogPath,
+			"custom_endpoint_url":    server.URL,
+		})
+
+		Expect(read(meta, csbmajorengineversion.DataSourceMajorEngineVersion(), "15.3").Get("major_version")).To(Equal("15"))
+	})
+
+	It("reports errors returned by the API rather than falling back to the catalogue", func() {
+		standIn.errorCode = "AccessDenied"
+		meta := configure(map[string]any{
+			"engine_version_catalog": catalogPath,
+			"custom_endpoint_url":    server.URL,
+		})
+
+		data := csbmajorengineversion.DataSourceMajorEngineVersion().TestResourceData()
+		Expect(data.Set("engine_version", "15.3")).To(Succeed())
+		diags := csbmajorengineversion.DataSourceMajorEngineVersion().ReadContext(context.TODO(), data, meta)
+		Expect(diags).To(HaveLen(1))
+		Expect(diags[0].Summary).To(ContainSubstring("AccessDenied"))
+	})
+
+	It("reads the catalogue from the environment", func() {
+		GinkgoT().Setenv("CSB_ENGINE_VERSION_CATALOG", catalogPath)
+		GinkgoT().Setenv("CSB_ENGINE_VERSION_CATALOG_MODE", "offline")
+		meta := configure(map[string]any{"custom_endpoint_url": server.URL})
+
+		Expect(read(meta, csbmajorengineversion.DataSourceMajorEngineVersion(), "14.2").Get("major_version")).To(Equal("14"))
+		Expect(standIn.rdsRequests).To(BeEmpty())
+	})
+
+	It("reports catalogues that cannot be parsed", func() {
+		Expect(os.WriteFile(catalogPath, []byte("not json"), 0o600)).To(Succeed())
+
+		_, err := csbmajorengineversion.LoadCatalog(catalogPath)
+		Expect(err).To(MatchError(HavePrefix("failed to parse engine version catalogue")))
+	})
+
+	It("reports catalogues that cannot be read", func() {
+		data := schema.TestResourceDataRaw(GinkgoT(), csbmajorengineversion.ProviderSchema(), map[string]any{
+			"engine":                 "postgres",
+			"region":                 "us-west-2",
+			"engine_version_catalog": "/does/not/exist.json",
+		})
+		_, diags := csbmajorengineversion.ProviderConfigureContext(context.TODO(), data)
+		Expect(diags).To(HaveLen(1))
+		Expect(diags[0].Summary).To(HavePrefix("failed to read engine version catalogue"))
+	})
+
+	It("generates a catalogue from the API", func() {
+		standIn.versions = append(standIn.versions, engineVersion{
+			Engine:             "postgres",
+			EngineVersion:      "15.3",
+			MajorEngineVersion: "15",
+			Status:             "available",
+			ValidUpgradeTarget: []upgradeTarget{{EngineVersion: "16.1", IsMajorVersionUpgrade: true}},
+		})
+
+		catalog, err := csbmajorengineversion.GenerateCatalog(context.TODO(), csbmajorengineversion.ClientConfig{
+			Region:            "us-west-2",
+			AccessKeyID:       "AKIASTATICKEY",
+			SecretAccessKey:   "static-secret",
+			CustomEndpointURL: server.URL,
+		}, []string{"postgres"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(catalog.GeneratedFrom).To(Equal("DescribeDBEngineVersions in us-west-2"))
+		Expect(catalog.Engines).To(Equal(map[string]csbmajorengineversion.CatalogEngine{
+			"postgres": {
+				DefaultVersion: "16.1",
+				Versions: []csbmajorengineversion.CatalogVersion{
+					{EngineVersion: "16.1", MajorEngineVersion: "16"},
+					{
+						EngineVersion:       "15.3",
+						MajorEngineVersion:  "15",
+						Status:              "available",
+						ValidUpgradeTargets: []csbmajorengineversion.CatalogUpgradeTarget{{EngineVersion: "16.1", IsMajorVersionUpgrade: true}},
+					},
+				},
+			},
+		}))
+	})
+})
@@ -3,6 +3,7 @@ package csbmajorengineversion
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,6 +22,11 @@ func DataSourceMajorEngineVersion() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			resolvedVersionKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Newest exact engine version that matches engine_version",
+			},
 		},
 		ReadContext: resourceMajorEngineVersionRead,
 		Description: "Returns major engine version value",
@@ -31,7 +37,7 @@ func resourceMajorEngineVersionRead(ctx context.Context, d *schema.ResourceData,
 	descriptor := meta.(*engineDescriptor)
 
 	engineVersion := d.Get(engineVersionKey).(string)
-	majorEngineVersion, err := descriptor.Describe(ctx, engineVersion)
+	resolved, err := descriptor.Resolve(ctx, engineVersion)
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -39,12 +45,17 @@ func resourceMajorEngineVersionRead(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId("version")
 
+	majorEngineVersion := aws.ToString(resolved.MajorEngineVersion)
 	tflog.Debug(ctx, "Setting Major DB engine version", map[string]any{
 		"major_engine_version": majorEngineVersion,
+		"resolved_version":     aws.ToString(resolved.EngineVersion),
 	})
 	if err := d.Set(majorVersionKey, majorEngineVersion); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set(resolvedVersionKey, aws.ToString(resolved.EngineVersion)); err != nil {
+		return diag.FromErr(err)
+	}
 	return nil
 
 }
@@ -0,0 +1,148 @@
+package csbmajorengineversion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const versionStatusDeprecated = "deprecated"
+
+func DataSourceUpgradeTargets() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			engineVersionKey: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Exact engine version to upgrade from",
+			},
+			targetVersionKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "When specified, the read fails unless this version is a valid upgrade target",
+			},
+			allowMajorVersionUpgradeKey: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether target_version may be a major version upgrade",
+			},
+			deprecatedKey: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether engine_version is deprecated",
+			},
+			defaultVersionKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version that RDS uses when none is specified",
+			},
+			upgradeTargetsKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Versions that engine_version can be upgraded to",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						engineVersionKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						isMajorVersionUpgradeKey: {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		ReadContext: dataSourceUpgradeTargetsRead,
+		Description: "Returns the valid upgrade targets of an engine version, and the default engine version",
+	}
+}
+
+func dataSourceUpgradeTargetsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	descriptor := meta.(*engineDescriptor)
+	engineVersion := d.Get(engineVersionKey).(string)
+
+	version, err := descriptor.DescribeExact(ctx, engineVersion)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	defaultVersion, err := descriptor.DefaultVersion(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	targets := make([]map[string]any, 0, len(version.ValidUpgradeTarget))
+	majorUpgrades := make(map[string]bool, len(version.ValidUpgradeTarget))
+	for _, target := range version.ValidUpgradeTarget {
+		targetVersion := aws.ToString(target.EngineVersion)
+		majorUpgrades[targetVersion] = aws.ToBool(target.IsMajorVersionUpgrade)
+		targets = append(targets, map[string]any{
+			engineVersionKey:         targetVersion,
+			isMajorVersionUpgradeKey: aws.ToBool(target.IsMajorVersionUpgrade),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", descriptor.engine, engineVersion))
+
+	tflog.Debug(ctx, "Setting DB engine version upgrade targets", map[string]any{
+		"engine_version":  engineVersion,
+		"default_version": defaultVersion,
+		"upgrade_targets": len(targets),
+	})
+	for key, value := range map[string]any{
+		deprecatedKey:     aws.ToString(version.Status) == versionStatusDeprecated,
+		defaultVersionKey: defaultVersion,
+		upgradeTargetsKey: targets,
+	} {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if targetVersion, ok := d.GetOk(targetVersionKey); ok {
+		return validateUpgradeTarget(descriptor.engine, engineVersion, targetVersion.(string), d.Get(allowMajorVersionUpgradeKey).(bool), majorUpgrades)
+	}
+
+	return nil
+}
+
+func validateUpgradeTarget(engine, from, to string, allowMajor bool, majorUpgrades map[string]bool) diag.Diagnostics {
+	if from == to {
+		return nil
+	}
+
+	isMajor, ok := majorUpgrades[to]
+	switch {
+	case !ok:
+		valid := make([]string, 0, len(majorUpgrades))
+		for version := range majorUpgrades {
+			valid = append(valid, version)
+		}
+		slices.Sort(valid)
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("invalid upgrade of engine %s from version %s to version %s", engine, from, to),
+			Detail:   fmt.Sprintf("valid upgrade targets are: %s", strings.Join(valid, ", ")),
+		}}
+	case isMajor && !allowMajor:
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("upgrade of engine %s from version %s to version %s is a major version upgrade", engine, from, to),
+			Detail:   "major version upgrades must be allowed explicitly",
+		}}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,108 @@
+package csbmajorengineversion_test
+
+import (
+	"context"
+	"net/http/httptest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-majorengineversion/csbmajorengineversion"
+)
+
+var _ = Describe("DataSourceUpgradeTargets", func() {
+	var (
+		meta any
+		data *schema.ResourceData
+	)
+
+	BeforeEach(func() {
+		server := httptest.NewServer(&rdsStandIn{versions: []engineVersion{
+			{
+				Engine:             "postgres",
+				EngineVersion:      "14.2",
+				MajorEngineVersion: "14",
+				Status:             "deprecated",
+				ValidUpgradeTarget: []upgradeTarget{
+					{EngineVersion: "14.7"},
+					{EngineVersion: "15.3", IsMajorVersionUpgrade: true},
+				},
+			},
+			{Engine: "postgres", EngineVersion: "14.7", MajorEngineVersion: "14", Status: "available"},
+			{Engine: "postgres", EngineVersion: "15.3", MajorEngineVersion: "15", Status: "available", Default: true},
+		}})
+		DeferCleanup(server.Close)
+
+		config := schema.TestResourceDataRaw(GinkgoT(), csbmajorengineversion.ProviderSchema(), map[string]any{
+			"engine":              "postgres",
+			"region":              "us-west-2",
+			"access_key_id":       "AKIASTATICKEY",
+			"secret_access_key":   "static-secret",
+			"custom_endpoint_url": server.URL,
+		})
+		var diags diag.Diagnostics
+		meta, diags = csbmajorengineversion.ProviderConfigureContext(context.TODO(), config)
+		Expect(diags).To(BeEmpty())
+
+		data = csbmajorengineversion.DataSourceUpgradeTargets().TestResourceData()
+		Expect(data.Set("engine_version", "14.2")).To(Succeed())
+	})
+
+	read := func() diag.Diagnostics {
+		return csbmajorengineversion.DataSourceUpgradeTargets().ReadContext(context.TODO(), data, meta)
+	}
+
+	It("lists the upgrade targets, the default version and whether the version is deprecated", func() {
+		Expect(read()).To(BeEmpty())
+		Expect(data.Id()).To(Equal("postgres/14.2"))
+		Expect(data.Get("deprecated")).To(BeTrue())
+		Expect(data.Get("default_version")).To(Equal("15.3"))
+		Expect(data.Get("upgrade_targets")).To(Equal([]any{
+			map[string]any{"engine_version": "14.7", "is_major_version_upgrade": false},
+			map[string]any{"engine_version": "15.3", "is_major_version_upgrade": true},
+		}))
+	})
+
+	It("only describes the exact version", func() {
+		Expect(data.Set("engine_version", "14")).To(Succeed())
+
+		diags := read()
+		Expect(diags).To(HaveLen(1))
+		Expect(diags[0].Summary).To(Equal("invalid parameter combination. API does not return any db engine version - engine postgres - engine version 14"))
+	})
+
+	It("accepts a minor version upgrade", func() {
+		Expect(data.Set("target_version", "14.7")).To(Succeed())
+
+		Expect(read()).To(BeEmpty())
+	})
+
+	It("accepts the same version", func() {
+		Expect(data.Set("target_version", "14.2")).To(Succeed())
+
+		Expect(read()).To(BeEmpty())
+	})
+
+	It("refuses a major version upgrade unless it is allowed", func() {
+		Expect(data.Set("target_version", "15.3")).To(Succeed())
+
+		diags := read()
+		Expect(diags).To(HaveLen(1))
+		Expect(diags[0].Summary).To(Equal("upgrade of engine postgres from version 14.2 to version 15.3 is a major version upgrade"))
+
+		Expect(data.Set("allow_major_version_upgrade", true)).To(Succeed())
+		Expect(read()).To(BeEmpty())
+	})
+
+	It("refuses targets that are not valid", func() {
+		Expect(data.Set("target_version", "16.1")).To(Succeed())
+		Expect(data.Set("allow_major_version_upgrade", true)).To(Succeed())
+
+		diags := read()
+		Expect(diags).To(HaveLen(1))
+		Expect(diags[0].Summary).To(Equal("invalid upgrade of engine postgres from version 14.2 to version 16.1"))
+		Expect(diags[0].Detail).To(Equal("valid upgrade targets are: 14.7, 15.3"))
+	})
+})
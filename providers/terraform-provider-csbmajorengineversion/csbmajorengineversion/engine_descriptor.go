@@ -2,53 +2,221 @@ package csbmajorengineversion
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// ClientConfig describes how to connect to RDS. When no key is specified, the default AWS credentials
+// chain is used. When RoleARN is specified, the role is assumed with those credentials.
+type ClientConfig struct {
+	Region            string
+	AccessKeyID       string
+	SecretAccessKey   string
+	RoleARN           string
+	CustomEndpointURL string
+}
+
 type engineDescriptor struct {
-	engine string
-	region string
+	engine       string
+	clientConfig ClientConfig
+	catalog      *Catalog
+	catalogMode  string
+
+	lock   sync.Mutex
+	client *rds.Client
 }
 
-func NewEngineDescriptor(engine, region string) *engineDescriptor {
-	return &engineDescriptor{engine: engine, region: region}
+// NewEngineDescriptor creates a descriptor that uses the API, the catalogue, or both depending on the
+// catalogue mode. The catalogue may be nil, in which case only the API is used.
+func NewEngineDescriptor(engine string, clientConfig ClientConfig, catalog *Catalog, catalogMode string) *engineDescriptor {
+	return &engineDescriptor{engine: engine, clientConfig: clientConfig, catalog: catalog, catalogMode: catalogMode}
 }
 
-func (e *engineDescriptor) Describe(ctx context.Context, engineVersion string) (string, error) {
-	cfg, err := config.LoadDefaultConfig(ctx)
-	if err != nil {
-		return "", fmt.Errorf("failed to load aws config %w", err)
+// Resolve returns the newest engine version that matches a partial version such as "15" or "8.0",
+// an exact version, or one of the aliases "latest" and "default"
+func (e *engineDescriptor) Resolve(ctx context.Context, engineVersion string) (types.DBEngineVersion, error) {
+	if engineVersion == aliasDefault {
+		defaultVersion, err := e.DefaultVersion(ctx)
+		if err != nil {
+			return types.DBEngineVersion{}, err
+		}
+		return e.DescribeExact(ctx, defaultVersion)
 	}
-	rdsClient := rds.NewFromConfig(cfg)
 
 	tflog.Debug(ctx, "Retrieving AWS DB engine versions", map[string]any{
 		"engine":         e.engine,
 		"engine_version": engineVersion,
 	})
-	output, err := rdsClient.DescribeDBEngineVersions(
-		ctx,
-		&rds.DescribeDBEngineVersionsInput{
-			Engine:        aws.String(e.engine),
-			EngineVersion: aws.String(engineVersion),
-			IncludeAll:    aws.Bool(true), // If false, Postgres version 14.2 does not return any output because it is no longer listed in the AWS console
-		},
-		func(options *rds.Options) { options.Region = e.region },
+	input := &rds.DescribeDBEngineVersionsInput{
+		IncludeAll: aws.Bool(true), // If false, Postgres version 14.2 does not return any output because it is no longer listed in the AWS console
+	}
+	if engineVersion != aliasLatest {
+		input.EngineVersion = aws.String(majorPrefix(engineVersion))
+	}
+	versions, err := e.listEngineVersions(ctx, input)
+	if err != nil {
+		return types.DBEngineVersion{}, err
+	}
+
+	newest, ok := newestMatchingVersion(versions, engineVersion)
+	if !ok {
+		return types.DBEngineVersion{}, e.noVersionError(engineVersion)
+	}
+
+	return newest, nil
+}
+
+// DescribeExact returns the description of an exact engine version, such as "15.3", and not
+// the first of the versions that match a partial one, such as "15"
+func (e *engineDescriptor) DescribeExact(ctx context.Context, engineVersion string) (types.DBEngineVersion, error) {
+	versions, err := e.listEngineVersions(ctx, &rds.DescribeDBEngineVersionsInput{
+		EngineVersion: aws.String(engineVersion),
+		IncludeAll:    aws.Bool(true),
+	})
+	if err != nil {
+		return types.DBEngineVersion{}, err
+	}
+
+	for _, version := range versions {
+		if aws.ToString(version.EngineVersion) == engineVersion {
+			return version, nil
+		}
+	}
+
+	return types.DBEngineVersion{}, e.noVersionError(engineVersion)
+}
+
+// DefaultVersion returns the version that RDS uses when none is specified
+func (e *engineDescriptor) DefaultVersion(ctx context.Context) (string, error) {
+	versions, err := e.listEngineVersions(ctx, &rds.DescribeDBEngineVersionsInput{
+		DefaultOnly: aws.Bool(true),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(versions) == 0 {
+		return "", fmt.Errorf("API does not return any default db engine version - engine %s", e.engine)
+	}
+
+	return aws.ToString(versions[0].EngineVersion), nil
+}
+
+func (e *engineDescriptor) listEngineVersions(ctx context.Context, input *rds.DescribeDBEngineVersionsInput) ([]types.DBEngineVersion, error) {
+	input.Engine = aws.String(e.engine)
+
+	if e.catalog != nil && e.catalogMode == CatalogModeOffline {
+		return e.catalog.describe(e.engine, input), nil
+	}
+
+	versions, err := e.describeEngineVersions(ctx, input)
+	if err != nil && e.catalog != nil && isUnreachable(err) {
+		tflog.Warn(ctx, "Falling back to the engine version catalogue", map[string]any{
+			"engine": e.engine,
+			"error":  err.Error(),
+		})
+		return e.catalog.describe(e.engine, input), nil
+	}
+
+	return versions, err
+}
+
+func (e *engineDescriptor) describeEngineVersions(ctx context.Context, input *rds.DescribeDBEngineVersionsInput) ([]types.DBEngineVersion, error) {
+	rdsClient, err := e.rdsClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []types.DBEngineVersion
+	paginator := rds.NewDescribeDBEngineVersionsPaginator(rdsClient, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe engine version: %w", err)
+		}
+		versions = append(versions, page.DBEngineVersions...)
+	}
+
+	return versions, nil
+}
+
+// isUnreachable tells the errors of an API that cannot be reached, for which the catalogue is a stand-in, from
+// the errors that the API returns, such as AccessDenied, which must not be hidden by a stale catalogue
+func isUnreachable(err error) bool {
+	var (
+		sendErr *smithyhttp.RequestSendError
+		apiErr  smithy.APIError
 	)
+	switch {
+	case errors.As(err, &sendErr):
+		return true
+	case errors.As(err, &apiErr):
+		return apiErr.ErrorFault() == smithy.FaultServer
+	default:
+		return false
+	}
+}
+
+func (e *engineDescriptor) noVersionError(engineVersion string) error {
+	return fmt.Errorf(
+		"invalid parameter combination. API does not return any db engine version - engine %s - engine version %s",
+		e.engine, engineVersion,
+	)
+}
+
+// rdsClient creates the client on first use, and then reuses it for every read
+func (e *engineDescriptor) rdsClient(ctx context.Context) (*rds.Client, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.client != nil {
+		return e.client, nil
+	}
+
+	c := e.clientConfig
+	opts := []func(*config.LoadOptions) error{config.WithRegion(c.Region)}
+	if c.AccessKeyID != "" {
+		opts = append(opts, config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(c.AccessKeyID, c.SecretAccessKey, "")))
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, opts...)
 	if err != nil {
-		return "", fmt.Errorf("failed to describe engine version: %w", err)
+		return nil, fmt.Errorf("failed to load aws config %w", err)
 	}
 
-	if len(output.DBEngineVersions) == 0 {
-		return "", fmt.Errorf(
-			"invalid parameter combination. API does not return any db engine version - engine %s - engine version %s",
-			e.engine, engineVersion,
-		)
+	if c.RoleARN != "" {
+		stsClient := sts.NewFromConfig(cfg, func(o *sts.Options) {
+			if c.CustomEndpointURL != "" {
+				o.BaseEndpoint = aws.String(c.CustomEndpointURL)
+			}
+		})
+		cfg.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(stsClient, c.RoleARN))
 	}
 
-	return aws.ToString(output.DBEngineVersions[0].MajorEngineVersion), nil
+	// Credentials are retrieved here rather than by the first call, so that a misconfigured broker is
+	// reported even when the credentials chain fails to reach its endpoint, instead of using the catalogue
+	if _, err := cfg.Credentials.Retrieve(ctx); err != nil {
+		return nil, fmt.Errorf("failed to retrieve aws credentials: %w", err)
+	}
+
+	e.client = rds.NewFromConfig(cfg, func(o *rds.Options) {
+		// For testing we use a custom endpoint
+		if c.CustomEndpointURL != "" {
+			o.BaseEndpoint = aws.String(c.CustomEndpointURL)
+		}
+	})
+
+	return e.client, nil
 }
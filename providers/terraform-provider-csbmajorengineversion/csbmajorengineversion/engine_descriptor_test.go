@@ -0,0 +1,96 @@
+package csbmajorengineversion_test
+
+import (
+	"context"
+	"net/http/httptest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-majorengineversion/csbmajorengineversion"
+)
+
+var _ = Describe("Provider configuration", func() {
+	var (
+		standIn *rdsStandIn
+		server  *httptest.Server
+	)
+
+	BeforeEach(func() {
+		standIn = &rdsStandIn{versions: []engineVersion{
+			{Engine: "postgres", EngineVersion: "15.3", MajorEngineVersion: "15"},
+		}}
+		server = httptest.NewServer(standIn)
+		DeferCleanup(server.Close)
+	})
+
+	configure := func(config map[string]any) any {
+		data := schema.TestResourceDataRaw(GinkgoT(), csbmajorengineversion.ProviderSchema(), config)
+		meta, diags := csbmajorengineversion.ProviderConfigureContext(context.TODO(), data)
+		Expect(diags).To(BeEmpty())
+		return meta
+	}
+
+	read := func(meta any) string {
+		data := csbmajorengineversion.DataSourceMajorEngineVersion().TestResourceData()
+		Expect(data.Set("engine_version", "15.3")).To(Succeed())
+		diags := csbmajorengineversion.DataSourceMajorEngineVersion().ReadContext(context.TODO(), data, meta)
+		Expect(diags).To(BeEmpty())
+		return data.Get("major_version").(string)
+	}
+
+	It("uses the configured region, credentials and endpoint", func() {
+		meta := configure(map[string]any{
+			"engine":              "postgres",
+			"region":              "eu-west-1",
+			"access_key_id":       "AKIASTATICKEY",
+			"secret_access_key":   "static-secret",
+			"custom_endpoint_url": server.URL,
+		})
+
+		Expect(read(meta)).To(Equal("15"))
+		Expect(standIn.stsRequests).To(BeEmpty())
+		Expect(standIn.authzs).To(ConsistOf(ContainSubstring("Credential=AKIASTATICKEY/")))
+		Expect(standIn.authzs).To(ConsistOf(ContainSubstring("/eu-west-1/rds/")))
+		Expect(standIn.rdsRequests[0].Get("Engine")).To(Equal("postgres"))
+		Expect(standIn.rdsRequests[0].Get("IncludeAll")).To(Equal("true"))
+		Expect(standIn.rdsRequests[0].Get("EngineVersion")).To(Equal("15"))
+	})
+
+	It("assumes the configured role once for every read", func() {
+		meta := configure(map[string]any{
+			"engine":              "postgres",
+			"region":              "us-west-2",
+			"access_key_id":       "AKIASTATICKEY",
+			"secret_access_key":   "static-secret",
+			"role_arn":            "arn:aws:iam::123456789012:role/engine-versions",
+			"custom_endpoint_url": server.URL,
+		})
+
+		Expect(read(meta)).To(Equal("15"))
+		Expect(read(meta)).To(Equal("15"))
+		Expect(standIn.stsRequests).To(HaveLen(1))
+		Expect(standIn.stsRequests[0].Get("RoleArn")).To(Equal("arn:aws:iam::123456789012:role/engine-versions"))
+		Expect(standIn.authzs).To(HaveExactElements(
+			ContainSubstring("Credential=ASIAASSUMEDKEY/"),
+			ContainSubstring("Credential=ASIAASSUMEDKEY/"),
+		))
+	})
+
+	It("reports versions that do not exist", func() {
+		meta := configure(map[string]any{
+			"engine":              "postgres",
+			"region":              "us-west-2",
+			"access_key_id":       "AKIASTATICKEY",
+			"secret_access_key":   "static-secret",
+			"custom_endpoint_url": server.URL,
+		})
+
+		data := csbmajorengineversion.DataSourceMajorEngineVersion().TestResourceData()
+		Expect(data.Set("engine_version", "16.1")).To(Succeed())
+		diags := csbmajorengineversion.DataSourceMajorEngineVersion().ReadContext(context.TODO(), data, meta)
+		Expect(diags).To(HaveLen(1))
+		Expect(diags[0].Summary).To(Equal("invalid parameter combination. API does not return any db engine version - engine postgres - engine version 16.1"))
+	})
+})
@@ -0,0 +1,3 @@
+package csbmajorengineversion
+
+var CompareVersions = compareVersions
@@ -1,9 +1,24 @@
 package csbmajorengineversion
 
 const (
-	engineKey           = "engine"
-	awsRegionKey        = "region"
-	engineVersionKey    = "engine_version"
-	majorVersionKey     = "major_version"
-	DataResourceNameKey = "csbmajorengineversion"
+	engineKey            = "engine"
+	awsRegionKey         = "region"
+	accessKeyIDKey       = "access_key_id"
+	secretAccessKeyKey   = "secret_access_key"
+	roleARNKey           = "role_arn"
+	customEndpointURLKey = "custom_endpoint_url"
+	catalogKey           = "engine_version_catalog"
+	catalogModeKey       = "engine_version_catalog_mode"
+	engineVersionKey     = "engine_version"
+	majorVersionKey      = "major_version"
+	resolvedVersionKey   = "resolved_version"
+	DataResourceNameKey  = "csbmajorengineversion"
+
+	targetVersionKey                  = "target_version"
+	allowMajorVersionUpgradeKey       = "allow_major_version_upgrade"
+	deprecatedKey                     = "deprecated"
+	defaultVersionKey                 = "default_version"
+	upgradeTargetsKey                 = "upgrade_targets"
+	isMajorVersionUpgradeKey          = "is_major_version_upgrade"
+	UpgradeTargetsDataResourceNameKey = "csbmajorengineversion_upgrade_targets"
 )
@@ -15,7 +15,8 @@ func Provider() *schema.Provider {
 		Schema:               ProviderSchema(),
 		ConfigureContextFunc: ProviderConfigureContext,
 		DataSourcesMap: map[string]*schema.Resource{
-			DataResourceNameKey: DataSourceMajorEngineVersion(),
+			DataResourceNameKey:               DataSourceMajorEngineVersion(),
+			UpgradeTargetsDataResourceNameKey: DataSourceUpgradeTargets(),
 		},
 	}
 }
@@ -32,13 +33,63 @@ func ProviderSchema() map[string]*schema.Schema {
 			Required:     true,
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
+		accessKeyIDKey: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{secretAccessKeyKey},
+			Description:  "When not specified, the default AWS credentials chain is used",
+		},
+		secretAccessKeyKey: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			RequiredWith: []string{accessKeyIDKey},
+		},
+		roleARNKey: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "Role to assume in order to describe the engine versions, for instance in another account",
+		},
+		customEndpointURLKey: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  "Endpoint used for both RDS and STS instead of the AWS one, mostly useful in tests",
+		},
+		catalogKey: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("CSB_ENGINE_VERSION_CATALOG", ""),
+			Description: "Path of a JSON engine version catalogue to use instead of, or as a fallback to, the API",
+		},
+		catalogModeKey: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			DefaultFunc:  schema.EnvDefaultFunc("CSB_ENGINE_VERSION_CATALOG_MODE", CatalogModeFallback),
+			ValidateFunc: validation.StringInSlice([]string{CatalogModeFallback, CatalogModeOffline}, false),
+			Description:  `Either "fallback", to use the catalogue only when the API cannot be reached, or "offline", to never call the API`,
+		},
 	}
 }
 
 func ProviderConfigureContext(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
 	tflog.Debug(ctx, "Configuring Terraform csbmajorengineversion Provider")
 	engine := d.Get(engineKey).(string)
-	region := d.Get(awsRegionKey).(string)
 
-	return NewEngineDescriptor(engine, region), nil
+	var catalog *Catalog
+	if path := d.Get(catalogKey).(string); path != "" {
+		var err error
+		if catalog, err = LoadCatalog(path); err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
+	return NewEngineDescriptor(engine, ClientConfig{
+		Region:            d.Get(awsRegionKey).(string),
+		AccessKeyID:       d.Get(accessKeyIDKey).(string),
+		SecretAccessKey:   d.Get(secretAccessKeyKey).(string),
+		RoleARN:           d.Get(roleARNKey).(string),
+		CustomEndpointURL: d.Get(customEndpointURLKey).(string),
+	}, catalog, d.Get(catalogModeKey).(string)), nil
 }
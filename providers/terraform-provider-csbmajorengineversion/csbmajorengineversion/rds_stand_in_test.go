@@ -0,0 +1,88 @@
+package csbmajorengineversion_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type engineVersion struct {
+	Engine             string
+	EngineVersion      string
+	MajorEngineVersion string
+	Status             string          `xml:",omitempty"`
+	ValidUpgradeTarget []upgradeTarget `xml:"ValidUpgradeTarget>UpgradeTarget,omitempty"`
+
+	// Default is not part of the response, but decides what is returned when only the default is requested
+	Default bool `xml:"-"`
+}
+
+type upgradeTarget struct {
+	EngineVersion         string
+	IsMajorVersionUpgrade bool
+}
+
+// rdsStandIn plays the part of both RDS and STS, and records what it receives
+type rdsStandIn struct {
+	versions []engineVersion
+	// errorCode, when set, is returned by DescribeDBEngineVersions as a client error
+	errorCode string
+
+	lock        sync.Mutex
+	rdsRequests []url.Values
+	stsRequests []url.Values
+	authzs      []string
+}
+
+func (s *rdsStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	Expect(r.ParseForm()).To(Succeed())
+	w.Header().Set("Content-Type", "text/xml")
+
+	switch action := r.PostForm.Get("Action"); action {
+	case "AssumeRole":
+		s.stsRequests = append(s.stsRequests, r.PostForm)
+		fmt.Fprint(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <Credentials>
+      <AccessKeyId>ASIAASSUMEDKEY</AccessKeyId>
+      <SecretAccessKey>assumed-secret</SecretAccessKey>
+      <SessionToken>assumed-token</SessionToken>
+      <Expiration>2099-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+</AssumeRoleResponse>`)
+	case "DescribeDBEngineVersions":
+		s.rdsRequests = append(s.rdsRequests, r.PostForm)
+		s.authzs = append(s.authzs, r.Header.Get("Authorization"))
+		if s.errorCode != "" {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintf(w, `<ErrorResponse xmlns="http://rds.amazonaws.com/doc/2014-10-31/"><Error><Type>Sender</Type><Code>%s</Code><Message>stand-in error</Message></Error><RequestId>stand-in</RequestId></ErrorResponse>`, s.errorCode)
+			return
+		}
+		fmt.Fprint(w, `<DescribeDBEngineVersionsResponse xmlns="http://rds.amazonaws.com/doc/2014-10-31/"><DescribeDBEngineVersionsResult><DBEngineVersions>`)
+		for _, v := range s.versions {
+			if v.Engine == r.PostForm.Get("Engine") &&
+				matchesVersion(v.EngineVersion, r.PostForm.Get("EngineVersion")) &&
+				(v.Default || r.PostForm.Get("DefaultOnly") != "true") {
+				Expect(xml.NewEncoder(w).EncodeElement(v, xml.StartElement{Name: xml.Name{Local: "DBEngineVersion"}})).To(Succeed())
+			}
+		}
+		fmt.Fprint(w, `</DBEngineVersions></DescribeDBEngineVersionsResult></DescribeDBEngineVersionsResponse>`)
+	default:
+		Fail(fmt.Sprintf("unexpected action %q", action))
+	}
+}
+
+// matchesVersion behaves like the RDS filter, where a major version matches all its minor versions
+func matchesVersion(version, filter string) bool {
+	return filter == "" || version == filter || strings.HasPrefix(version, filter+".")
+}
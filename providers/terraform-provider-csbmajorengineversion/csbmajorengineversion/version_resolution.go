@@ -0,0 +1,99 @@
+package csbmajorengineversion
+
+import (
+	"cmp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+const (
+	aliasLatest  = "latest"
+	aliasDefault = "default"
+
+	versionStatusAvailable = "available"
+)
+
+// majorPrefix is the first component of a version, which RDS accepts as a filter for all the versions
+// that start with it, so that only the versions that may match are listed
+func majorPrefix(version string) string {
+	prefix, _, _ := strings.Cut(version, ".")
+	return prefix
+}
+
+// newestMatchingVersion picks the newest of the versions that match the requested one. A version matches
+// when it is equal to the requested one, or when it starts with the requested one followed by a dot, so
+// that "8.0" matches "8.0.32" and "8.0.mysql_aurora.3.03.1", but "8.0.3" does not match "8.0.32".
+// The "latest" alias matches every available version, but no deprecated one.
+func newestMatchingVersion(versions []types.DBEngineVersion, requested string) (types.DBEngineVersion, bool) {
+	var (
+		newest types.DBEngineVersion
+		found  bool
+	)
+	for _, version := range versions {
+		v := aws.ToString(version.EngineVersion)
+		switch {
+		case requested == aliasLatest && aws.ToString(version.Status) != versionStatusAvailable:
+			continue
+		case requested != aliasLatest && v != requested && !strings.HasPrefix(v, requested+"."):
+			continue
+		}
+		if !found || compareVersions(v, aws.ToString(newest.EngineVersion)) > 0 {
+			newest, found = version, true
+		}
+	}
+	return newest, found
+}
+
+// compareVersions orders versions semantically: the dot separated components are compared in turn,
+// so that "14.10" is newer than "14.9", and a version is older than the versions it is a prefix of.
+// Versions that are semantically equal, such as "3.03" and "3.3", are ordered as plain strings so that
+// the result never depends on the order in which the API returns them.
+func compareVersions(a, b string) int {
+	aComponents, bComponents := strings.Split(a, "."), strings.Split(b, ".")
+	for i := range min(len(aComponents), len(bComponents)) {
+		if c := compareComponents(aComponents[i], bComponents[i]); c != 0 {
+			return c
+		}
+	}
+	return cmp.Or(cmp.Compare(len(aComponents), len(bComponents)), strings.Compare(a, b))
+}
+
+// compareComponents compares runs of digits numerically and other runs as strings, so that "v10" is
+// newer than "v9" and "mysql_aurora" compares equal to itself
+func compareComponents(a, b string) int {
+	aRuns, bRuns := splitRuns(a), splitRuns(b)
+	for i := range min(len(aRuns), len(bRuns)) {
+		aNumber, aErr := strconv.ParseUint(aRuns[i], 10, 64)
+		bNumber, bErr := strconv.ParseUint(bRuns[i], 10, 64)
+		var c int
+		if aErr == nil && bErr == nil {
+			c = cmp.Compare(aNumber, bNumber)
+		} else {
+			c = strings.Compare(aRuns[i], bRuns[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(aRuns), len(bRuns))
+}
+
+// splitRuns splits "v12abc" into "v", "12" and "abc"
+func splitRuns(s string) []string {
+	var runs []string
+	start := 0
+	for i, r := range s {
+		if i > start && unicode.IsDigit(r) != unicode.IsDigit(rune(s[start])) {
+			runs = append(runs, s[start:i])
+			start = i
+		}
+	}
+	if start < len(s) {
+		runs = append(runs, s[start:])
+	}
+	return runs
+}
@@ -0,0 +1,109 @@
+package csbmajorengineversion_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-majorengineversion/csbmajorengineversion"
+)
+
+var _ = Describe("Version resolution", func() {
+	DescribeTable(
+		"resolves partial versions and aliases to the newest matching version",
+		func(engine, requested, resolved, major string) {
+			server := httptest.NewServer(&rdsStandIn{versions: []engineVersion{
+				{Engine: "postgres", EngineVersion: "14.10", MajorEngineVersion: "14", Status: "available"},
+				{Engine: "postgres", EngineVersion: "17.2", MajorEngineVersion: "17", Status: "deprecated"},
+				{Engine: "postgres", EngineVersion: "16.1", MajorEngineVersion: "16", Status: "available"},
+				{Engine: "postgres", EngineVersion: "14.9", MajorEngineVersion: "14", Status: "available"},
+				{Engine: "postgres", EngineVersion: "15.3", MajorEngineVersion: "15", Status: "available", Default: true},
+				{Engine: "mysql", EngineVersion: "8.0.32", MajorEngineVersion: "8.0"},
+				{Engine: "mysql", EngineVersion: "8.0.4", MajorEngineVersion: "8.0"},
+				{Engine: "mysql", EngineVersion: "5.7.44", MajorEngineVersion: "5.7"},
+				{Engine: "aurora-mysql", EngineVersion: "8.0.mysql_aurora.3.10.0", MajorEngineVersion: "8.0"},
+				{Engine: "aurora-mysql", EngineVersion: "8.0.mysql_aurora.3.9.1", MajorEngineVersion: "8.0"},
+				{Engine: "sqlserver-ee", EngineVersion: "15.00.4236.7.v1", MajorEngineVersion: "15.00"},
+				{Engine: "sqlserver-ee", EngineVersion: "15.00.4236.7.v10", MajorEngineVersion: "15.00"},
+				{Engine: "sqlserver-ee", EngineVersion: "15.00.4236.7.v2", MajorEngineVersion: "15.00"},
+			}})
+			DeferCleanup(server.Close)
+
+			config := schema.TestResourceDataRaw(GinkgoT(), csbmajorengineversion.ProviderSchema(), map[string]any{
+				"engine":              engine,
+				"region":              "us-west-2",
+				"access_key_id":       "AKIASTATICKEY",
+				"secret_access_key":   "static-secret",
+				"custom_endpoint_url": server.URL,
+			})
+			meta, diags := csbmajorengineversion.ProviderConfigureContext(context.TODO(), config)
+			Expect(diags).To(BeEmpty())
+
+			data := csbmajorengineversion.DataSourceMajorEngineVersion().TestResourceData()
+			Expect(data.Set("engine_version", requested)).To(Succeed())
+			Expect(csbmajorengineversion.DataSourceMajorEngineVersion().ReadContext(context.TODO(), data, meta)).To(BeEmpty())
+			Expect(data.Get("resolved_version")).To(Equal(resolved))
+			Expect(data.Get("major_version")).To(Equal(major))
+		},
+		Entry("major version", "postgres", "14", "14.10", "14"),
+		Entry("exact version", "postgres", "14.9", "14.9", "14"),
+		Entry("latest", "postgres", "latest", "16.1", "16"),
+		Entry("deprecated major version newer than the latest", "postgres", "17", "17.2", "17"),
+		Entry("default", "postgres", "default", "15.3", "15"),
+		Entry("mysql major version", "mysql", "8.0", "8.0.32", "8.0"),
+		Entry("aurora major version", "aurora-mysql", "8.0", "8.0.mysql_aurora.3.10.0", "8.0"),
+		Entry("sqlserver major version", "sqlserver-ee", "15.00", "15.00.4236.7.v10", "15.00"),
+	)
+
+	It("reports partial versions that match nothing", func() {
+		server := httptest.NewServer(&rdsStandIn{versions: []engineVersion{
+			{Engine: "mysql", EngineVersion: "8.0.32", MajorEngineVersion: "8.0"},
+		}})
+		DeferCleanup(server.Close)
+
+		config := schema.TestResourceDataRaw(GinkgoT(), csbmajorengineversion.ProviderSchema(), map[string]any{
+			"engine":              "mysql",
+			"region":              "us-west-2",
+			"access_key_id":       "AKIASTATICKEY",
+			"secret_access_key":   "static-secret",
+			"custom_endpoint_url": server.URL,
+		})
+		meta, _ := csbmajorengineversion.ProviderConfigureContext(context.TODO(), config)
+
+		data := csbmajorengineversion.DataSourceMajorEngineVersion().TestResourceData()
+		Expect(data.Set("engine_version", "8.0.3")).To(Succeed())
+		diags := csbmajorengineversion.DataSourceMajorEngineVersion().ReadContext(context.TODO(), data, meta)
+		Expect(diags).To(HaveLen(1))
+		Expect(diags[0].Summary).To(Equal("invalid parameter combination. API does not return any db engine version - engine mysql - engine version 8.0.3"))
+	})
+
+	It("sorts versions semantically and deterministically", func() {
+		versions := []string{
+			"8.0.mysql_aurora.3.10.0",
+			"14.10",
+			"3.3",
+			"14.9",
+			"15.00.4236.7.v10",
+			"14",
+			"3.03",
+			"15.00.4236.7.v2",
+			"8.0.mysql_aurora.3.9.1",
+		}
+		slices.SortFunc(versions, csbmajorengineversion.CompareVersions)
+		Expect(versions).To(HaveExactElements(
+			"3.03",
+			"3.3",
+			"8.0.mysql_aurora.3.9.1",
+			"8.0.mysql_aurora.3.10.0",
+			"14",
+			"14.9",
+			"14.10",
+			"15.00.4236.7.v2",
+			"15.00.4236.7.v10",
+		))
+	})
+})
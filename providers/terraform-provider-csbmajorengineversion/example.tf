@@ -17,3 +17,8 @@ provider "csbmajorengineversion" {
 data "csbmajorengineversion" "major_version" {
   engine_version = "14.7"
 }
+
+data "csbmajorengineversion_upgrade_targets" "upgrade" {
+  engine_version = "14.7"
+  target_version = "14.9"
+}
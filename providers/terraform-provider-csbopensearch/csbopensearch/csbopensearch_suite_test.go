@@ -0,0 +1,13 @@
+package csbopensearch_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCsbopensearch(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CSB OpenSearch Suite")
+}
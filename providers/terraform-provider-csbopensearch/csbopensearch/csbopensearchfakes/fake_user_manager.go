@@ -0,0 +1,273 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+//
+//lint:file-ignore ST1000 auto-generated
+package csbopensearchfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-opensearch/csbopensearch"
+)
+
+type FakeUserManager struct {
+	DeleteUserStub        func(context.Context, string) error
+	deleteUserMutex       sync.RWMutex
+	deleteUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteUserReturns struct {
+		result1 error
+	}
+	deleteUserReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PutUserStub        func(context.Context, csbopensearch.BindingUser) error
+	putUserMutex       sync.RWMutex
+	putUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 csbopensearch.BindingUser
+	}
+	putUserReturns struct {
+		result1 error
+	}
+	putUserReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UserExistsStub        func(context.Context, string) (bool, error)
+	userExistsMutex       sync.RWMutex
+	userExistsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	userExistsReturns struct {
+		result1 bool
+		result2 error
+	}
+	userExistsReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUserManager) DeleteUser(arg1 context.Context, arg2 string) error {
+	fake.deleteUserMutex.Lock()
+	ret, specificReturn := fake.deleteUserReturnsOnCall[len(fake.deleteUserArgsForCall)]
+	fake.deleteUserArgsForCall = append(fake.deleteUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteUserStub
+	fakeReturns := fake.deleteUserReturns
+	fake.recordInvocation("DeleteUser", []interface{}{arg1, arg2})
+	fake.deleteUserMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserManager) DeleteUserCallCount() int {
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	return len(fake.deleteUserArgsForCall)
+}
+
+func (fake *FakeUserManager) DeleteUserCalls(stub func(context.Context, string) error) {
+	fake.deleteUserMutex.Lock()
+	defer fake.deleteUserMutex.Unlock()
+	fake.DeleteUserStub = stub
+}
+
+func (fake *FakeUserManager) DeleteUserArgsForCall(i int) (context.Context, string) {
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	argsForCall := fake.deleteUserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserManager) DeleteUserReturns(result1 error) {
+	fake.deleteUserMutex.Lock()
+	defer fake.deleteUserMutex.Unlock()
+	fake.DeleteUserStub = nil
+	fake.deleteUserReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserManager) DeleteUserReturnsOnCall(i int, result1 error) {
+	fake.deleteUserMutex.Lock()
+	defer fake.deleteUserMutex.Unlock()
+	fake.DeleteUserStub = nil
+	if fake.deleteUserReturnsOnCall == nil {
+		fake.deleteUserReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteUserReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserManager) PutUser(arg1 context.Context, arg2 csbopensearch.BindingUser) error {
+	fake.putUserMutex.Lock()
+	ret, specificReturn := fake.putUserReturnsOnCall[len(fake.putUserArgsForCall)]
+	fake.putUserArgsForCall = append(fake.putUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 csbopensearch.BindingUser
+	}{arg1, arg2})
+	stub := fake.PutUserStub
+	fakeReturns := fake.putUserReturns
+	fake.recordInvocation("PutUser", []interface{}{arg1, arg2})
+	fake.putUserMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserManager) PutUserCallCount() int {
+	fake.putUserMutex.RLock()
+	defer fake.putUserMutex.RUnlock()
+	return len(fake.putUserArgsForCall)
+}
+
+func (fake *FakeUserManager) PutUserCalls(stub func(context.Context, csbopensearch.BindingUser) error) {
+	fake.putUserMutex.Lock()
+	defer fake.putUserMutex.Unlock()
+	fake.PutUserStub = stub
+}
+
+func (fake *FakeUserManager) PutUserArgsForCall(i int) (context.Context, csbopensearch.BindingUser) {
+	fake.putUserMutex.RLock()
+	defer fake.putUserMutex.RUnlock()
+	argsForCall := fake.putUserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserManager) PutUserReturns(result1 error) {
+	fake.putUserMutex.Lock()
+	defer fake.putUserMutex.Unlock()
+	fake.PutUserStub = nil
+	fake.putUserReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserManager) PutUserReturnsOnCall(i int, result1 error) {
+	fake.putUserMutex.Lock()
+	defer fake.putUserMutex.Unlock()
+	fake.PutUserStub = nil
+	if fake.putUserReturnsOnCall == nil {
+		fake.putUserReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.putUserReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserManager) UserExists(arg1 context.Context, arg2 string) (bool, error) {
+	fake.userExistsMutex.Lock()
+	ret, specificReturn := fake.userExistsReturnsOnCall[len(fake.userExistsArgsForCall)]
+	fake.userExistsArgsForCall = append(fake.userExistsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.UserExistsStub
+	fakeReturns := fake.userExistsReturns
+	fake.recordInvocation("UserExists", []interface{}{arg1, arg2})
+	fake.userExistsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserManager) UserExistsCallCount() int {
+	fake.userExistsMutex.RLock()
+	defer fake.userExistsMutex.RUnlock()
+	return len(fake.userExistsArgsForCall)
+}
+
+func (fake *FakeUserManager) UserExistsCalls(stub func(context.Context, string) (bool, error)) {
+	fake.userExistsMutex.Lock()
+	defer fake.userExistsMutex.Unlock()
+	fake.UserExistsStub = stub
+}
+
+func (fake *FakeUserManager) UserExistsArgsForCall(i int) (context.Context, string) {
+	fake.userExistsMutex.RLock()
+	defer fake.userExistsMutex.RUnlock()
+	argsForCall := fake.userExistsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserManager) UserExistsReturns(result1 bool, result2 error) {
+	fake.userExistsMutex.Lock()
+	defer fake.userExistsMutex.Unlock()
+	fake.UserExistsStub = nil
+	fake.userExistsReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserManager) UserExistsReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.userExistsMutex.Lock()
+	defer fake.userExistsMutex.Unlock()
+	fake.UserExistsStub = nil
+	if fake.userExistsReturnsOnCall == nil {
+		fake.userExistsReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.userExistsReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserManager) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	fake.putUserMutex.RLock()
+	defer fake.putUserMutex.RUnlock()
+	fake.userExistsMutex.RLock()
+	defer fake.userExistsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeUserManager) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ csbopensearch.UserManager = new(FakeUserManager)
@@ -0,0 +1,77 @@
+// Package csbopensearch is a Terraform provider specialised for the OpenSearch service of the AWS brokerpak
+package csbopensearch
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	endpointKey      = "endpoint"
+	adminUsernameKey = "admin_username"
+	adminPasswordKey = "admin_password"
+	tlsSkipVerifyKey = "tls_skip_verify"
+)
+
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			endpointKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "URL of the OpenSearch domain endpoint, e.g. https://vpc-domain-id.us-west-2.es.amazonaws.com",
+			},
+			adminUsernameKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Master user of the internal user database of the domain",
+			},
+			adminPasswordKey: {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			tlsSkipVerifyKey: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Do not verify the server certificate. Only meant for tests",
+			},
+		},
+		ConfigureContextFunc: providerConfigure,
+		ResourcesMap: map[string]*schema.Resource{
+			"csbopensearch_binding_user": ResourceBindingUser(),
+		},
+	}
+}
+
+func providerConfigure(_ context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+	for _, f := range []func() diag.Diagnostics{
+		func() diag.Diagnostics {
+			endpoint, err := url.ParseRequestURI(d.Get(endpointKey).(string))
+			switch {
+			case err != nil:
+				return diag.Errorf("invalid value for %q: %s", endpointKey, err)
+			case endpoint.Scheme != "http" && endpoint.Scheme != "https":
+				return diag.Errorf("invalid value for %q: scheme must be http or https", endpointKey)
+			}
+			return nil
+		},
+	} {
+		if dg := f(); dg != nil {
+			return nil, dg
+		}
+	}
+
+	var settings = &openSearchSettings{
+		endpoint:      d.Get(endpointKey).(string),
+		adminUsername: d.Get(adminUsernameKey).(string),
+		adminPassword: d.Get(adminPasswordKey).(string),
+		tlsSkipVerify: d.Get(tlsSkipVerifyKey).(bool),
+	}
+
+	return settings, nil
+}
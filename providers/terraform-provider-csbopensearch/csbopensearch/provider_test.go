@@ -0,0 +1,99 @@
+package csbopensearch_test
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http/httptest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-opensearch/csbopensearch"
+)
+
+var _ = Describe("Provider", func() {
+	var provider *schema.Provider
+
+	BeforeEach(func() {
+		provider = csbopensearch.Provider()
+	})
+
+	configure := func(config map[string]any) diag.Diagnostics {
+		return provider.Configure(context.TODO(), terraform.NewResourceConfigRaw(config))
+	}
+
+	It("validates the provider schema", func() {
+		Expect(provider.InternalValidate()).To(Succeed())
+	})
+
+	It("accepts an HTTPS endpoint", func() {
+		Expect(configure(map[string]any{
+			"endpoint":       "https://vpc-csb-opensearch-fake.us-west-2.es.amazonaws.com",
+			"admin_username": "admin",
+			"admin_password": "fake-password",
+		})).To(BeEmpty())
+	})
+
+	It("rejects an endpoint without a scheme", func() {
+		d := configure(map[string]any{
+			"endpoint":       "vpc-csb-opensearch-fake.us-west-2.es.amazonaws.com",
+			"admin_username": "admin",
+			"admin_password": "fake-password",
+		})
+		Expect(d).To(HaveLen(1))
+		Expect(d[0].Summary).To(HavePrefix(`invalid value for "endpoint"`))
+	})
+
+	It("rejects an endpoint with another scheme", func() {
+		d := configure(map[string]any{
+			"endpoint":       "ftp://vpc-csb-opensearch-fake.us-west-2.es.amazonaws.com",
+			"admin_username": "admin",
+			"admin_password": "fake-password",
+		})
+		Expect(d).To(HaveLen(1))
+		Expect(d[0].Summary).To(Equal(`invalid value for "endpoint": scheme must be http or https`))
+	})
+
+	It("manages users through the security API of the endpoint", func() {
+		standIn := newSecurityAPIStandIn("admin", "admin-password")
+		server := httptest.NewTLSServer(standIn)
+		DeferCleanup(server.Close)
+
+		Expect(configure(map[string]any{
+			"endpoint":        server.URL,
+			"admin_username":  "admin",
+			"admin_password":  "admin-password",
+			"tls_skip_verify": true,
+		})).To(BeEmpty())
+
+		manager, err := provider.Meta().(csbopensearch.OpenSearchConfig).GetUserManager(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(manager.PutUser(context.TODO(), csbopensearch.BindingUser{Username: "binding_user", Password: "a-password", IndexPatterns: []string{"*"}})).To(Succeed())
+
+		_, exists := standIn.get("internalusers", "binding_user")
+		Expect(exists).To(BeTrue())
+	})
+
+	It("verifies the server certificate by default", func() {
+		server := httptest.NewUnstartedServer(newSecurityAPIStandIn("admin", "admin-password"))
+		// The handshake failure is expected, so it does not need logging
+		server.Config.ErrorLog = log.New(io.Discard, "", 0)
+		server.StartTLS()
+		DeferCleanup(server.Close)
+
+		Expect(configure(map[string]any{
+			"endpoint":       server.URL,
+			"admin_username": "admin",
+			"admin_password": "admin-password",
+		})).To(BeEmpty())
+
+		manager, err := provider.Meta().(csbopensearch.OpenSearchConfig).GetUserManager(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		_, err = manager.UserExists(context.TODO(), "binding_user")
+		Expect(err).To(MatchError(ContainSubstring("certificate")))
+	})
+})
@@ -0,0 +1,141 @@
+package csbopensearch
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	UsernameKey      = "username"
+	PasswordKey      = "password"
+	IndexPatternsKey = "index_patterns"
+	ReadOnlyKey      = "read_only"
+
+	defaultTimeout = 5 * time.Minute
+)
+
+func ResourceBindingUser() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			UsernameKey: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 63),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*$`), "must start with a letter and contain only letters, digits, underscores and hyphens"),
+				),
+				Description: "Name of the internal user, and of the role that the user is mapped to",
+			},
+			PasswordKey: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(8, 128),
+			},
+			IndexPatternsKey: {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				Description: "Patterns of the indices that the role grants access to, e.g. logs-*",
+			},
+			ReadOnlyKey: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only grant the role the permissions to read and search the indices",
+			},
+		},
+		CreateContext: resourceBindingUserPut,
+		ReadContext:   resourceBindingUserRead,
+		UpdateContext: resourceBindingUserPut,
+		DeleteContext: resourceBindingUserDelete,
+		Description:   "An OpenSearch internal user for a single binding, mapped to its own role",
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+// resourceBindingUserPut serves as both create and update, as the security API replaces the
+// role, the user and the mapping wholesale
+func resourceBindingUserPut(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	manager, err := config.(OpenSearchConfig).GetUserManager(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	timeout := schema.TimeoutUpdate
+	if data.Id() == "" {
+		timeout = schema.TimeoutCreate
+	}
+	ctx, cancel := context.WithTimeout(ctx, data.Timeout(timeout))
+	defer cancel()
+
+	user := bindingUser(data)
+	if err := manager.PutUser(ctx, user); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(user.Username)
+	return nil
+}
+
+// resourceBindingUserRead only looks up the internal user. When it is missing, the user is removed
+// from the state, and the next apply puts the role and the mapping again along with the user.
+func resourceBindingUserRead(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	manager, err := config.(OpenSearchConfig).GetUserManager(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	exists, err := manager.UserExists(ctx, data.Id())
+	switch {
+	case err != nil:
+		return diag.FromErr(err)
+	case !exists:
+		data.SetId("")
+	}
+
+	return nil
+}
+
+func resourceBindingUserDelete(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	manager, err := config.(OpenSearchConfig).GetUserManager(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, data.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	if err := manager.DeleteUser(ctx, data.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func bindingUser(data *schema.ResourceData) BindingUser {
+	var indexPatterns []string
+	for _, p := range data.Get(IndexPatternsKey).([]any) {
+		indexPatterns = append(indexPatterns, p.(string))
+	}
+
+	return BindingUser{
+		Username:      data.Get(UsernameKey).(string),
+		Password:      data.Get(PasswordKey).(string),
+		IndexPatterns: indexPatterns,
+		ReadOnly:      data.Get(ReadOnlyKey).(bool),
+	}
+}
@@ -0,0 +1,130 @@
+package csbopensearch_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-opensearch/csbopensearch"
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-opensearch/csbopensearch/csbopensearchfakes"
+)
+
+var _ = Describe("ResourceBindingUser", func() {
+	var (
+		resource *schema.Resource
+		manager  *csbopensearchfakes.FakeUserManager
+		config   *csbopensearchfakes.FakeOpenSearchConfig
+		data     *schema.ResourceData
+	)
+
+	BeforeEach(func() {
+		resource = csbopensearch.ResourceBindingUser()
+		manager = &csbopensearchfakes.FakeUserManager{}
+		config = &csbopensearchfakes.FakeOpenSearchConfig{}
+		config.GetUserManagerReturns(manager, nil)
+
+		data = schema.TestResourceDataRaw(GinkgoT(), resource.Schema, map[string]any{
+			csbopensearch.UsernameKey:      "binding_user",
+			csbopensearch.PasswordKey:      "a-password",
+			csbopensearch.IndexPatternsKey: []any{"logs-*", "metrics"},
+		})
+	})
+
+	It("creates a read-write user", func() {
+		Expect(resource.CreateContext(context.TODO(), data, config)).To(BeNil())
+
+		Expect(manager.PutUserCallCount()).To(Equal(1))
+		_, user := manager.PutUserArgsForCall(0)
+		Expect(user).To(Equal(csbopensearch.BindingUser{
+			Username:      "binding_user",
+			Password:      "a-password",
+			IndexPatterns: []string{"logs-*", "metrics"},
+			ReadOnly:      false,
+		}))
+		Expect(data.Id()).To(Equal("binding_user"))
+	})
+
+	It("reports creation failures", func() {
+		manager.PutUserReturns(fmt.Errorf("boom"))
+
+		d := resource.CreateContext(context.TODO(), data, config)
+		Expect(d).To(HaveLen(1))
+		Expect(d[0].Summary).To(Equal("boom"))
+		Expect(data.Id()).To(BeEmpty())
+	})
+
+	It("reports failures to get the user manager", func() {
+		config.GetUserManagerReturns(nil, fmt.Errorf("no route to host"))
+
+		d := resource.CreateContext(context.TODO(), data, config)
+		Expect(d).To(HaveLen(1))
+		Expect(d[0].Summary).To(Equal("no route to host"))
+		Expect(manager.PutUserCallCount()).To(BeZero())
+	})
+
+	It("updates the user", func() {
+		data.SetId("binding_user")
+		Expect(data.Set(csbopensearch.ReadOnlyKey, true)).To(Succeed())
+
+		Expect(resource.UpdateContext(context.TODO(), data, config)).To(BeNil())
+
+		Expect(manager.PutUserCallCount()).To(Equal(1))
+		_, user := manager.PutUserArgsForCall(0)
+		Expect(user.ReadOnly).To(BeTrue())
+	})
+
+	It("deletes the user", func() {
+		data.SetId("binding_user")
+
+		Expect(resource.DeleteContext(context.TODO(), data, config)).To(BeNil())
+
+		Expect(manager.DeleteUserCallCount()).To(Equal(1))
+		_, username := manager.DeleteUserArgsForCall(0)
+		Expect(username).To(Equal("binding_user"))
+	})
+
+	Describe("read", func() {
+		BeforeEach(func() {
+			data.SetId("binding_user")
+		})
+
+		It("keeps the user when it exists", func() {
+			manager.UserExistsReturns(true, nil)
+
+			Expect(resource.ReadContext(context.TODO(), data, config)).To(BeNil())
+			Expect(data.Id()).To(Equal("binding_user"))
+		})
+
+		It("removes the user from the state when it no longer exists", func() {
+			manager.UserExistsReturns(false, nil)
+
+			Expect(resource.ReadContext(context.TODO(), data, config)).To(BeNil())
+			Expect(data.Id()).To(BeEmpty())
+		})
+
+		It("reports failures to check the user", func() {
+			manager.UserExistsReturns(false, fmt.Errorf("boom"))
+
+			d := resource.ReadContext(context.TODO(), data, config)
+			Expect(d).To(HaveLen(1))
+			Expect(data.Id()).To(Equal("binding_user"))
+		})
+	})
+
+	DescribeTable("validation",
+		func(key, value string, valid bool) {
+			_, errs := resource.Schema[key].ValidateFunc(value, key)
+			Expect(errs == nil).To(Equal(valid))
+		},
+		Entry("username with letters, digits, underscores and hyphens", csbopensearch.UsernameKey, "user_1-a", true),
+		Entry("username with a leading digit", csbopensearch.UsernameKey, "1user", false),
+		Entry("username with a colon", csbopensearch.UsernameKey, "user:1", false),
+		Entry("username that is too long", csbopensearch.UsernameKey, "u"+strings.Repeat("1", 63), false),
+		Entry("password with special characters", csbopensearch.PasswordKey, "P@ss word/1", true),
+		Entry("password that is too short", csbopensearch.PasswordKey, "short", false),
+	)
+})
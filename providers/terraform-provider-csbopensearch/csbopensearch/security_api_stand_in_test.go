@@ -0,0 +1,123 @@
+package csbopensearch_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type securityAPIRequest struct {
+	Method   string
+	Resource string
+	Name     string
+}
+
+// securityAPIStandIn plays the part of the security API of an OpenSearch domain, keeping the users, roles
+// and role mappings in memory, and records what it receives
+type securityAPIStandIn struct {
+	username string
+	password string
+
+	lock      sync.Mutex
+	resources map[string]map[string]json.RawMessage
+	requests  []securityAPIRequest
+	failures  map[string]int
+}
+
+func newSecurityAPIStandIn(username, password string) *securityAPIStandIn {
+	return &securityAPIStandIn{
+		username: username,
+		password: password,
+		resources: map[string]map[string]json.RawMessage{
+			"internalusers": {},
+			"roles":         {},
+			"rolesmapping":  {},
+		},
+		failures: map[string]int{},
+	}
+}
+
+// fail makes every request for the resource fail with the status code
+func (s *securityAPIStandIn) fail(resource string, status int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.failures[resource] = status
+}
+
+func (s *securityAPIStandIn) get(resource, name string) (json.RawMessage, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	body, ok := s.resources[resource][name]
+	return body, ok
+}
+
+func (s *securityAPIStandIn) receivedRequests() []securityAPIRequest {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]securityAPIRequest{}, s.requests...)
+}
+
+func (s *securityAPIStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if username, password, ok := r.BasicAuth(); !ok || username != s.username || password != s.password {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, "Unauthorized")
+		return
+	}
+
+	resource, name, ok := strings.Cut(strings.TrimPrefix(r.URL.Path, "/_plugins/_security/api/"), "/")
+	Expect(ok).To(BeTrue(), "unexpected path %q", r.URL.Path)
+	Expect(s.resources).To(HaveKey(resource))
+	s.requests = append(s.requests, securityAPIRequest{Method: r.Method, Resource: resource, Name: name})
+
+	if status, ok := s.failures[resource]; ok {
+		w.WriteHeader(status)
+		fmt.Fprintf(w, `{"status":"error","reason":"%s failed"}`, resource)
+		return
+	}
+
+	_, exists := s.resources[resource][name]
+	switch r.Method {
+	case http.MethodGet:
+		if !exists {
+			s.notFound(w, resource, name)
+			return
+		}
+		Expect(json.NewEncoder(w).Encode(map[string]json.RawMessage{name: s.resources[resource][name]})).To(Succeed())
+	case http.MethodPut:
+		Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+		body, err := io.ReadAll(r.Body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(json.Valid(body)).To(BeTrue())
+		s.resources[resource][name] = body
+		if exists {
+			fmt.Fprintf(w, `{"status":"OK","message":"'%s' updated."}`, name)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"status":"CREATED","message":"'%s' created."}`, name)
+	case http.MethodDelete:
+		if !exists {
+			s.notFound(w, resource, name)
+			return
+		}
+		delete(s.resources[resource], name)
+		fmt.Fprintf(w, `{"status":"OK","message":"'%s' deleted."}`, name)
+	default:
+		Fail(fmt.Sprintf("unexpected method %q", r.Method))
+	}
+}
+
+func (s *securityAPIStandIn) notFound(w http.ResponseWriter, resource, name string) {
+	w.WriteHeader(http.StatusNotFound)
+	fmt.Fprintf(w, `{"status":"NOT_FOUND","message":"%s '%s' not found."}`, resource, name)
+}
@@ -0,0 +1,177 @@
+package csbopensearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const securityAPIPath = "_plugins/_security/api"
+
+// The action groups granted on the index patterns, and on the cluster for the operations that span indices,
+// such as bulk requests and multi-gets
+var (
+	readOnlyIndexActions    = []string{"read", "indices_monitor"}
+	readWriteIndexActions   = []string{"crud", "create_index", "indices_monitor"}
+	readOnlyClusterActions  = []string{"cluster_composite_ops_ro"}
+	readWriteClusterActions = []string{"cluster_composite_ops"}
+)
+
+// errNotFound is returned by the security API for users, roles and role mappings that do not exist
+var errNotFound = errors.New("not found")
+
+// NewSecurityAPIUserManager manages users with the security API of the fine-grained access control
+// of a domain, which requires the credentials of the master user
+func NewSecurityAPIUserManager(client *http.Client, endpoint, adminUsername, adminPassword string) UserManager {
+	return &securityAPIUserManager{
+		client:        client,
+		endpoint:      strings.TrimSuffix(endpoint, "/"),
+		adminUsername: adminUsername,
+		adminPassword: adminPassword,
+	}
+}
+
+type securityAPIUserManager struct {
+	client        *http.Client
+	endpoint      string
+	adminUsername string
+	adminPassword string
+}
+
+type role struct {
+	ClusterPermissions []string          `json:"cluster_permissions"`
+	IndexPermissions   []indexPermission `json:"index_permissions"`
+}
+
+type indexPermission struct {
+	IndexPatterns  []string `json:"index_patterns"`
+	AllowedActions []string `json:"allowed_actions"`
+}
+
+type internalUser struct {
+	Password string `json:"password"`
+}
+
+type roleMapping struct {
+	Users []string `json:"users"`
+}
+
+// PutUser both creates and updates users, since every security API request that it makes creates
+// the resource or replaces it
+func (m *securityAPIUserManager) PutUser(ctx context.Context, user BindingUser) error {
+	if err := m.setUser(ctx, user); err != nil {
+		return fmt.Errorf("error putting user %q: %w", user.Username, err)
+	}
+	tflog.Info(ctx, "put user", map[string]any{"username": user.Username, "index_patterns": user.IndexPatterns, "read_only": user.ReadOnly})
+	return nil
+}
+
+// setUser creates or replaces a role named after the user, the user, and the mapping of the user to the role.
+// The role is created first so that the user never has more permissions than the role grants.
+func (m *securityAPIUserManager) setUser(ctx context.Context, user BindingUser) error {
+	r := role{
+		ClusterPermissions: readWriteClusterActions,
+		IndexPermissions:   []indexPermission{{IndexPatterns: user.IndexPatterns, AllowedActions: readWriteIndexActions}},
+	}
+	if user.ReadOnly {
+		r.ClusterPermissions = readOnlyClusterActions
+		r.IndexPermissions[0].AllowedActions = readOnlyIndexActions
+	}
+
+	for _, step := range []struct {
+		resource string
+		body     any
+	}{
+		{resource: "roles", body: r},
+		{resource: "internalusers", body: internalUser{Password: user.Password}},
+		{resource: "rolesmapping", body: roleMapping{Users: []string{user.Username}}},
+	} {
+		if err := m.do(ctx, http.MethodPut, step.resource, user.Username, step.body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteUser removes the role mapping first, so that the user loses its permissions even when a later
+// request fails. Resources that are already gone are skipped, which lets the deletion be retried.
+func (m *securityAPIUserManager) DeleteUser(ctx context.Context, username string) error {
+	for _, resource := range []string{"rolesmapping", "internalusers", "roles"} {
+		if err := m.do(ctx, http.MethodDelete, resource, username, nil); err != nil && !errors.Is(err, errNotFound) {
+			return fmt.Errorf("error deleting user %q: %w", username, err)
+		}
+	}
+	tflog.Info(ctx, "deleted user", map[string]any{"username": username})
+	return nil
+}
+
+func (m *securityAPIUserManager) UserExists(ctx context.Context, username string) (bool, error) {
+	switch err := m.do(ctx, http.MethodGet, "internalusers", username, nil); {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, errNotFound):
+		return false, nil
+	default:
+		return false, fmt.Errorf("error getting user %q: %w", username, err)
+	}
+}
+
+func (m *securityAPIUserManager) do(ctx context.Context, method, resource, name string, body any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	target := fmt.Sprintf("%s/%s/%s/%s", m.endpoint, securityAPIPath, resource, url.PathEscape(name))
+	req, err := http.NewRequestWithContext(ctx, method, target, reader)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(m.adminUsername, m.adminPassword)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return fmt.Errorf("%s %s: %w", method, resource, errNotFound)
+	case resp.StatusCode >= http.StatusBadRequest:
+		return fmt.Errorf("%s %s: %s: %s", method, resource, resp.Status, errorMessage(resp.Body))
+	}
+	return nil
+}
+
+// errorMessage extracts the reason for an error from the response of the security API
+func errorMessage(body io.Reader) string {
+	var response struct {
+		Message string `json:"message"`
+		Reason  string `json:"reason"`
+	}
+	data, _ := io.ReadAll(body)
+	switch {
+	case json.Unmarshal(data, &response) != nil:
+		return strings.TrimSpace(string(data))
+	case response.Reason != "":
+		return response.Reason
+	default:
+		return response.Message
+	}
+}
@@ -0,0 +1,132 @@
+package csbopensearch_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-opensearch/csbopensearch"
+)
+
+var _ = Describe("Security API user manager", func() {
+	var (
+		standIn *securityAPIStandIn
+		server  *httptest.Server
+		manager csbopensearch.UserManager
+		user    csbopensearch.BindingUser
+	)
+
+	BeforeEach(func() {
+		standIn = newSecurityAPIStandIn("admin", "admin-password")
+		server = httptest.NewServer(standIn)
+		DeferCleanup(server.Close)
+
+		manager = csbopensearch.NewSecurityAPIUserManager(server.Client(), server.URL+"/", "admin", "admin-password")
+		user = csbopensearch.BindingUser{Username: "binding_user", Password: "a-password", IndexPatterns: []string{"logs-*", "metrics"}}
+	})
+
+	It("creates a role, the user, and maps the user to the role", func() {
+		Expect(manager.PutUser(context.TODO(), user)).To(Succeed())
+
+		Expect(standIn.receivedRequests()).To(HaveExactElements(
+			securityAPIRequest{Method: http.MethodPut, Resource: "roles", Name: "binding_user"},
+			securityAPIRequest{Method: http.MethodPut, Resource: "internalusers", Name: "binding_user"},
+			securityAPIRequest{Method: http.MethodPut, Resource: "rolesmapping", Name: "binding_user"},
+		))
+
+		role, _ := standIn.get("roles", "binding_user")
+		Expect(role).To(MatchJSON(`{
+			"cluster_permissions": ["cluster_composite_ops"],
+			"index_permissions": [{"index_patterns": ["logs-*", "metrics"], "allowed_actions": ["crud", "create_index", "indices_monitor"]}]
+		}`))
+		internalUser, _ := standIn.get("internalusers", "binding_user")
+		Expect(internalUser).To(MatchJSON(`{"password": "a-password"}`))
+		mapping, _ := standIn.get("rolesmapping", "binding_user")
+		Expect(mapping).To(MatchJSON(`{"users": ["binding_user"]}`))
+	})
+
+	It("only grants read permissions to a read-only user", func() {
+		user.ReadOnly = true
+
+		Expect(manager.PutUser(context.TODO(), user)).To(Succeed())
+
+		role, _ := standIn.get("roles", "binding_user")
+		Expect(role).To(MatchJSON(`{
+			"cluster_permissions": ["cluster_composite_ops_ro"],
+			"index_permissions": [{"index_patterns": ["logs-*", "metrics"], "allowed_actions": ["read", "indices_monitor"]}]
+		}`))
+	})
+
+	It("replaces the password and the role when updating the user", func() {
+		Expect(manager.PutUser(context.TODO(), user)).To(Succeed())
+		user.Password = "another-password"
+		user.IndexPatterns = []string{"traces-*"}
+
+		Expect(manager.PutUser(context.TODO(), user)).To(Succeed())
+
+		internalUser, _ := standIn.get("internalusers", "binding_user")
+		Expect(internalUser).To(MatchJSON(`{"password": "another-password"}`))
+		role, _ := standIn.get("roles", "binding_user")
+		Expect(string(role)).To(ContainSubstring(`"index_patterns":["traces-*"]`))
+	})
+
+	It("does not create the user when the role cannot be created", func() {
+		standIn.fail("roles", http.StatusBadRequest)
+
+		err := manager.PutUser(context.TODO(), user)
+		Expect(err).To(MatchError(`error putting user "binding_user": PUT roles: 400 Bad Request: roles failed`))
+		_, exists := standIn.get("internalusers", "binding_user")
+		Expect(exists).To(BeFalse())
+	})
+
+	It("reports failures to authenticate", func() {
+		manager = csbopensearch.NewSecurityAPIUserManager(server.Client(), server.URL, "admin", "wrong-password")
+
+		err := manager.PutUser(context.TODO(), user)
+		Expect(err).To(MatchError(`error putting user "binding_user": PUT roles: 401 Unauthorized: Unauthorized`))
+	})
+
+	It("deletes the role mapping, the user and the role", func() {
+		Expect(manager.PutUser(context.TODO(), user)).To(Succeed())
+
+		Expect(manager.DeleteUser(context.TODO(), "binding_user")).To(Succeed())
+
+		Expect(standIn.receivedRequests()[3:]).To(HaveExactElements(
+			securityAPIRequest{Method: http.MethodDelete, Resource: "rolesmapping", Name: "binding_user"},
+			securityAPIRequest{Method: http.MethodDelete, Resource: "internalusers", Name: "binding_user"},
+			securityAPIRequest{Method: http.MethodDelete, Resource: "roles", Name: "binding_user"},
+		))
+		for _, resource := range []string{"rolesmapping", "internalusers", "roles"} {
+			_, exists := standIn.get(resource, "binding_user")
+			Expect(exists).To(BeFalse())
+		}
+	})
+
+	It("succeeds when deleting a user that does not exist", func() {
+		Expect(manager.DeleteUser(context.TODO(), "binding_user")).To(Succeed())
+	})
+
+	It("reports failures to delete the user", func() {
+		standIn.fail("internalusers", http.StatusForbidden)
+
+		err := manager.DeleteUser(context.TODO(), "binding_user")
+		Expect(err).To(MatchError(`error deleting user "binding_user": DELETE internalusers: 403 Forbidden: internalusers failed`))
+	})
+
+	It("reports whether the user exists", func() {
+		Expect(manager.UserExists(context.TODO(), "binding_user")).To(BeFalse())
+
+		Expect(manager.PutUser(context.TODO(), user)).To(Succeed())
+		Expect(manager.UserExists(context.TODO(), "binding_user")).To(BeTrue())
+	})
+
+	It("reports failures to get the user", func() {
+		standIn.fail("internalusers", http.StatusInternalServerError)
+
+		_, err := manager.UserExists(context.TODO(), "binding_user")
+		Expect(err).To(MatchError(`error getting user "binding_user": GET internalusers: 500 Internal Server Error: internalusers failed`))
+	})
+})
@@ -0,0 +1,64 @@
+package csbopensearch
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"sync"
+	"time"
+)
+
+//go:generate go tool counterfeiter -generate
+
+//counterfeiter:generate -header csbopensearchfakes/header.txt . OpenSearchConfig
+type OpenSearchConfig interface {
+	GetUserManager(ctx context.Context) (UserManager, error)
+}
+
+// BindingUser is an internal user created for a binding, along with the role that it is mapped to
+type BindingUser struct {
+	Username      string
+	Password      string
+	IndexPatterns []string
+	ReadOnly      bool
+}
+
+//counterfeiter:generate -header csbopensearchfakes/header.txt . UserManager
+type UserManager interface {
+	PutUser(ctx context.Context, user BindingUser) error
+	DeleteUser(ctx context.Context, username string) error
+	UserExists(ctx context.Context, username string) (bool, error)
+}
+
+type openSearchSettings struct {
+	endpoint      string
+	adminUsername string
+	adminPassword string
+	tlsSkipVerify bool
+
+	lock        sync.Mutex
+	userManager UserManager
+}
+
+// openSearchSettings is the provider meta, which the resources use through OpenSearchConfig
+var _ OpenSearchConfig = &openSearchSettings{}
+
+// GetUserManager creates the HTTP client on first use, and then reuses it so that connections are kept alive
+func (s *openSearchSettings) GetUserManager(context.Context) (UserManager, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.userManager != nil {
+		return s.userManager, nil
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: s.tlsSkipVerify, // #nosec G402 -- set from tls_skip_verify, which is false unless a test configures it
+	}
+
+	client := &http.Client{Transport: transport, Timeout: time.Minute}
+	s.userManager = NewSecurityAPIUserManager(client, s.endpoint, s.adminUsername, s.adminPassword)
+	return s.userManager, nil
+}
@@ -0,0 +1,23 @@
+# Run "make init" to perform "terraform init"
+
+terraform {
+  required_providers {
+    csbopensearch = {
+      source  = "cloudfoundry.org/cloud-service-broker/csbopensearch"
+      version = "1.0.0"
+    }
+  }
+}
+
+provider "csbopensearch" {
+  endpoint       = "https://vpc-csb-opensearch-46d6f6fb-fake.us-west-2.es.amazonaws.com"
+  admin_username = "admin"
+  admin_password = "FAKE-admin-password"
+}
+
+resource "csbopensearch_binding_user" "binding" {
+  username       = "csb_binding_user"
+  password       = "FAKE-password-1"
+  index_patterns = ["logs-*"]
+  read_only      = true
+}
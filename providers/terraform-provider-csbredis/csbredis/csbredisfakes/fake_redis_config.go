@@ -0,0 +1,119 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+//
+//lint:file-ignore ST1000 auto-generated
+package csbredisfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-redis/csbredis"
+)
+
+type FakeRedisConfig struct {
+	GetUserManagerStub        func(context.Context) (csbredis.UserManager, error)
+	getUserManagerMutex       sync.RWMutex
+	getUserManagerArgsForCall []struct {
+		arg1 context.Context
+	}
+	getUserManagerReturns struct {
+		result1 csbredis.UserManager
+		result2 error
+	}
+	getUserManagerReturnsOnCall map[int]struct {
+		result1 csbredis.UserManager
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRedisConfig) GetUserManager(arg1 context.Context) (csbredis.UserManager, error) {
+	fake.getUserManagerMutex.Lock()
+	ret, specificReturn := fake.getUserManagerReturnsOnCall[len(fake.getUserManagerArgsForCall)]
+	fake.getUserManagerArgsForCall = append(fake.getUserManagerArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetUserManagerStub
+	fakeReturns := fake.getUserManagerReturns
+	fake.recordInvocation("GetUserManager", []interface{}{arg1})
+	fake.getUserManagerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRedisConfig) GetUserManagerCallCount() int {
+	fake.getUserManagerMutex.RLock()
+	defer fake.getUserManagerMutex.RUnlock()
+	return len(fake.getUserManagerArgsForCall)
+}
+
+func (fake *FakeRedisConfig) GetUserManagerCalls(stub func(context.Context) (csbredis.UserManager, error)) {
+	fake.getUserManagerMutex.Lock()
+	defer fake.getUserManagerMutex.Unlock()
+	fake.GetUserManagerStub = stub
+}
+
+func (fake *FakeRedisConfig) GetUserManagerArgsForCall(i int) context.Context {
+	fake.getUserManagerMutex.RLock()
+	defer fake.getUserManagerMutex.RUnlock()
+	argsForCall := fake.getUserManagerArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRedisConfig) GetUserManagerReturns(result1 csbredis.UserManager, result2 error) {
+	fake.getUserManagerMutex.Lock()
+	defer fake.getUserManagerMutex.Unlock()
+	fake.GetUserManagerStub = nil
+	fake.getUserManagerReturns = struct {
+		result1 csbredis.UserManager
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRedisConfig) GetUserManagerReturnsOnCall(i int, result1 csbredis.UserManager, result2 error) {
+	fake.getUserManagerMutex.Lock()
+	defer fake.getUserManagerMutex.Unlock()
+	fake.GetUserManagerStub = nil
+	if fake.getUserManagerReturnsOnCall == nil {
+		fake.getUserManagerReturnsOnCall = make(map[int]struct {
+			result1 csbredis.UserManager
+			result2 error
+		})
+	}
+	fake.getUserManagerReturnsOnCall[i] = struct {
+		result1 csbredis.UserManager
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRedisConfig) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getUserManagerMutex.RLock()
+	defer fake.getUserManagerMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRedisConfig) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ csbredis.RedisConfig = new(FakeRedisConfig)
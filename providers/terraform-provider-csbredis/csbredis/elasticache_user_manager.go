@@ -0,0 +1,236 @@
+package csbredis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	userStatusActive      = "active"
+	userGroupStatusActive = "active"
+)
+
+// These are variables rather than constants so that tests can shorten them
+var (
+	retryBaseDelay = time.Second
+	retryMaxDelay  = 30 * time.Second
+	waiterMinDelay = 5 * time.Second
+	waiterMaxDelay = 30 * time.Second
+)
+
+//counterfeiter:generate -header csbredisfakes/header.txt . ElastiCacheClient
+type ElastiCacheClient interface {
+	CreateUser(context.Context, *elasticache.CreateUserInput, ...func(*elasticache.Options)) (*elasticache.CreateUserOutput, error)
+	ModifyUser(context.Context, *elasticache.ModifyUserInput, ...func(*elasticache.Options)) (*elasticache.ModifyUserOutput, error)
+	DeleteUser(context.Context, *elasticache.DeleteUserInput, ...func(*elasticache.Options)) (*elasticache.DeleteUserOutput, error)
+	DescribeUsers(context.Context, *elasticache.DescribeUsersInput, ...func(*elasticache.Options)) (*elasticache.DescribeUsersOutput, error)
+	ModifyUserGroup(context.Context, *elasticache.ModifyUserGroupInput, ...func(*elasticache.Options)) (*elasticache.ModifyUserGroupOutput, error)
+	DescribeUserGroups(context.Context, *elasticache.DescribeUserGroupsInput, ...func(*elasticache.Options)) (*elasticache.DescribeUserGroupsOutput, error)
+}
+
+var _ ElastiCacheClient = &elasticache.Client{}
+
+// NewElastiCacheUserManager manages users with the ElastiCache RBAC API. Users are added to the
+// user group, which must already be associated with the replication group.
+func NewElastiCacheUserManager(client ElastiCacheClient, userGroupID string) UserManager {
+	return &elastiCacheUserManager{client: client, userGroupID: userGroupID}
+}
+
+type elastiCacheUserManager struct {
+	client      ElastiCacheClient
+	userGroupID string
+}
+
+func (m *elastiCacheUserManager) CreateUser(ctx context.Context, user BindingUser) error {
+	if m.userGroupID == "" {
+		return fmt.Errorf("%q must be configured in the provider to create users", userGroupIDKey)
+	}
+
+	_, err := m.client.CreateUser(ctx, &elasticache.CreateUserInput{
+		UserId:       aws.String(user.Username),
+		UserName:     aws.String(user.Username),
+		Engine:       aws.String("redis"),
+		AccessString: aws.String(user.AccessString),
+		Passwords:    []string{user.Password},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating user %q: %w", user.Username, err)
+	}
+	tflog.Info(ctx, "created user", map[string]any{"username": user.Username})
+
+	if err := m.waitForUser(ctx, user.Username); err != nil {
+		return err
+	}
+
+	err = retryOnGroupStateConflict(ctx, fmt.Sprintf("adding user %q to user group %q", user.Username, m.userGroupID), func() error {
+		_, err := m.client.ModifyUserGroup(ctx, &elasticache.ModifyUserGroupInput{
+			UserGroupId:  aws.String(m.userGroupID),
+			UserIdsToAdd: []string{user.Username},
+		})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error adding user %q to user group %q: %w", user.Username, m.userGroupID, err)
+	}
+
+	return m.waitForUserGroup(ctx)
+}
+
+func (m *elastiCacheUserManager) UpdateUser(ctx context.Context, user BindingUser) error {
+	err := retryOnGroupStateConflict(ctx, fmt.Sprintf("modifying user %q", user.Username), func() error {
+		_, err := m.client.ModifyUser(ctx, &elasticache.ModifyUserInput{
+			UserId:       aws.String(user.Username),
+			AccessString: aws.String(user.AccessString),
+			Passwords:    []string{user.Password},
+		})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error modifying user %q: %w", user.Username, err)
+	}
+
+	return m.waitForUser(ctx, user.Username)
+}
+
+func (m *elastiCacheUserManager) DeleteUser(ctx context.Context, username string) error {
+	user, err := m.describeUser(ctx, username)
+	switch {
+	case err != nil:
+		return err
+	case user == nil:
+		return nil
+	}
+
+	if m.userGroupID != "" && slices.Contains(user.UserGroupIds, m.userGroupID) {
+		err := retryOnGroupStateConflict(ctx, fmt.Sprintf("removing user %q from user group %q", username, m.userGroupID), func() error {
+			_, err := m.client.ModifyUserGroup(ctx, &elasticache.ModifyUserGroupInput{
+				UserGroupId:     aws.String(m.userGroupID),
+				UserIdsToRemove: []string{username},
+			})
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("error removing user %q from user group %q: %w", username, m.userGroupID, err)
+		}
+
+		if err := m.waitForUserGroup(ctx); err != nil {
+			return err
+		}
+	}
+
+	err = retryOnGroupStateConflict(ctx, fmt.Sprintf("deleting user %q", username), func() error {
+		_, err := m.client.DeleteUser(ctx, &elasticache.DeleteUserInput{UserId: aws.String(username)})
+		return err
+	})
+	var notFound *types.UserNotFoundFault
+	if err != nil && !errors.As(err, &notFound) {
+		return fmt.Errorf("error deleting user %q: %w", username, err)
+	}
+	tflog.Info(ctx, "deleted user", map[string]any{"username": username})
+	return nil
+}
+
+func (m *elastiCacheUserManager) UserExists(ctx context.Context, username string) (bool, error) {
+	user, err := m.describeUser(ctx, username)
+	return user != nil, err
+}
+
+// describeUser returns nil when the user does not exist
+func (m *elastiCacheUserManager) describeUser(ctx context.Context, username string) (*types.User, error) {
+	output, err := m.client.DescribeUsers(ctx, &elasticache.DescribeUsersInput{UserId: aws.String(username)})
+	var notFound *types.UserNotFoundFault
+	switch {
+	case errors.As(err, &notFound):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("error describing user %q: %w", username, err)
+	case len(output.Users) == 0:
+		return nil, nil
+	default:
+		return &output.Users[0], nil
+	}
+}
+
+func (m *elastiCacheUserManager) waitForUser(ctx context.Context, username string) error {
+	return poll(ctx, fmt.Sprintf("user %q to become active", username), func() (bool, error) {
+		user, err := m.describeUser(ctx, username)
+		switch {
+		case err != nil:
+			return false, err
+		case user == nil:
+			return false, fmt.Errorf("user %q no longer exists", username)
+		default:
+			return aws.ToString(user.Status) == userStatusActive, nil
+		}
+	})
+}
+
+func (m *elastiCacheUserManager) waitForUserGroup(ctx context.Context) error {
+	return poll(ctx, fmt.Sprintf("user group %q to become active", m.userGroupID), func() (bool, error) {
+		output, err := m.client.DescribeUserGroups(ctx, &elasticache.DescribeUserGroupsInput{UserGroupId: aws.String(m.userGroupID)})
+		switch {
+		case err != nil:
+			return false, fmt.Errorf("error describing user group %q: %w", m.userGroupID, err)
+		case len(output.UserGroups) == 0:
+			return false, fmt.Errorf("user group %q does not exist", m.userGroupID)
+		default:
+			return aws.ToString(output.UserGroups[0].Status) == userGroupStatusActive, nil
+		}
+	})
+}
+
+// poll calls the condition until it is met, fails, or the context is done
+func poll(ctx context.Context, description string, condition func() (bool, error)) error {
+	delay := waiterMinDelay
+	for {
+		done, err := condition()
+		switch {
+		case err != nil:
+			return fmt.Errorf("error waiting for %s: %w", description, err)
+		case done:
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for %s", description)
+		case <-time.After(delay):
+		}
+		delay = min(2*delay, waiterMaxDelay)
+	}
+}
+
+// retryOnGroupStateConflict runs the operation until it succeeds, fails with an error other than
+// a user or user group being modified by a concurrent binding, or the context is done
+func retryOnGroupStateConflict(ctx context.Context, description string, operation func() error) error {
+	delay := retryBaseDelay
+	for {
+		err := operation()
+		if err == nil || !isStateConflict(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out %s: %w", description, err)
+		case <-time.After(delay):
+		}
+		delay = min(2*delay, retryMaxDelay)
+	}
+}
+
+func isStateConflict(err error) bool {
+	var (
+		groupState *types.InvalidUserGroupStateFault
+		userState  *types.InvalidUserStateFault
+	)
+	return errors.As(err, &groupState) || errors.As(err, &userState)
+}
@@ -0,0 +1,164 @@
+package csbredis_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-redis/csbredis"
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-redis/csbredis/csbredisfakes"
+)
+
+var _ = Describe("ElastiCache user manager", func() {
+	const userGroupID = "csb-redis-instance"
+
+	var (
+		client  *csbredisfakes.FakeElastiCacheClient
+		manager csbredis.UserManager
+		user    csbredis.BindingUser
+	)
+
+	BeforeEach(func() {
+		DeferCleanup(csbredis.ShortenDelays())
+
+		client = &csbredisfakes.FakeElastiCacheClient{}
+		client.DescribeUsersReturns(describeUsersOutput("active", userGroupID), nil)
+		client.DescribeUserGroupsReturns(&elasticache.DescribeUserGroupsOutput{UserGroups: []types.UserGroup{{Status: aws.String("active")}}}, nil)
+		manager = csbredis.NewElastiCacheUserManager(client, userGroupID)
+		user = csbredis.BindingUser{Username: "binding-user", Password: "a-password-of-sufficient-length", AccessString: "on ~* +@all"}
+	})
+
+	Describe("CreateUser", func() {
+		It("creates the user and adds it to the user group", func() {
+			Expect(manager.CreateUser(context.TODO(), user)).To(Succeed())
+
+			Expect(client.CreateUserCallCount()).To(Equal(1))
+			_, input, _ := client.CreateUserArgsForCall(0)
+			Expect(aws.ToString(input.UserId)).To(Equal("binding-user"))
+			Expect(aws.ToString(input.UserName)).To(Equal("binding-user"))
+			Expect(aws.ToString(input.Engine)).To(Equal("redis"))
+			Expect(aws.ToString(input.AccessString)).To(Equal("on ~* +@all"))
+			Expect(input.Passwords).To(ConsistOf("a-password-of-sufficient-length"))
+
+			Expect(client.ModifyUserGroupCallCount()).To(Equal(1))
+			_, groupInput, _ := client.ModifyUserGroupArgsForCall(0)
+			Expect(aws.ToString(groupInput.UserGroupId)).To(Equal(userGroupID))
+			Expect(groupInput.UserIdsToAdd).To(ConsistOf("binding-user"))
+		})
+
+		It("waits for the user and the user group to become active", func() {
+			client.DescribeUsersReturnsOnCall(0, describeUsersOutput("modifying"), nil)
+			client.DescribeUserGroupsReturnsOnCall(0, &elasticache.DescribeUserGroupsOutput{UserGroups: []types.UserGroup{{Status: aws.String("modifying")}}}, nil)
+
+			Expect(manager.CreateUser(context.TODO(), user)).To(Succeed())
+
+			Expect(client.DescribeUsersCallCount()).To(Equal(2))
+			Expect(client.DescribeUserGroupsCallCount()).To(Equal(2))
+		})
+
+		It("retries adding the user while the user group is being modified by another binding", func() {
+			client.ModifyUserGroupReturnsOnCall(0, nil, &types.InvalidUserGroupStateFault{Message: aws.String("modifying")})
+
+			Expect(manager.CreateUser(context.TODO(), user)).To(Succeed())
+
+			Expect(client.ModifyUserGroupCallCount()).To(Equal(2))
+		})
+
+		It("fails when the user group is not configured", func() {
+			manager = csbredis.NewElastiCacheUserManager(client, "")
+
+			Expect(manager.CreateUser(context.TODO(), user)).To(MatchError(`"user_group_id" must be configured in the provider to create users`))
+			Expect(client.CreateUserCallCount()).To(BeZero())
+		})
+
+		It("reports creation failures", func() {
+			client.CreateUserReturns(nil, fmt.Errorf("quota exceeded"))
+
+			Expect(manager.CreateUser(context.TODO(), user)).To(MatchError(`error creating user "binding-user": quota exceeded`))
+			Expect(client.ModifyUserGroupCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("UpdateUser", func() {
+		It("modifies the access string and password", func() {
+			Expect(manager.UpdateUser(context.TODO(), user)).To(Succeed())
+
+			Expect(client.ModifyUserCallCount()).To(Equal(1))
+			_, input, _ := client.ModifyUserArgsForCall(0)
+			Expect(aws.ToString(input.UserId)).To(Equal("binding-user"))
+			Expect(aws.ToString(input.AccessString)).To(Equal("on ~* +@all"))
+			Expect(input.Passwords).To(ConsistOf("a-password-of-sufficient-length"))
+		})
+	})
+
+	Describe("DeleteUser", func() {
+		It("removes the user from the user group before deleting it", func() {
+			Expect(manager.DeleteUser(context.TODO(), "binding-user")).To(Succeed())
+
+			Expect(client.ModifyUserGroupCallCount()).To(Equal(1))
+			_, groupInput, _ := client.ModifyUserGroupArgsForCall(0)
+			Expect(groupInput.UserIdsToRemove).To(ConsistOf("binding-user"))
+
+			Expect(client.DeleteUserCallCount()).To(Equal(1))
+			_, input, _ := client.DeleteUserArgsForCall(0)
+			Expect(aws.ToString(input.UserId)).To(Equal("binding-user"))
+		})
+
+		It("does not modify the user group when the user is not a member", func() {
+			client.DescribeUsersReturns(describeUsersOutput("active"), nil)
+
+			Expect(manager.DeleteUser(context.TODO(), "binding-user")).To(Succeed())
+
+			Expect(client.ModifyUserGroupCallCount()).To(BeZero())
+			Expect(client.DeleteUserCallCount()).To(Equal(1))
+		})
+
+		It("succeeds when the user does not exist", func() {
+			client.DescribeUsersReturns(nil, &types.UserNotFoundFault{Message: aws.String("not found")})
+
+			Expect(manager.DeleteUser(context.TODO(), "binding-user")).To(Succeed())
+
+			Expect(client.DeleteUserCallCount()).To(BeZero())
+		})
+
+		It("retries the deletion while the user is still being removed from the group", func() {
+			client.DeleteUserReturnsOnCall(0, nil, &types.InvalidUserStateFault{Message: aws.String("modifying")})
+
+			Expect(manager.DeleteUser(context.TODO(), "binding-user")).To(Succeed())
+
+			Expect(client.DeleteUserCallCount()).To(Equal(2))
+		})
+	})
+
+	Describe("UserExists", func() {
+		It("is true when the user is described", func() {
+			Expect(manager.UserExists(context.TODO(), "binding-user")).To(BeTrue())
+		})
+
+		It("is false when the user is not found", func() {
+			client.DescribeUsersReturns(nil, &types.UserNotFoundFault{Message: aws.String("not found")})
+
+			Expect(manager.UserExists(context.TODO(), "binding-user")).To(BeFalse())
+		})
+
+		It("reports other failures", func() {
+			client.DescribeUsersReturns(nil, fmt.Errorf("throttled"))
+
+			_, err := manager.UserExists(context.TODO(), "binding-user")
+			Expect(err).To(MatchError(`error describing user "binding-user": throttled`))
+		})
+	})
+})
+
+func describeUsersOutput(status string, userGroupIDs ...string) *elasticache.DescribeUsersOutput {
+	return &elasticache.DescribeUsersOutput{Users: []types.User{{
+		UserId:       aws.String("binding-user"),
+		Status:       aws.String(status),
+		UserGroupIds: userGroupIDs,
+	}}}
+}
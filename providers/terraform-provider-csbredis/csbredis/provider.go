@@ -0,0 +1,74 @@
+// Package csbredis is a Terraform provider specialised for the Redis service of the AWS brokerpak
+package csbredis
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	awsRegionKey         = "region"
+	accessKeyIDKey       = "access_key_id"
+	secretAccessKeyKey   = "secret_access_key"
+	customEndpointURLKey = "custom_endpoint_url"
+	userGroupIDKey       = "user_group_id"
+)
+
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			awsRegionKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Region of the ElastiCache user group",
+			},
+			accessKeyIDKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{secretAccessKeyKey},
+				Description:  "When not specified, the default AWS credentials chain is used",
+			},
+			secretAccessKeyKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{accessKeyIDKey},
+			},
+			customEndpointURLKey: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			userGroupIDKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ElastiCache user group that the binding users are added to",
+			},
+		},
+		ConfigureContextFunc: providerConfigure,
+		ResourcesMap: map[string]*schema.Resource{
+			"csbredis_binding_user": ResourceBindingUser(),
+		},
+	}
+}
+
+func providerConfigure(_ context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+	var customEndpointURL string
+	if customURL, ok := d.GetOk(customEndpointURLKey); ok {
+		uri, err := url.ParseRequestURI(customURL.(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		customEndpointURL = uri.String()
+	}
+
+	return &redisSettings{
+		region:            d.Get(awsRegionKey).(string),
+		accessKeyID:       d.Get(accessKeyIDKey).(string),
+		secretAccessKey:   d.Get(secretAccessKeyKey).(string),
+		customEndpointURL: customEndpointURL,
+		userGroupID:       d.Get(userGroupIDKey).(string),
+	}, nil
+}
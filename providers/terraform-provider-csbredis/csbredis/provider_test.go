@@ -0,0 +1,34 @@
+package csbredis_test
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-redis/csbredis"
+)
+
+var _ = Describe("Provider", func() {
+	configure := func(config map[string]any) diag.Diagnostics {
+		return csbredis.Provider().Configure(context.TODO(), terraform.NewResourceConfigRaw(config))
+	}
+
+	It("validates the provider schema", func() {
+		Expect(csbredis.Provider().InternalValidate()).To(Succeed())
+	})
+
+	It("configures the ElastiCache user group", func() {
+		Expect(configure(map[string]any{"region": "us-west-2", "user_group_id": "csb-redis"})).To(BeEmpty())
+	})
+
+	It("only requires the region once a user is managed", func() {
+		provider := csbredis.Provider()
+		Expect(provider.Configure(context.TODO(), terraform.NewResourceConfigRaw(map[string]any{}))).To(BeEmpty())
+
+		_, err := provider.Meta().(csbredis.RedisConfig).GetUserManager(context.TODO())
+		Expect(err).To(MatchError(`"region" must be specified`))
+	})
+})
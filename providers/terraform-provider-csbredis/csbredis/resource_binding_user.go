@@ -0,0 +1,134 @@
+package csbredis
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	UsernameKey     = "username"
+	PasswordKey     = "password"
+	AccessStringKey = "access_string"
+
+	defaultAccessString = "on ~* +@all"
+	defaultTimeout      = 15 * time.Minute
+)
+
+func ResourceBindingUser() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			UsernameKey: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 40),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]*$`), "must start with a letter and contain only letters, digits and hyphens"),
+				),
+				Description: "Also used as the ElastiCache user ID",
+			},
+			PasswordKey: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(16, 128),
+			},
+			AccessStringKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultAccessString,
+				Description: "Redis ACL rules granted to the user",
+			},
+		},
+		CreateContext: resourceBindingUserCreate,
+		ReadContext:   resourceBindingUserRead,
+		UpdateContext: resourceBindingUserUpdate,
+		DeleteContext: resourceBindingUserDelete,
+		Description:   "A Redis user for a single binding",
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+func resourceBindingUserCreate(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	manager, err := config.(RedisConfig).GetUserManager(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, data.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	user := bindingUser(data)
+	if err := manager.CreateUser(ctx, user); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(user.Username)
+	return nil
+}
+
+// resourceBindingUserRead removes the user from the state when it no longer exists, so that
+// it is created again
+func resourceBindingUserRead(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	manager, err := config.(RedisConfig).GetUserManager(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	exists, err := manager.UserExists(ctx, data.Id())
+	switch {
+	case err != nil:
+		return diag.FromErr(err)
+	case !exists:
+		data.SetId("")
+	}
+
+	return nil
+}
+
+func resourceBindingUserUpdate(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	manager, err := config.(RedisConfig).GetUserManager(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, data.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	if err := manager.UpdateUser(ctx, bindingUser(data)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceBindingUserDelete(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	manager, err := config.(RedisConfig).GetUserManager(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, data.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	if err := manager.DeleteUser(ctx, data.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func bindingUser(data *schema.ResourceData) BindingUser {
+	return BindingUser{
+		Username:     data.Get(UsernameKey).(string),
+		Password:     data.Get(PasswordKey).(string),
+		AccessString: data.Get(AccessStringKey).(string),
+	}
+}
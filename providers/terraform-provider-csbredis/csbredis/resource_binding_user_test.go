@@ -0,0 +1,117 @@
+package csbredis_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-redis/csbredis"
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-redis/csbredis/csbredisfakes"
+)
+
+var _ = Describe("ResourceBindingUser", func() {
+	var (
+		resource *schema.Resource
+		manager  *csbredisfakes.FakeUserManager
+		config   *csbredisfakes.FakeRedisConfig
+		data     *schema.ResourceData
+	)
+
+	BeforeEach(func() {
+		resource = csbredis.ResourceBindingUser()
+		manager = &csbredisfakes.FakeUserManager{}
+		config = &csbredisfakes.FakeRedisConfig{}
+		config.GetUserManagerReturns(manager, nil)
+
+		data = schema.TestResourceDataRaw(GinkgoT(), resource.Schema, map[string]any{
+			csbredis.UsernameKey: "binding-user",
+			csbredis.PasswordKey: "a-password-of-sufficient-length",
+		})
+	})
+
+	It("creates the user with the default access string", func() {
+		Expect(resource.CreateContext(context.TODO(), data, config)).To(BeNil())
+
+		Expect(manager.CreateUserCallCount()).To(Equal(1))
+		_, user := manager.CreateUserArgsForCall(0)
+		Expect(user).To(Equal(csbredis.BindingUser{
+			Username:     "binding-user",
+			Password:     "a-password-of-sufficient-length",
+			AccessString: "on ~* +@all",
+		}))
+		Expect(data.Id()).To(Equal("binding-user"))
+	})
+
+	It("reports creation failures", func() {
+		manager.CreateUserReturns(fmt.Errorf("boom"))
+
+		d := resource.CreateContext(context.TODO(), data, config)
+		Expect(d).To(HaveLen(1))
+		Expect(d[0].Summary).To(Equal("boom"))
+		Expect(data.Id()).To(BeEmpty())
+	})
+
+	It("reports failures to get the user manager", func() {
+		config.GetUserManagerReturns(nil, fmt.Errorf("no credentials"))
+
+		d := resource.CreateContext(context.TODO(), data, config)
+		Expect(d).To(HaveLen(1))
+		Expect(d[0].Summary).To(Equal("no credentials"))
+		Expect(manager.CreateUserCallCount()).To(BeZero())
+	})
+
+	It("updates the user", func() {
+		data.SetId("binding-user")
+		Expect(data.Set(csbredis.AccessStringKey, "on ~app:* +@read")).To(Succeed())
+
+		Expect(resource.UpdateContext(context.TODO(), data, config)).To(BeNil())
+
+		Expect(manager.UpdateUserCallCount()).To(Equal(1))
+		_, user := manager.UpdateUserArgsForCall(0)
+		Expect(user.AccessString).To(Equal("on ~app:* +@read"))
+	})
+
+	It("deletes the user", func() {
+		data.SetId("binding-user")
+
+		Expect(resource.DeleteContext(context.TODO(), data, config)).To(BeNil())
+
+		Expect(manager.DeleteUserCallCount()).To(Equal(1))
+		_, username := manager.DeleteUserArgsForCall(0)
+		Expect(username).To(Equal("binding-user"))
+	})
+
+	Describe("read", func() {
+		BeforeEach(func() {
+			data.SetId("binding-user")
+		})
+
+		It("keeps the user when it exists", func() {
+			manager.UserExistsReturns(true, nil)
+
+			Expect(resource.ReadContext(context.TODO(), data, config)).To(BeNil())
+			Expect(data.Id()).To(Equal("binding-user"))
+		})
+
+		It("removes the user from the state when it no longer exists", func() {
+			manager.UserExistsReturns(false, nil)
+
+			Expect(resource.ReadContext(context.TODO(), data, config)).To(BeNil())
+			Expect(data.Id()).To(BeEmpty())
+		})
+	})
+
+	DescribeTable("username validation",
+		func(username string, valid bool) {
+			_, errs := resource.Schema[csbredis.UsernameKey].ValidateFunc(username, csbredis.UsernameKey)
+			Expect(errs == nil).To(Equal(valid))
+		},
+		Entry("letters, digits and hyphens", "user-1", true),
+		Entry("leading digit", "1user", false),
+		Entry("underscore", "user_1", false),
+		Entry("too long", "u123456789012345678901234567890123456789012", false),
+	)
+})
@@ -0,0 +1,89 @@
+package csbredis
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+)
+
+//go:generate go tool counterfeiter -generate
+
+//counterfeiter:generate -header csbredisfakes/header.txt . RedisConfig
+type RedisConfig interface {
+	GetUserManager(ctx context.Context) (UserManager, error)
+}
+
+// BindingUser is a user created for a binding
+type BindingUser struct {
+	Username     string
+	Password     string
+	AccessString string
+}
+
+//counterfeiter:generate -header csbredisfakes/header.txt . UserManager
+type UserManager interface {
+	CreateUser(ctx context.Context, user BindingUser) error
+	UpdateUser(ctx context.Context, user BindingUser) error
+	DeleteUser(ctx context.Context, username string) error
+	UserExists(ctx context.Context, username string) (bool, error)
+}
+
+type redisSettings struct {
+	region            string
+	accessKeyID       string
+	secretAccessKey   string
+	customEndpointURL string
+	userGroupID       string
+
+	lock        sync.Mutex
+	userManager UserManager
+}
+
+// Fail fast if the interface is not implemented
+var _ RedisConfig = &redisSettings{}
+
+// GetUserManager creates the user manager on first use, and then reuses it. The region is only checked
+// here, so that bindings of instances without RBAC can configure the provider without one.
+func (r *redisSettings) GetUserManager(ctx context.Context) (UserManager, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.userManager != nil {
+		return r.userManager, nil
+	}
+
+	if r.region == "" {
+		return nil, fmt.Errorf("%q must be specified", awsRegionKey)
+	}
+	client, err := r.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r.userManager = NewElastiCacheUserManager(client, r.userGroupID)
+
+	return r.userManager, nil
+}
+
+func (r *redisSettings) client(ctx context.Context) (*elasticache.Client, error) {
+	opts := []func(*config.LoadOptions) error{config.WithRegion(r.region)}
+	if r.accessKeyID != "" {
+		opts = append(opts, config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(r.accessKeyID, r.secretAccessKey, "")))
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return elasticache.NewFromConfig(cfg, func(o *elasticache.Options) {
+		// For testing we use a custom endpoint
+		if r.customEndpointURL != "" {
+			o.BaseEndpoint = aws.String(r.customEndpointURL)
+		}
+	}), nil
+}
@@ -0,0 +1,20 @@
+# Run "make init" to perform "terraform init"
+
+terraform {
+  required_providers {
+    csbredis = {
+      source  = "cloudfoundry.org/cloud-service-broker/csbredis"
+      version = "1.0.0"
+    }
+  }
+}
+
+provider "csbredis" {
+  region        = "us-west-2"
+  user_group_id = "csb-redis-46d6f6fb-c746-4488-8ed9-bc05bff03eb8"
+}
+
+resource "csbredis_binding_user" "binding" {
+  username = "csb-binding-user"
+  password = "FAKE-password-of-sufficient-length"
+}
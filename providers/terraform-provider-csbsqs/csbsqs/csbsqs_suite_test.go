@@ -0,0 +1,13 @@
+package csbsqs_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCsbsqs(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CSB SQS Suite")
+}
@@ -0,0 +1,207 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+//
+//lint:file-ignore ST1000 auto-generated
+package csbsqsfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-sqs/csbsqs"
+)
+
+type FakeQueueAttributesClient struct {
+	GetQueueAttributesStub        func(context.Context, *sqs.GetQueueAttributesInput, ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error)
+	getQueueAttributesMutex       sync.RWMutex
+	getQueueAttributesArgsForCall []struct {
+		arg1 context.Context
+		arg2 *sqs.GetQueueAttributesInput
+		arg3 []func(*sqs.Options)
+	}
+	getQueueAttributesReturns struct {
+		result1 *sqs.GetQueueAttributesOutput
+		result2 error
+	}
+	getQueueAttributesReturnsOnCall map[int]struct {
+		result1 *sqs.GetQueueAttributesOutput
+		result2 error
+	}
+	SetQueueAttributesStub        func(context.Context, *sqs.SetQueueAttributesInput, ...func(*sqs.Options)) (*sqs.SetQueueAttributesOutput, error)
+	setQueueAttributesMutex       sync.RWMutex
+	setQueueAttributesArgsForCall []struct {
+		arg1 context.Context
+		arg2 *sqs.SetQueueAttributesInput
+		arg3 []func(*sqs.Options)
+	}
+	setQueueAttributesReturns struct {
+		result1 *sqs.SetQueueAttributesOutput
+		result2 error
+	}
+	setQueueAttributesReturnsOnCall map[int]struct {
+		result1 *sqs.SetQueueAttributesOutput
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeQueueAttributesClient) GetQueueAttributes(arg1 context.Context, arg2 *sqs.GetQueueAttributesInput, arg3 ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error) {
+	fake.getQueueAttributesMutex.Lock()
+	ret, specificReturn := fake.getQueueAttributesReturnsOnCall[len(fake.getQueueAttributesArgsForCall)]
+	fake.getQueueAttributesArgsForCall = append(fake.getQueueAttributesArgsForCall, struct {
+		arg1 context.Context
+		arg2 *sqs.GetQueueAttributesInput
+		arg3 []func(*sqs.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.GetQueueAttributesStub
+	fakeReturns := fake.getQueueAttributesReturns
+	fake.recordInvocation("GetQueueAttributes", []interface{}{arg1, arg2, arg3})
+	fake.getQueueAttributesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeQueueAttributesClient) GetQueueAttributesCallCount() int {
+	fake.getQueueAttributesMutex.RLock()
+	defer fake.getQueueAttributesMutex.RUnlock()
+	return len(fake.getQueueAttributesArgsForCall)
+}
+
+func (fake *FakeQueueAttributesClient) GetQueueAttributesCalls(stub func(context.Context, *sqs.GetQueueAttributesInput, ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error)) {
+	fake.getQueueAttributesMutex.Lock()
+	defer fake.getQueueAttributesMutex.Unlock()
+	fake.GetQueueAttributesStub = stub
+}
+
+func (fake *FakeQueueAttributesClient) GetQueueAttributesArgsForCall(i int) (context.Context, *sqs.GetQueueAttributesInput, []func(*sqs.Options)) {
+	fake.getQueueAttributesMutex.RLock()
+	defer fake.getQueueAttributesMutex.RUnlock()
+	argsForCall := fake.getQueueAttributesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeQueueAttributesClient) GetQueueAttributesReturns(result1 *sqs.GetQueueAttributesOutput, result2 error) {
+	fake.getQueueAttributesMutex.Lock()
+	defer fake.getQueueAttributesMutex.Unlock()
+	fake.GetQueueAttributesStub = nil
+	fake.getQueueAttributesReturns = struct {
+		result1 *sqs.GetQueueAttributesOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQueueAttributesClient) GetQueueAttributesReturnsOnCall(i int, result1 *sqs.GetQueueAttributesOutput, result2 error) {
+	fake.getQueueAttributesMutex.Lock()
+	defer fake.getQueueAttributesMutex.Unlock()
+	fake.GetQueueAttributesStub = nil
+	if fake.getQueueAttributesReturnsOnCall == nil {
+		fake.getQueueAttributesReturnsOnCall = make(map[int]struct {
+			result1 *sqs.GetQueueAttributesOutput
+			result2 error
+		})
+	}
+	fake.getQueueAttributesReturnsOnCall[i] = struct {
+		result1 *sqs.GetQueueAttributesOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQueueAttributesClient) SetQueueAttributes(arg1 context.Context, arg2 *sqs.SetQueueAttributesInput, arg3 ...func(*sqs.Options)) (*sqs.SetQueueAttributesOutput, error) {
+	fake.setQueueAttributesMutex.Lock()
+	ret, specificReturn := fake.setQueueAttributesReturnsOnCall[len(fake.setQueueAttributesArgsForCall)]
+	fake.setQueueAttributesArgsForCall = append(fake.setQueueAttributesArgsForCall, struct {
+		arg1 context.Context
+		arg2 *sqs.SetQueueAttributesInput
+		arg3 []func(*sqs.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.SetQueueAttributesStub
+	fakeReturns := fake.setQueueAttributesReturns
+	fake.recordInvocation("SetQueueAttributes", []interface{}{arg1, arg2, arg3})
+	fake.setQueueAttributesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeQueueAttributesClient) SetQueueAttributesCallCount() int {
+	fake.setQueueAttributesMutex.RLock()
+	defer fake.setQueueAttributesMutex.RUnlock()
+	return len(fake.setQueueAttributesArgsForCall)
+}
+
+func (fake *FakeQueueAttributesClient) SetQueueAttributesCalls(stub func(context.Context, *sqs.SetQueueAttributesInput, ...func(*sqs.Options)) (*sqs.SetQueueAttributesOutput, error)) {
+	fake.setQueueAttributesMutex.Lock()
+	defer fake.setQueueAttributesMutex.Unlock()
+	fake.SetQueueAttributesStub = stub
+}
+
+func (fake *FakeQueueAttributesClient) SetQueueAttributesArgsForCall(i int) (context.Context, *sqs.SetQueueAttributesInput, []func(*sqs.Options)) {
+	fake.setQueueAttributesMutex.RLock()
+	defer fake.setQueueAttributesMutex.RUnlock()
+	argsForCall := fake.setQueueAttributesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeQueueAttributesClient) SetQueueAttributesReturns(result1 *sqs.SetQueueAttributesOutput, result2 error) {
+	fake.setQueueAttributesMutex.Lock()
+	defer fake.setQueueAttributesMutex.Unlock()
+	fake.SetQueueAttributesStub = nil
+	fake.setQueueAttributesReturns = struct {
+		result1 *sqs.SetQueueAttributesOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQueueAttributesClient) SetQueueAttributesReturnsOnCall(i int, result1 *sqs.SetQueueAttributesOutput, result2 error) {
+	fake.setQueueAttributesMutex.Lock()
+	defer fake.setQueueAttributesMutex.Unlock()
+	fake.SetQueueAttributesStub = nil
+	if fake.setQueueAttributesReturnsOnCall == nil {
+		fake.setQueueAttributesReturnsOnCall = make(map[int]struct {
+			result1 *sqs.SetQueueAttributesOutput
+			result2 error
+		})
+	}
+	fake.setQueueAttributesReturnsOnCall[i] = struct {
+		result1 *sqs.SetQueueAttributesOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQueueAttributesClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getQueueAttributesMutex.RLock()
+	defer fake.getQueueAttributesMutex.RUnlock()
+	fake.setQueueAttributesMutex.RLock()
+	defer fake.setQueueAttributesMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeQueueAttributesClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ csbsqs.QueueAttributesClient = new(FakeQueueAttributesClient)
@@ -0,0 +1,119 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+//
+//lint:file-ignore ST1000 auto-generated
+package csbsqsfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-sqs/csbsqs"
+)
+
+type FakeSQSConfig struct {
+	GetQueuePoliciesStub        func(context.Context) (*csbsqs.QueuePolicies, error)
+	getQueuePoliciesMutex       sync.RWMutex
+	getQueuePoliciesArgsForCall []struct {
+		arg1 context.Context
+	}
+	getQueuePoliciesReturns struct {
+		result1 *csbsqs.QueuePolicies
+		result2 error
+	}
+	getQueuePoliciesReturnsOnCall map[int]struct {
+		result1 *csbsqs.QueuePolicies
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSQSConfig) GetQueuePolicies(arg1 context.Context) (*csbsqs.QueuePolicies, error) {
+	fake.getQueuePoliciesMutex.Lock()
+	ret, specificReturn := fake.getQueuePoliciesReturnsOnCall[len(fake.getQueuePoliciesArgsForCall)]
+	fake.getQueuePoliciesArgsForCall = append(fake.getQueuePoliciesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetQueuePoliciesStub
+	fakeReturns := fake.getQueuePoliciesReturns
+	fake.recordInvocation("GetQueuePolicies", []interface{}{arg1})
+	fake.getQueuePoliciesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSQSConfig) GetQueuePoliciesCallCount() int {
+	fake.getQueuePoliciesMutex.RLock()
+	defer fake.getQueuePoliciesMutex.RUnlock()
+	return len(fake.getQueuePoliciesArgsForCall)
+}
+
+func (fake *FakeSQSConfig) GetQueuePoliciesCalls(stub func(context.Context) (*csbsqs.QueuePolicies, error)) {
+	fake.getQueuePoliciesMutex.Lock()
+	defer fake.getQueuePoliciesMutex.Unlock()
+	fake.GetQueuePoliciesStub = stub
+}
+
+func (fake *FakeSQSConfig) GetQueuePoliciesArgsForCall(i int) context.Context {
+	fake.getQueuePoliciesMutex.RLock()
+	defer fake.getQueuePoliciesMutex.RUnlock()
+	argsForCall := fake.getQueuePoliciesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSQSConfig) GetQueuePoliciesReturns(result1 *csbsqs.QueuePolicies, result2 error) {
+	fake.getQueuePoliciesMutex.Lock()
+	defer fake.getQueuePoliciesMutex.Unlock()
+	fake.GetQueuePoliciesStub = nil
+	fake.getQueuePoliciesReturns = struct {
+		result1 *csbsqs.QueuePolicies
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSQSConfig) GetQueuePoliciesReturnsOnCall(i int, result1 *csbsqs.QueuePolicies, result2 error) {
+	fake.getQueuePoliciesMutex.Lock()
+	defer fake.getQueuePoliciesMutex.Unlock()
+	fake.GetQueuePoliciesStub = nil
+	if fake.getQueuePoliciesReturnsOnCall == nil {
+		fake.getQueuePoliciesReturnsOnCall = make(map[int]struct {
+			result1 *csbsqs.QueuePolicies
+			result2 error
+		})
+	}
+	fake.getQueuePoliciesReturnsOnCall[i] = struct {
+		result1 *csbsqs.QueuePolicies
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSQSConfig) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getQueuePoliciesMutex.RLock()
+	defer fake.getQueuePoliciesMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSQSConfig) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ csbsqs.SQSConfig = new(FakeSQSConfig)
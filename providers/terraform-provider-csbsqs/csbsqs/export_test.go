@@ -0,0 +1,13 @@
+package csbsqs
+
+import "time"
+
+// ShortenDelays makes reading back written policies fast enough for unit tests
+func ShortenDelays() (restore func()) {
+	delay := verifyDelay
+	verifyDelay = time.Millisecond
+
+	return func() {
+		verifyDelay = delay
+	}
+}
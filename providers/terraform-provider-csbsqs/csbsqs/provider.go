@@ -0,0 +1,67 @@
+// Package csbsqs is a Terraform provider specialised for the SQS queues that the AWS brokerpak subscribes to SNS topics
+package csbsqs
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	awsRegionKey         = "region"
+	accessKeyIDKey       = "access_key_id"
+	secretAccessKeyKey   = "secret_access_key"
+	customEndpointURLKey = "custom_endpoint_url"
+)
+
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			awsRegionKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Region of the queues",
+			},
+			accessKeyIDKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{secretAccessKeyKey},
+				Description:  "When not specified, the default AWS credentials chain is used",
+			},
+			secretAccessKeyKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{accessKeyIDKey},
+			},
+			customEndpointURLKey: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		ConfigureContextFunc: providerConfigure,
+		ResourcesMap: map[string]*schema.Resource{
+			"csbsqs_queue_policy_statement": ResourceQueuePolicyStatement(),
+		},
+	}
+}
+
+func providerConfigure(_ context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+	var customEndpointURL string
+	if customURL, ok := d.GetOk(customEndpointURLKey); ok {
+		uri, err := url.ParseRequestURI(customURL.(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		customEndpointURL = uri.String()
+	}
+
+	return &sqsSettings{
+		region:            d.Get(awsRegionKey).(string),
+		accessKeyID:       d.Get(accessKeyIDKey).(string),
+		secretAccessKey:   d.Get(secretAccessKeyKey).(string),
+		customEndpointURL: customEndpointURL,
+	}, nil
+}
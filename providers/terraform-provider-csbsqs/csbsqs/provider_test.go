@@ -0,0 +1,32 @@
+package csbsqs_test
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-sqs/csbsqs"
+)
+
+var _ = Describe("Provider", func() {
+	configure := func(config map[string]any) diag.Diagnostics {
+		return csbsqs.Provider().Configure(context.TODO(), terraform.NewResourceConfigRaw(config))
+	}
+
+	It("validates the provider schema", func() {
+		Expect(csbsqs.Provider().InternalValidate()).To(Succeed())
+	})
+
+	It("only needs the region", func() {
+		Expect(configure(map[string]any{"region": "us-west-2"})).To(BeEmpty())
+	})
+
+	It("rejects an invalid custom endpoint", func() {
+		d := configure(map[string]any{"region": "us-west-2", "custom_endpoint_url": "not a URL"})
+		Expect(d).To(HaveLen(1))
+		Expect(d[0].Summary).To(ContainSubstring("invalid URI"))
+	})
+})
@@ -0,0 +1,234 @@
+package csbsqs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+)
+
+const (
+	policyVersion = "2012-10-17"
+
+	maxEditAttempts = 10
+)
+
+// verifyDelay is the minimum wait before a written policy is read back, as changes to queue attributes
+// take a moment to propagate. A random amount up to the same again is added, so that bindings that
+// conflicted do not retry in lockstep.
+var verifyDelay = 2 * time.Second
+
+// QueuePolicies edits single statements of the access policy of a queue. SQS only allows the whole
+// policy to be replaced, so each edit reads the policy and writes it back with only the one statement
+// changed, keeping the statements that the queue owner or other bindings added.
+//
+// SQS has no conditional writes, so two bindings that edit the same policy at the same time can each
+// write back a copy without the statement of the other. Each edit therefore reads the policy back,
+// and starts over when its statement was lost.
+type QueuePolicies struct {
+	client QueueAttributesClient
+}
+
+func NewQueuePolicies(client QueueAttributesClient) *QueuePolicies {
+	return &QueuePolicies{client: client}
+}
+
+// GetStatement returns the statement with the given ID, without the ID itself. It returns false
+// when either the queue or the statement does not exist.
+func (q *QueuePolicies) GetStatement(ctx context.Context, queueURL, sid string) (string, bool, error) {
+	policy, err := q.getPolicy(ctx, queueURL)
+	switch {
+	case isQueueDoesNotExist(err):
+		return "", false, nil
+	case err != nil:
+		return "", false, err
+	}
+
+	i := policy.find(sid)
+	if i < 0 {
+		return "", false, nil
+	}
+
+	statement := make(map[string]any, len(policy.statements[i]))
+	for k, v := range policy.statements[i] {
+		if k != "Sid" {
+			statement[k] = v
+		}
+	}
+
+	result, err := json.Marshal(statement)
+	if err != nil {
+		return "", false, err
+	}
+	return string(result), true, nil
+}
+
+// PutStatement adds the statement to the policy of the queue with the given ID, replacing any
+// statement that already has that ID. A queue without a policy gets a new one.
+func (q *QueuePolicies) PutStatement(ctx context.Context, queueURL, sid, statement string) error {
+	var s map[string]any
+	if err := json.Unmarshal([]byte(statement), &s); err != nil {
+		return fmt.Errorf("invalid policy statement: %w", err)
+	}
+	s["Sid"] = sid
+
+	return q.editPolicy(ctx, queueURL, sid, true, func(policy *policyDocument) bool {
+		if i := policy.find(sid); i >= 0 {
+			policy.statements[i] = s
+		} else {
+			policy.statements = append(policy.statements, s)
+		}
+		return true
+	})
+}
+
+// DeleteStatement removes the statement with the given ID from the policy of the queue, and removes the
+// policy once it has no statements left. Queues and statements that no longer exist are ignored, so that
+// a binding can still be deleted when its queue has been deleted first.
+func (q *QueuePolicies) DeleteStatement(ctx context.Context, queueURL, sid string) error {
+	err := q.editPolicy(ctx, queueURL, sid, false, func(policy *policyDocument) bool {
+		i := policy.find(sid)
+		if i < 0 {
+			return false
+		}
+		policy.statements = append(policy.statements[:i], policy.statements[i+1:]...)
+		return true
+	})
+	if isQueueDoesNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// editPolicy applies the edit to the current policy and writes it back, until reading the policy back
+// shows the statement with the ID as present or absent as the edit intended. The edit returns false
+// when the policy needs no change.
+func (q *QueuePolicies) editPolicy(ctx context.Context, queueURL, sid string, present bool, edit func(*policyDocument) bool) error {
+	for attempt := 1; ; attempt++ {
+		policy, err := q.getPolicy(ctx, queueURL)
+		if err != nil {
+			return err
+		}
+		if !edit(policy) {
+			return nil
+		}
+		if err := q.setPolicy(ctx, queueURL, policy); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(verifyDelay + rand.N(verifyDelay)):
+		}
+
+		written, err := q.getPolicy(ctx, queueURL)
+		switch {
+		case err != nil:
+			return err
+		case (written.find(sid) >= 0) == present:
+			return nil
+		case attempt == maxEditAttempts:
+			return fmt.Errorf("statement %q of the policy of queue %q was overwritten by concurrent changes %d times", sid, queueURL, attempt)
+		}
+	}
+}
+
+func (q *QueuePolicies) getPolicy(ctx context.Context, queueURL string) (*policyDocument, error) {
+	output, err := q.client.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       aws.String(queueURL),
+		AttributeNames: []types.QueueAttributeName{types.QueueAttributeNamePolicy},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := parsePolicy(output.Attributes[string(types.QueueAttributeNamePolicy)])
+	if err != nil {
+		return nil, fmt.Errorf("could not parse the policy of queue %q: %w", queueURL, err)
+	}
+	return policy, nil
+}
+
+// setPolicy writes the policy, or clears it when there are no statements, as SQS rejects
+// policies without statements
+func (q *QueuePolicies) setPolicy(ctx context.Context, queueURL string, policy *policyDocument) error {
+	var document string
+	if len(policy.statements) > 0 {
+		result, err := policy.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		document = string(result)
+	}
+
+	_, err := q.client.SetQueueAttributes(ctx, &sqs.SetQueueAttributesInput{
+		QueueUrl:   aws.String(queueURL),
+		Attributes: map[string]string{string(types.QueueAttributeNamePolicy): document},
+	})
+	return err
+}
+
+func isQueueDoesNotExist(err error) bool {
+	var notFound *types.QueueDoesNotExist
+	return errors.As(err, &notFound)
+}
+
+// policyDocument keeps the top-level elements of a policy other than the statements as they were
+type policyDocument struct {
+	elements   map[string]json.RawMessage
+	statements []map[string]any
+}
+
+func parsePolicy(document string) (*policyDocument, error) {
+	policy := &policyDocument{elements: map[string]json.RawMessage{}}
+	if document == "" {
+		return policy, nil
+	}
+
+	if err := json.Unmarshal([]byte(document), &policy.elements); err != nil {
+		return nil, err
+	}
+
+	// A policy with a single statement may have it as an object rather than a list
+	if raw, ok := policy.elements["Statement"]; ok {
+		if err := json.Unmarshal(raw, &policy.statements); err != nil {
+			var statement map[string]any
+			if err := json.Unmarshal(raw, &statement); err != nil {
+				return nil, err
+			}
+			policy.statements = []map[string]any{statement}
+		}
+		delete(policy.elements, "Statement")
+	}
+
+	return policy, nil
+}
+
+func (p *policyDocument) find(sid string) int {
+	for i, s := range p.statements {
+		if s["Sid"] == sid {
+			return i
+		}
+	}
+	return -1
+}
+
+func (p *policyDocument) MarshalJSON() ([]byte, error) {
+	document := make(map[string]any, len(p.elements)+2)
+	for k, v := range p.elements {
+		document[k] = v
+	}
+	if _, ok := document["Version"]; !ok {
+		document["Version"] = policyVersion
+	}
+	document["Statement"] = p.statements
+
+	return json.Marshal(document)
+}
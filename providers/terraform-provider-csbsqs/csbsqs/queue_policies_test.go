@@ -0,0 +1,216 @@
+package csbsqs_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-sqs/csbsqs"
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-sqs/csbsqs/csbsqsfakes"
+)
+
+var _ = Describe("QueuePolicies", func() {
+	const (
+		queueURL  = "https://sqs.us-west-2.amazonaws.com/123456789012/queue"
+		statement = `{"Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Action":"sqs:SendMessage"}`
+		other     = `{"Sid":"Owner","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"sqs:*"}`
+	)
+
+	var (
+		client   *csbsqsfakes.FakeQueueAttributesClient
+		policies *csbsqs.QueuePolicies
+	)
+
+	hasPolicy := func(policy string) {
+		storePolicy(client, policy)
+	}
+
+	writtenPolicy := func() string {
+		Expect(client.SetQueueAttributesCallCount()).To(Equal(1))
+		_, input, _ := client.SetQueueAttributesArgsForCall(0)
+		Expect(*input.QueueUrl).To(Equal(queueURL))
+		return input.Attributes["Policy"]
+	}
+
+	BeforeEach(func() {
+		client = &csbsqsfakes.FakeQueueAttributesClient{}
+		policies = csbsqs.NewQueuePolicies(client)
+		hasPolicy("")
+
+		DeferCleanup(csbsqs.ShortenDelays())
+	})
+
+	Describe("PutStatement", func() {
+		It("creates a policy when the queue has none", func() {
+			Expect(policies.PutStatement(context.TODO(), queueURL, "TopicA", statement)).To(Succeed())
+
+			Expect(writtenPolicy()).To(MatchJSON(`{
+				"Version": "2012-10-17",
+				"Statement": [{"Sid":"TopicA","Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Action":"sqs:SendMessage"}]
+			}`))
+		})
+
+		It("keeps the other statements and elements of the policy", func() {
+			hasPolicy(`{"Version":"2008-10-17","Id":"queue-policy","Statement":` + other + `}`)
+
+			Expect(policies.PutStatement(context.TODO(), queueURL, "TopicA", statement)).To(Succeed())
+
+			Expect(writtenPolicy()).To(MatchJSON(`{
+				"Version": "2008-10-17",
+				"Id": "queue-policy",
+				"Statement": [` + other + `, {"Sid":"TopicA","Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Action":"sqs:SendMessage"}]
+			}`))
+		})
+
+		It("replaces a statement with the same ID", func() {
+			hasPolicy(`{"Version":"2012-10-17","Statement":[{"Sid":"TopicA","Effect":"Deny"},` + other + `]}`)
+
+			Expect(policies.PutStatement(context.TODO(), queueURL, "TopicA", statement)).To(Succeed())
+
+			Expect(writtenPolicy()).To(MatchJSON(`{
+				"Version": "2012-10-17",
+				"Statement": [{"Sid":"TopicA","Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Action":"sqs:SendMessage"}, ` + other + `]
+			}`))
+		})
+
+		It("adds the statement again when a concurrent change dropped it", func() {
+			stored := storePolicy(client, `{"Version":"2012-10-17","Statement":[`+other+`]}`)
+			client.SetQueueAttributesStub = func(_ context.Context, input *sqs.SetQueueAttributesInput, _ ...func(*sqs.Options)) (*sqs.SetQueueAttributesOutput, error) {
+				*stored = input.Attributes["Policy"]
+				if client.SetQueueAttributesCallCount() == 1 {
+					// Another binding read the policy before this write, and writes it back with only its own statement added
+					*stored = `{"Version":"2012-10-17","Statement":[` + other + `,{"Sid":"TopicB","Effect":"Allow"}]}`
+				}
+				return &sqs.SetQueueAttributesOutput{}, nil
+			}
+
+			Expect(policies.PutStatement(context.TODO(), queueURL, "TopicA", statement)).To(Succeed())
+
+			Expect(client.SetQueueAttributesCallCount()).To(Equal(2))
+			Expect(*stored).To(MatchJSON(`{
+				"Version": "2012-10-17",
+				"Statement": [` + other + `, {"Sid":"TopicB","Effect":"Allow"}, {"Sid":"TopicA","Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Action":"sqs:SendMessage"}]
+			}`))
+		})
+
+		It("gives up when concurrent changes keep dropping the statement", func() {
+			client.SetQueueAttributesReturns(&sqs.SetQueueAttributesOutput{}, nil)
+
+			err := policies.PutStatement(context.TODO(), queueURL, "TopicA", statement)
+			Expect(err).To(MatchError(ContainSubstring(`statement "TopicA" of the policy of queue "` + queueURL + `" was overwritten by concurrent changes 10 times`)))
+			Expect(client.SetQueueAttributesCallCount()).To(Equal(10))
+		})
+
+		It("rejects statements that are not JSON objects", func() {
+			Expect(policies.PutStatement(context.TODO(), queueURL, "TopicA", `["sqs:SendMessage"]`)).To(MatchError(ContainSubstring("invalid policy statement")))
+			Expect(client.SetQueueAttributesCallCount()).To(BeZero())
+		})
+
+		It("reports policies that cannot be parsed", func() {
+			hasPolicy(`not JSON`)
+
+			Expect(policies.PutStatement(context.TODO(), queueURL, "TopicA", statement)).To(MatchError(ContainSubstring("could not parse the policy")))
+			Expect(client.SetQueueAttributesCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("GetStatement", func() {
+		It("returns the statement without its ID", func() {
+			hasPolicy(`{"Version":"2012-10-17","Statement":[` + other + `,{"Sid":"TopicA","Effect":"Allow","Action":"sqs:SendMessage"}]}`)
+
+			result, exists, err := policies.GetStatement(context.TODO(), queueURL, "TopicA")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeTrue())
+			Expect(result).To(MatchJSON(`{"Effect":"Allow","Action":"sqs:SendMessage"}`))
+		})
+
+		It("reports statements that are not in the policy", func() {
+			hasPolicy(`{"Version":"2012-10-17","Statement":[` + other + `]}`)
+
+			_, exists, err := policies.GetStatement(context.TODO(), queueURL, "TopicA")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+
+		It("reports queues that do not exist", func() {
+			client.GetQueueAttributesReturns(nil, &types.QueueDoesNotExist{})
+
+			_, exists, err := policies.GetStatement(context.TODO(), queueURL, "TopicA")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+
+		It("reports other failures", func() {
+			client.GetQueueAttributesReturns(nil, fmt.Errorf("access denied"))
+
+			_, _, err := policies.GetStatement(context.TODO(), queueURL, "TopicA")
+			Expect(err).To(MatchError("access denied"))
+		})
+	})
+
+	Describe("DeleteStatement", func() {
+		It("only removes the statement with the ID", func() {
+			hasPolicy(`{"Version":"2012-10-17","Statement":[{"Sid":"TopicA","Effect":"Allow"},` + other + `]}`)
+
+			Expect(policies.DeleteStatement(context.TODO(), queueURL, "TopicA")).To(Succeed())
+
+			Expect(writtenPolicy()).To(MatchJSON(`{"Version":"2012-10-17","Statement":[` + other + `]}`))
+		})
+
+		It("removes the statement again when a concurrent change restored it", func() {
+			stored := storePolicy(client, `{"Version":"2012-10-17","Statement":[{"Sid":"TopicA","Effect":"Allow"},`+other+`]}`)
+			client.SetQueueAttributesStub = func(_ context.Context, input *sqs.SetQueueAttributesInput, _ ...func(*sqs.Options)) (*sqs.SetQueueAttributesOutput, error) {
+				*stored = input.Attributes["Policy"]
+				if client.SetQueueAttributesCallCount() == 1 {
+					// Another binding read the policy before this write, so its copy still has the statement
+					*stored = `{"Version":"2012-10-17","Statement":[{"Sid":"TopicA","Effect":"Allow"},` + other + `,{"Sid":"TopicB","Effect":"Allow"}]}`
+				}
+				return &sqs.SetQueueAttributesOutput{}, nil
+			}
+
+			Expect(policies.DeleteStatement(context.TODO(), queueURL, "TopicA")).To(Succeed())
+
+			Expect(client.SetQueueAttributesCallCount()).To(Equal(2))
+			Expect(*stored).To(MatchJSON(`{"Version":"2012-10-17","Statement":[` + other + `,{"Sid":"TopicB","Effect":"Allow"}]}`))
+		})
+
+		It("removes the policy when no statements are left", func() {
+			hasPolicy(`{"Version":"2012-10-17","Statement":{"Sid":"TopicA","Effect":"Allow"}}`)
+
+			Expect(policies.DeleteStatement(context.TODO(), queueURL, "TopicA")).To(Succeed())
+
+			Expect(writtenPolicy()).To(BeEmpty())
+		})
+
+		It("leaves the policy alone when the statement is not in it", func() {
+			hasPolicy(`{"Version":"2012-10-17","Statement":[` + other + `]}`)
+
+			Expect(policies.DeleteStatement(context.TODO(), queueURL, "TopicA")).To(Succeed())
+			Expect(client.SetQueueAttributesCallCount()).To(BeZero())
+		})
+
+		It("succeeds when the queue no longer exists", func() {
+			client.GetQueueAttributesReturns(nil, &types.QueueDoesNotExist{})
+
+			Expect(policies.DeleteStatement(context.TODO(), queueURL, "TopicA")).To(Succeed())
+			Expect(client.SetQueueAttributesCallCount()).To(BeZero())
+		})
+	})
+})
+
+// storePolicy makes the fake behave like a queue, whose policy is the one last written
+func storePolicy(client *csbsqsfakes.FakeQueueAttributesClient, policy string) *string {
+	stored := &policy
+	client.GetQueueAttributesStub = func(context.Context, *sqs.GetQueueAttributesInput, ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error) {
+		return &sqs.GetQueueAttributesOutput{Attributes: map[string]string{"Policy": *stored}}, nil
+	}
+	client.SetQueueAttributesStub = func(_ context.Context, input *sqs.SetQueueAttributesInput, _ ...func(*sqs.Options)) (*sqs.SetQueueAttributesOutput, error) {
+		*stored = input.Attributes["Policy"]
+		return &sqs.SetQueueAttributesOutput{}, nil
+	}
+	return stored
+}
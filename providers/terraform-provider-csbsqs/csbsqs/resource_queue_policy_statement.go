@@ -0,0 +1,125 @@
+package csbsqs
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	QueueURLKey  = "queue_url"
+	SidKey       = "sid"
+	StatementKey = "statement"
+
+	idSeparator = "#"
+)
+
+func ResourceQueuePolicyStatement() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			QueueURLKey: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+			SidKey: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9]+$`), "must contain only letters and digits"),
+				Description:  "ID of the statement in the queue policy, which must be unique in the policy",
+			},
+			StatementKey: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				Description:      "The statement as a JSON object, without a Sid",
+			},
+		},
+		CreateContext: resourceQueuePolicyStatementCreate,
+		ReadContext:   resourceQueuePolicyStatementRead,
+		UpdateContext: resourceQueuePolicyStatementUpdate,
+		DeleteContext: resourceQueuePolicyStatementDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceQueuePolicyStatementImport,
+		},
+		Description: "A statement in the access policy of an SQS queue, which leaves the other statements of the policy untouched",
+	}
+}
+
+func resourceQueuePolicyStatementCreate(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	policies, err := config.(SQSConfig).GetQueuePolicies(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	queueURL, sid := data.Get(QueueURLKey).(string), data.Get(SidKey).(string)
+	if err := policies.PutStatement(ctx, queueURL, sid, data.Get(StatementKey).(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(queueURL + idSeparator + sid)
+	return nil
+}
+
+// resourceQueuePolicyStatementRead removes the statement from the state when it is no longer in the
+// policy, for instance because the policy was replaced, so that it is added again
+func resourceQueuePolicyStatementRead(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	policies, err := config.(SQSConfig).GetQueuePolicies(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statement, exists, err := policies.GetStatement(ctx, data.Get(QueueURLKey).(string), data.Get(SidKey).(string))
+	switch {
+	case err != nil:
+		return diag.FromErr(err)
+	case !exists:
+		data.SetId("")
+		return nil
+	}
+
+	return diag.FromErr(data.Set(StatementKey, statement))
+}
+
+func resourceQueuePolicyStatementUpdate(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	policies, err := config.(SQSConfig).GetQueuePolicies(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(policies.PutStatement(ctx, data.Get(QueueURLKey).(string), data.Get(SidKey).(string), data.Get(StatementKey).(string)))
+}
+
+func resourceQueuePolicyStatementDelete(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	policies, err := config.(SQSConfig).GetQueuePolicies(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(policies.DeleteStatement(ctx, data.Get(QueueURLKey).(string), data.Get(SidKey).(string)))
+}
+
+// resourceQueuePolicyStatementImport accepts IDs of the form <queue_url>#<sid>
+func resourceQueuePolicyStatementImport(_ context.Context, data *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	queueURL, sid, ok := strings.Cut(data.Id(), idSeparator)
+	if !ok || queueURL == "" || sid == "" {
+		return nil, fmt.Errorf("invalid ID %q, expected <queue_url>%s<sid>", data.Id(), idSeparator)
+	}
+
+	if err := data.Set(QueueURLKey, queueURL); err != nil {
+		return nil, err
+	}
+	if err := data.Set(SidKey, sid); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{data}, nil
+}
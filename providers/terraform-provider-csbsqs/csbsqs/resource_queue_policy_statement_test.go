@@ -0,0 +1,113 @@
+package csbsqs_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-sqs/csbsqs"
+	"github.com/cloudfoundry/csb-brokerpak-aws/terraform-provider-sqs/csbsqs/csbsqsfakes"
+)
+
+var _ = Describe("ResourceQueuePolicyStatement", func() {
+	const (
+		queueURL = "https://sqs.us-west-2.amazonaws.com/123456789012/queue"
+		id       = queueURL + "#TopicA"
+	)
+
+	var (
+		resource *schema.Resource
+		client   *csbsqsfakes.FakeQueueAttributesClient
+		config   *csbsqsfakes.FakeSQSConfig
+		data     *schema.ResourceData
+	)
+
+	BeforeEach(func() {
+		resource = csbsqs.ResourceQueuePolicyStatement()
+		client = &csbsqsfakes.FakeQueueAttributesClient{}
+		storePolicy(client, "")
+		config = &csbsqsfakes.FakeSQSConfig{}
+		config.GetQueuePoliciesReturns(csbsqs.NewQueuePolicies(client), nil)
+
+		data = schema.TestResourceDataRaw(GinkgoT(), resource.Schema, map[string]any{
+			csbsqs.QueueURLKey:  queueURL,
+			csbsqs.SidKey:       "TopicA",
+			csbsqs.StatementKey: `{"Effect":"Allow","Action":"sqs:SendMessage"}`,
+		})
+
+		DeferCleanup(csbsqs.ShortenDelays())
+	})
+
+	It("adds the statement to the queue policy", func() {
+		Expect(resource.CreateContext(context.TODO(), data, config)).To(BeNil())
+
+		Expect(client.SetQueueAttributesCallCount()).To(Equal(1))
+		_, input, _ := client.SetQueueAttributesArgsForCall(0)
+		Expect(input.Attributes["Policy"]).To(ContainSubstring(`"Sid":"TopicA"`))
+		Expect(data.Id()).To(Equal(id))
+	})
+
+	It("reports failures to get the queue policies", func() {
+		config.GetQueuePoliciesReturns(nil, fmt.Errorf("no credentials"))
+
+		d := resource.CreateContext(context.TODO(), data, config)
+		Expect(d).To(HaveLen(1))
+		Expect(d[0].Summary).To(Equal("no credentials"))
+		Expect(data.Id()).To(BeEmpty())
+	})
+
+	It("removes the statement from the queue policy", func() {
+		data.SetId(id)
+		storePolicy(client, `{"Version":"2012-10-17","Statement":[{"Sid":"TopicA"},{"Sid":"TopicB"}]}`)
+
+		Expect(resource.DeleteContext(context.TODO(), data, config)).To(BeNil())
+
+		Expect(client.SetQueueAttributesCallCount()).To(Equal(1))
+		_, input, _ := client.SetQueueAttributesArgsForCall(0)
+		Expect(input.Attributes["Policy"]).To(MatchJSON(`{"Version":"2012-10-17","Statement":[{"Sid":"TopicB"}]}`))
+	})
+
+	Describe("read", func() {
+		BeforeEach(func() {
+			data.SetId(id)
+		})
+
+		It("reads the statement from the queue policy", func() {
+			client.GetQueueAttributesReturns(&sqs.GetQueueAttributesOutput{Attributes: map[string]string{
+				"Policy": `{"Version":"2012-10-17","Statement":[{"Sid":"TopicA","Effect":"Deny","Action":"sqs:SendMessage"}]}`,
+			}}, nil)
+
+			Expect(resource.ReadContext(context.TODO(), data, config)).To(BeNil())
+			Expect(data.Id()).To(Equal(id))
+			Expect(data.Get(csbsqs.StatementKey)).To(MatchJSON(`{"Effect":"Deny","Action":"sqs:SendMessage"}`))
+		})
+
+		It("removes the statement from the state when it is no longer in the policy", func() {
+			Expect(resource.ReadContext(context.TODO(), data, config)).To(BeNil())
+			Expect(data.Id()).To(BeEmpty())
+		})
+	})
+
+	Describe("import", func() {
+		It("reads the queue URL and statement ID from the ID", func() {
+			data.SetId(id)
+
+			result, err := resource.Importer.StateContext(context.TODO(), data, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(HaveLen(1))
+			Expect(result[0].Get(csbsqs.QueueURLKey)).To(Equal(queueURL))
+			Expect(result[0].Get(csbsqs.SidKey)).To(Equal("TopicA"))
+		})
+
+		It("rejects IDs without a statement ID", func() {
+			data.SetId(queueURL)
+
+			_, err := resource.Importer.StateContext(context.TODO(), data, config)
+			Expect(err).To(MatchError(ContainSubstring("invalid ID")))
+		})
+	})
+})
@@ -0,0 +1,66 @@
+package csbsqs
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+)
+
+//go:generate go tool counterfeiter -generate
+
+//counterfeiter:generate -header csbsqsfakes/header.txt . SQSConfig
+type SQSConfig interface {
+	GetQueuePolicies(ctx context.Context) (*QueuePolicies, error)
+}
+
+//counterfeiter:generate -header csbsqsfakes/header.txt . QueueAttributesClient
+type QueueAttributesClient interface {
+	GetQueueAttributes(ctx context.Context, params *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error)
+	SetQueueAttributes(ctx context.Context, params *sqs.SetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.SetQueueAttributesOutput, error)
+}
+
+type sqsSettings struct {
+	region            string
+	accessKeyID       string
+	secretAccessKey   string
+	customEndpointURL string
+
+	lock     sync.Mutex
+	policies *QueuePolicies
+}
+
+// Fail fast if the interface is not implemented
+var _ SQSConfig = &sqsSettings{}
+
+// GetQueuePolicies connects to SQS when a queue policy is first needed, so that planning
+// does not require credentials
+func (s *sqsSettings) GetQueuePolicies(ctx context.Context) (*QueuePolicies, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.policies != nil {
+		return s.policies, nil
+	}
+
+	opts := []func(*config.LoadOptions) error{config.WithRegion(s.region)}
+	if s.accessKeyID != "" {
+		opts = append(opts, config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(s.accessKeyID, s.secretAccessKey, "")))
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	s.policies = NewQueuePolicies(sqs.NewFromConfig(cfg, func(o *sqs.Options) {
+		// For testing we use a custom endpoint
+		if s.customEndpointURL != "" {
+			o.BaseEndpoint = aws.String(s.customEndpointURL)
+		}
+	}))
+	return s.policies, nil
+}
@@ -0,0 +1,26 @@
+# Run "make init" to perform "terraform init"
+
+terraform {
+  required_providers {
+    csbsqs = {
+      source  = "cloudfoundry.org/cloud-service-broker/csbsqs"
+      version = "1.0.0"
+    }
+  }
+}
+
+provider "csbsqs" {
+  region = "us-west-2"
+}
+
+resource "csbsqs_queue_policy_statement" "topic_delivery" {
+  queue_url = "https://sqs.us-west-2.amazonaws.com/123456789012/queue"
+  sid       = "AllowTopicDelivery"
+  statement = jsonencode({
+    Effect    = "Allow"
+    Principal = { Service = "sns.amazonaws.com" }
+    Action    = "sqs:SendMessage"
+    Resource  = "arn:aws:sqs:us-west-2:123456789012:queue"
+    Condition = { ArnEquals = { "aws:SourceArn" = "arn:aws:sns:us-west-2:123456789012:topic" } }
+  })
+}
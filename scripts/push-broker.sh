@@ -113,6 +113,12 @@ if [[ -z "$GSB_SERVICE_CSB_AWS_REDIS_PLANS" ]]; then
 fi
 echo "    GSB_SERVICE_CSB_AWS_REDIS_PLANS: $(echo "$GSB_SERVICE_CSB_AWS_REDIS_PLANS" | jq @json)" >>$cfmf
 
+if [[ -z "$GSB_SERVICE_CSB_AWS_OPENSEARCH_PLANS" ]]; then
+  echo "Missing GSB_SERVICE_CSB_AWS_OPENSEARCH_PLANS variable"
+  exit 1
+fi
+echo "    GSB_SERVICE_CSB_AWS_OPENSEARCH_PLANS: $(echo "$GSB_SERVICE_CSB_AWS_OPENSEARCH_PLANS" | jq @json)" >>$cfmf
+
 if [[ -z "$GSB_SERVICE_CSB_AWS_MSSQL_PLANS" ]]; then
   echo "Missing GSB_SERVICE_CSB_AWS_MSSQL_PLANS variable"
   exit 1
@@ -125,12 +131,36 @@ if [[ -z "$GSB_SERVICE_CSB_AWS_SQS_PLANS" ]]; then
 fi
 echo "    GSB_SERVICE_CSB_AWS_SQS_PLANS: $(echo "$GSB_SERVICE_CSB_AWS_SQS_PLANS" | jq @json)" >>$cfmf
 
+if [[ -z "$GSB_SERVICE_CSB_AWS_SNS_PLANS" ]]; then
+  echo "Missing GSB_SERVICE_CSB_AWS_SNS_PLANS variable"
+  exit 1
+fi
+echo "    GSB_SERVICE_CSB_AWS_SNS_PLANS: $(echo "$GSB_SERVICE_CSB_AWS_SNS_PLANS" | jq @json)" >>$cfmf
+
+if [[ -z "$GSB_SERVICE_CSB_AWS_KINESIS_PLANS" ]]; then
+  echo "Missing GSB_SERVICE_CSB_AWS_KINESIS_PLANS variable"
+  exit 1
+fi
+echo "    GSB_SERVICE_CSB_AWS_KINESIS_PLANS: $(echo "$GSB_SERVICE_CSB_AWS_KINESIS_PLANS" | jq @json)" >>$cfmf
+
 if [[ -z "$GSB_SERVICE_CSB_AWS_DYNAMODB_NAMESPACE_PLANS" ]]; then
   echo "Missing GSB_SERVICE_CSB_AWS_DYNAMODB_NAMESPACE_PLANS variable"
   exit 1
 fi
 echo "    GSB_SERVICE_CSB_AWS_DYNAMODB_NAMESPACE_PLANS: $(echo "$GSB_SERVICE_CSB_AWS_DYNAMODB_NAMESPACE_PLANS" | jq @json)" >>$cfmf
 
+if [[ -z "$GSB_SERVICE_CSB_AWS_DOCUMENTDB_PLANS" ]]; then
+  echo "Missing GSB_SERVICE_CSB_AWS_DOCUMENTDB_PLANS variable"
+  exit 1
+fi
+echo "    GSB_SERVICE_CSB_AWS_DOCUMENTDB_PLANS: $(echo "$GSB_SERVICE_CSB_AWS_DOCUMENTDB_PLANS" | jq @json)" >>$cfmf
+
+if [[ -z "$GSB_SERVICE_CSB_AWS_DYNAMODB_TABLE_PLANS" ]]; then
+  echo "Missing GSB_SERVICE_CSB_AWS_DYNAMODB_TABLE_PLANS variable"
+  exit 1
+fi
+echo "    GSB_SERVICE_CSB_AWS_DYNAMODB_TABLE_PLANS: $(echo "$GSB_SERVICE_CSB_AWS_DYNAMODB_TABLE_PLANS" | jq @json)" >>$cfmf
+
 cf push --no-start -f "${cfmf}" --var app=${APP_NAME}
 
 if [[ -z ${MSYQL_INSTANCE} ]]; then
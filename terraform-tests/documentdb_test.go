@@ -0,0 +1,198 @@
+package terraformtests
+
+import (
+	"path"
+
+	. "csbbrokerpakaws/terraform-tests/helpers"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("DocumentDB", Label("documentdb-terraform"), Ordered, func() {
+	var (
+		plan                  tfjson.Plan
+		terraformProvisionDir string
+		defaultVars           map[string]any
+	)
+
+	BeforeEach(func() {
+		defaultVars = map[string]any{
+			"instance_name":                   "csb-documentdb-test",
+			"db_name":                         "csbdb",
+			"labels":                          map[string]any{"key1": "some-documentdb-value"},
+			"region":                          awsRegion,
+			"aws_vpc_id":                      awsVPCID,
+			"cluster_instances":               3,
+			"docdb_subnet_group":              "",
+			"docdb_vpc_security_group_ids":    "",
+			"allow_major_version_upgrade":     true,
+			"auto_minor_version_upgrade":      true,
+			"backup_retention_period":         1,
+			"preferred_backup_window":         "23:26-23:56",
+			"deletion_protection":             false,
+			"require_tls":                     true,
+			"db_cluster_parameter_group_name": "",
+			"engine_version":                  "5.0.0",
+			"storage_encrypted":               true,
+			"kms_key_id":                      "",
+			"instance_class":                  "db.t3.medium",
+			"port":                            27017,
+		}
+	})
+
+	BeforeAll(func() {
+		terraformProvisionDir = path.Join(workingDir, "documentdb/provision")
+		Init(terraformProvisionDir)
+	})
+
+	Context("with Default values", func() {
+		BeforeAll(func() {
+			plan = ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{}))
+		})
+
+		It("should create the right resources", func() {
+			Expect(plan.ResourceChanges).To(HaveLen(10))
+
+			Expect(ResourceChangesTypes(plan)).To(ConsistOf(
+				"aws_docdb_cluster_instance",
+				"aws_docdb_cluster_instance",
+				"aws_docdb_cluster_instance",
+				"aws_docdb_cluster",
+				"random_password",
+				"random_string",
+				"aws_security_group_rule",
+				"aws_docdb_subnet_group",
+				"aws_security_group",
+				"aws_docdb_cluster_parameter_group",
+			))
+		})
+
+		It("should create a cluster_instance with the right values", func() {
+			Expect(AfterValuesForType(plan, "aws_docdb_cluster_instance")).To(MatchKeys(IgnoreExtras, Keys{
+				"engine":                     Equal("docdb"),
+				"identifier":                 Equal("csb-documentdb-test-0"),
+				"instance_class":             Equal("db.t3.medium"),
+				"auto_minor_version_upgrade": BeTrue(),
+				"tags":                       HaveKeyWithValue("key1", "some-documentdb-value"),
+				"apply_immediately":          BeTrue(),
+			}))
+		})
+
+		It("should create a cluster with the right values", func() {
+			Expect(AfterValuesForType(plan, "aws_docdb_cluster")).To(MatchKeys(IgnoreExtras, Keys{
+				"cluster_identifier":          Equal("csb-documentdb-test"),
+				"engine":                      Equal("docdb"),
+				"engine_version":              Equal("5.0.0"),
+				"port":                        BeNumerically("==", 27017),
+				"db_subnet_group_name":        Equal("csb-documentdb-test-p-sn"),
+				"skip_final_snapshot":         BeTrue(),
+				"allow_major_version_upgrade": BeTrue(),
+				"tags":                        HaveKeyWithValue("key1", "some-documentdb-value"),
+				"backup_retention_period":     BeNumerically("==", 1),
+				"preferred_backup_window":     Equal("23:26-23:56"),
+				"deletion_protection":         BeFalse(),
+				"storage_encrypted":           BeTrue(),
+				"apply_immediately":           BeTrue(),
+			}))
+		})
+
+		It("should require TLS in a parameter group of the engine version family", func() {
+			Expect(AfterValuesForType(plan, "aws_docdb_cluster_parameter_group")).To(MatchKeys(IgnoreExtras, Keys{
+				"family": Equal("docdb5.0"),
+				"parameter": ConsistOf(MatchKeys(IgnoreExtras, Keys{
+					"name":  Equal("tls"),
+					"value": Equal("enabled"),
+				})),
+			}))
+		})
+	})
+
+	When("require_tls is disabled", func() {
+		BeforeAll(func() {
+			plan = ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+				"require_tls": false,
+			}))
+		})
+
+		It("should disable TLS in the parameter group", func() {
+			Expect(AfterValuesForType(plan, "aws_docdb_cluster_parameter_group")).To(MatchKeys(IgnoreExtras, Keys{
+				"parameter": ConsistOf(MatchKeys(IgnoreExtras, Keys{
+					"name":  Equal("tls"),
+					"value": Equal("disabled"),
+				})),
+			}))
+		})
+	})
+
+	When("docdb_vpc_security_group_ids and docdb_subnet_group are passed", func() {
+		BeforeAll(func() {
+			plan = ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+				"docdb_vpc_security_group_ids": "group1,group2,group3",
+				"docdb_subnet_group":           "some-other-group",
+			}))
+		})
+
+		It("should use the values passed and not create new security groups, rules or subnet groups", func() {
+			Expect(AfterValuesForType(plan, "aws_docdb_cluster")).To(
+				MatchKeys(IgnoreExtras, Keys{
+					"vpc_security_group_ids": ConsistOf("group1", "group2", "group3"),
+					"db_subnet_group_name":   Equal("some-other-group"),
+				}))
+			Expect(ResourceCreationForType(plan, "aws_security_group")).To(BeEmpty())
+			Expect(ResourceCreationForType(plan, "aws_security_group_rule")).To(BeEmpty())
+			Expect(ResourceCreationForType(plan, "aws_docdb_subnet_group")).To(BeEmpty())
+		})
+	})
+
+	When("db_cluster_parameter_group_name is passed", func() {
+		BeforeAll(func() {
+			plan = ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+				"db_cluster_parameter_group_name": "some-parameter-group",
+			}))
+		})
+
+		It("should use the parameter group passed and not create a new one", func() {
+			Expect(AfterValuesForType(plan, "aws_docdb_cluster")).To(
+				MatchKeys(IgnoreExtras, Keys{
+					"db_cluster_parameter_group_name": Equal("some-parameter-group"),
+				}))
+			Expect(ResourceCreationForType(plan, "aws_docdb_cluster_parameter_group")).To(BeEmpty())
+		})
+	})
+
+	Context("engine_version", func() {
+		It("should complain about postcondition when the version is not exact", func() {
+			session, _ := FailPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+				"engine_version": "5.0",
+			}))
+
+			Expect(session.ExitCode()).NotTo(Equal(0))
+			msgs := string(session.Out.Contents())
+			Expect(msgs).To(ContainSubstring(`Error: Resource postcondition failed`))
+			Expect(msgs).To(ContainSubstring(`An exact DocumentDB engine version should be specified. Expected engine version: 5.0.0 - got: 5.0`))
+		})
+
+		It("should complain when the version does not exist", func() {
+			session, _ := FailPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+				"engine_version": "2.0.0",
+			}))
+
+			Expect(session.ExitCode()).NotTo(Equal(0))
+		})
+	})
+
+	Context("kms_key_id", func() {
+		It("should require storage_encrypted", func() {
+			session, _ := FailPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+				"storage_encrypted": false,
+				"kms_key_id":        "arn:aws:kms:us-west-2:123456789012:key/fake",
+			}))
+
+			Expect(session.ExitCode()).NotTo(Equal(0))
+			Expect(string(session.Out.Contents())).To(ContainSubstring("When specifying kms_key_id, storage_encrypted needs to be set to true."))
+		})
+	})
+})
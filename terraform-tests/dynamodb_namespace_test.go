@@ -1,11 +1,16 @@
 package terraformtests
 
 import (
+	"fmt"
 	"path"
+	"time"
+
+	"github.com/onsi/gomega/gbytes"
 
 	tfjson "github.com/hashicorp/terraform-json"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 
 	. "csbbrokerpakaws/terraform-tests/helpers"
 )
@@ -21,8 +26,11 @@ var _ = Describe("dynamodb-namespace", Label("dynamodb-ns-terraform"), Ordered,
 		BeforeAll(func() {
 			terraformProvisionDir = path.Join(workingDir, "dynamodb-namespace/provision")
 			defaultVars = map[string]any{
-				"region": awsRegion,
-				"prefix": "csb-fake-5368-489c-9f18-b53140316fb2-",
+				"region":                                 awsRegion,
+				"prefix":                                 "csb-fake-5368-489c-9f18-b53140316fb2-",
+				"backup_before_delete":                   false,
+				"access_key_rotation":                    0,
+				"access_key_rotation_grace_period_hours": 24,
 			}
 			Init(terraformProvisionDir)
 		})
@@ -49,9 +57,16 @@ var _ = Describe("dynamodb-namespace", Label("dynamodb-ns-terraform"), Ordered,
 					resourceID{Name: "housekeeping_policy", Type: "aws_iam_user_policy"},
 					resourceID{Name: "housekeeping_user_key", Type: "aws_iam_access_key"},
 					resourceID{Name: "housekeeping", Type: "csbdynamodbns_instance"},
+					resourceID{Name: "access_key_rotation", Type: "terraform_data"},
 				))
 			})
 
+			It("should not back up the tables", func() {
+				Expect(AfterValuesForType(plan, "csbdynamodbns_instance")).To(MatchKeys(IgnoreExtras, Keys{
+					"backup_before_delete": BeFalse(),
+				}))
+			})
+
 			It("should pass through the parameters", func() {
 				Expect(plan.OutputChanges).To(HaveKeyWithValue("region", BeAssignableToTypeOf(&tfjson.Change{})))
 				Expect(plan.OutputChanges).To(HaveKeyWithValue("prefix", BeAssignableToTypeOf(&tfjson.Change{})))
@@ -59,6 +74,18 @@ var _ = Describe("dynamodb-namespace", Label("dynamodb-ns-terraform"), Ordered,
 				Expect(plan.OutputChanges["prefix"].After).To(Equal("csb-fake-5368-489c-9f18-b53140316fb2-"))
 			})
 		})
+
+		Context("backup before delete", func() {
+			BeforeAll(func() {
+				plan = ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{"backup_before_delete": true}))
+			})
+
+			It("should back up the tables before deleting them", func() {
+				Expect(AfterValuesForType(plan, "csbdynamodbns_instance")).To(MatchKeys(IgnoreExtras, Keys{
+					"backup_before_delete": BeTrue(),
+				}))
+			})
+		})
 	})
 
 	Describe("binding", func() {
@@ -67,9 +94,14 @@ var _ = Describe("dynamodb-namespace", Label("dynamodb-ns-terraform"), Ordered,
 
 			terraformProvisionDir = path.Join(workingDir, "dynamodb-namespace/bind")
 			defaultVars = map[string]any{
-				"user_name": "fake-user-name",
-				"prefix":    "csb-fake-5368-489c-9f18-b53140316fb2-",
-				"region":    awsRegion,
+				"user_name":             "fake-user-name",
+				"prefix":                "csb-fake-5368-489c-9f18-b53140316fb2-",
+				"region":                awsRegion,
+				"credential_type":       "access_key",
+				"trusted_principal_arn": "",
+				"instance_details":      "{}",
+				"access":                "admin",
+				"table_suffixes":        []string{},
 			}
 			Init(terraformProvisionDir)
 			plan = ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{}))
@@ -77,8 +109,116 @@ var _ = Describe("dynamodb-namespace", Label("dynamodb-ns-terraform"), Ordered,
 		})
 
 		It("should include the new user credentials", func() {
+			Expect(ResourceChangesTypes(plan)).To(ConsistOf("aws_iam_user", "aws_iam_access_key", "aws_iam_user_policy"))
 			Expect(plan.OutputChanges).To(HaveKeyWithValue("access_key_id", BeAssignableToTypeOf(&tfjson.Change{})))
 			Expect(plan.OutputChanges).To(HaveKeyWithValue("secret_access_key", BeAssignableToTypeOf(&tfjson.Change{})))
 		})
+
+		Describe("access", func() {
+			userPolicy := func(overrides map[string]any) string {
+				accessPlan := ShowPlan(terraformProvisionDir, buildVars(defaultVars, overrides))
+				values, ok := AfterValuesForType(accessPlan, "aws_iam_user_policy").(map[string]any)
+				Expect(ok).To(BeTrue(), "the plan should contain an aws_iam_user_policy")
+				return values["policy"].(string)
+			}
+
+			It("should grant full access to the namespace for admin", func() {
+				Expect(userPolicy(map[string]any{})).To(SatisfyAll(
+					ContainSubstring(`"dynamodb:*"`),
+					ContainSubstring(`:table/csb-fake-5368-489c-9f18-b53140316fb2-*"`),
+				))
+			})
+
+			It("should only allow reading items for read_only", func() {
+				Expect(userPolicy(map[string]any{"access": "read_only"})).To(SatisfyAll(
+					ContainSubstring(`"dynamodb:GetItem"`),
+					ContainSubstring(`"dynamodb:Query"`),
+					Not(ContainSubstring(`"dynamodb:PutItem"`)),
+					Not(ContainSubstring(`"dynamodb:CreateTable"`)),
+					Not(ContainSubstring(`"dynamodb:*"`)),
+				))
+			})
+
+			It("should allow writing items but not managing tables for read_write", func() {
+				Expect(userPolicy(map[string]any{"access": "read_write"})).To(SatisfyAll(
+					ContainSubstring(`"dynamodb:GetItem"`),
+					ContainSubstring(`"dynamodb:PutItem"`),
+					ContainSubstring(`"dynamodb:DeleteItem"`),
+					Not(ContainSubstring(`"dynamodb:CreateTable"`)),
+					Not(ContainSubstring(`"dynamodb:DeleteTable"`)),
+					Not(ContainSubstring(`"dynamodb:*"`)),
+				))
+			})
+
+			It("should restrict the binding to the tables with the given suffixes", func() {
+				Expect(userPolicy(map[string]any{"table_suffixes": []string{"orders", "users"}})).To(SatisfyAll(
+					ContainSubstring(`:table/csb-fake-5368-489c-9f18-b53140316fb2-orders"`),
+					ContainSubstring(`:table/csb-fake-5368-489c-9f18-b53140316fb2-orders/*"`),
+					ContainSubstring(`:table/csb-fake-5368-489c-9f18-b53140316fb2-users"`),
+					Not(ContainSubstring(`:table/csb-fake-5368-489c-9f18-b53140316fb2-*"`)),
+				))
+			})
+		})
+
+		When("the access keys are rotated", func() {
+			accessKeyIndexes := func(plan tfjson.Plan) []any {
+				var indexes []any
+				for _, change := range ResourceCreationForType(plan, "aws_iam_access_key") {
+					indexes = append(indexes, change.Index)
+				}
+				return indexes
+			}
+
+			It("should only create the current access key for a new binding during the grace period", func() {
+				rotationPlan := ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+					"instance_details": fmt.Sprintf(`{"access_key_rotation":1,"access_key_rotation_grace_period_ends_at":%q}`, time.Now().Add(time.Hour).UTC().Format(time.RFC3339)),
+				}))
+
+				Expect(accessKeyIndexes(rotationPlan)).To(ConsistOf("1"))
+				Expect(AfterValuesForType(rotationPlan, "aws_iam_user")).To(
+					MatchKeys(IgnoreExtras, Keys{"tags": HaveKeyWithValue("access_key_rotation", "1")}),
+				)
+			})
+
+			It("should output the access key of the current rotation", func() {
+				rotationPlan := ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{"instance_details": `{"access_key_rotation":2}`}))
+
+				Expect(accessKeyIndexes(rotationPlan)).To(ConsistOf("2"))
+				Expect(rotationPlan.OutputChanges).To(HaveKey("access_key_id"))
+			})
+		})
+
+		When("credential_type is iam_role", func() {
+			const principal = "arn:aws:iam::123456789012:role/platform"
+
+			It("should create a role that the trusted principal can assume with a generated external ID", func() {
+				rolePlan := ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+					"credential_type":       "iam_role",
+					"trusted_principal_arn": principal,
+				}))
+
+				Expect(ResourceChangesTypes(rolePlan)).To(ConsistOf("aws_iam_role", "aws_iam_role_policy", "random_password"))
+				Expect(AfterValuesForType(rolePlan, "aws_iam_role")).To(MatchKeys(IgnoreExtras, Keys{
+					"name": Equal("fake-user-name"),
+					"path": Equal("/cf/"),
+				}))
+				Expect(AfterValuesForType(rolePlan, "random_password")).To(MatchKeys(IgnoreExtras, Keys{
+					"length":  BeNumerically("==", 32),
+					"special": BeFalse(),
+				}))
+				Expect(rolePlan.OutputChanges).To(HaveKeyWithValue("external_id", MatchFields(IgnoreExtras, Fields{
+					"AfterSensitive": BeTrue(),
+				})))
+			})
+
+			It("should fail when no trusted principal is configured", func() {
+				session, _ := FailPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+					"credential_type": "iam_role",
+				}))
+
+				Expect(session.ExitCode()).NotTo(Equal(0))
+				Expect(session).To(gbytes.Say("The broker must be configured with a trusted principal"))
+			})
+		})
 	})
 })
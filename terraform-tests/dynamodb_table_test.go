@@ -0,0 +1,267 @@
+package terraformtests
+
+import (
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/onsi/gomega/gbytes"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	. "csbbrokerpakaws/terraform-tests/helpers"
+)
+
+var _ = Describe("dynamodb-table", Label("dynamodb-table-terraform"), Ordered, func() {
+	var (
+		plan                  tfjson.Plan
+		terraformProvisionDir string
+		defaultVars           map[string]any
+	)
+
+	Describe("provisioning", func() {
+		var name string
+
+		BeforeAll(func() {
+			name = fmt.Sprintf("csb-tf-test-dynamodb-%d-%d", GinkgoRandomSeed(), time.Now().Unix())
+			terraformProvisionDir = path.Join(workingDir, "dynamodb-table/provision")
+			Init(terraformProvisionDir)
+		})
+
+		BeforeEach(func() {
+			defaultVars = map[string]any{
+				"instance_name":            name,
+				"labels":                   map[string]string{"label1": "value1"},
+				"region":                   awsRegion,
+				"hash_key":                 "id",
+				"hash_key_type":            "S",
+				"range_key":                "",
+				"range_key_type":           "S",
+				"global_secondary_indexes": []any{},
+				"billing_mode":             "PAY_PER_REQUEST",
+				"read_capacity":            5,
+				"write_capacity":           5,
+				"ttl_attribute":            "",
+				"stream_view_type":         nil,
+				"point_in_time_recovery":   false,
+				"kms_key_arn":              "",
+			}
+		})
+
+		Context("default", func() {
+			BeforeAll(func() {
+				plan = ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{}))
+			})
+
+			It("should create the table", func() {
+				Expect(ResourceChangesTypes(plan)).To(ConsistOf("aws_dynamodb_table"))
+			})
+
+			It("should create an on-demand table with a partition key", func() {
+				Expect(AfterValuesForType(plan, "aws_dynamodb_table")).To(MatchKeys(IgnoreExtras, Keys{
+					"name":           Equal(name),
+					"billing_mode":   Equal("PAY_PER_REQUEST"),
+					"hash_key":       Equal("id"),
+					"range_key":      BeNil(),
+					"stream_enabled": BeFalse(),
+					"ttl": ConsistOf(MatchKeys(IgnoreExtras, Keys{
+						"enabled":        BeFalse(),
+						"attribute_name": BeEmpty(),
+					})),
+					"attribute": ConsistOf(
+						MatchAllKeys(Keys{"name": Equal("id"), "type": Equal("S")}),
+					),
+					"global_secondary_index": BeEmpty(),
+					"point_in_time_recovery": ConsistOf(MatchKeys(IgnoreExtras, Keys{"enabled": BeFalse()})),
+					"tags_all": MatchAllKeys(Keys{
+						"label1": Equal("value1"),
+					}),
+				}))
+			})
+		})
+
+		Context("with a sort key and global secondary indexes", func() {
+			BeforeAll(func() {
+				plan = ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+					"range_key":      "created_at",
+					"range_key_type": "N",
+					"global_secondary_indexes": []any{
+						map[string]any{"name": "by-customer", "hash_key": "customer_id", "range_key": "created_at", "range_key_type": "N"},
+						map[string]any{"name": "by-status", "hash_key": "status", "projection_type": "INCLUDE", "non_key_attributes": []string{"total"}},
+					},
+				}))
+			})
+
+			It("should define every key attribute once", func() {
+				Expect(AfterValuesForType(plan, "aws_dynamodb_table")).To(MatchKeys(IgnoreExtras, Keys{
+					"range_key": Equal("created_at"),
+					"attribute": ConsistOf(
+						MatchAllKeys(Keys{"name": Equal("id"), "type": Equal("S")}),
+						MatchAllKeys(Keys{"name": Equal("created_at"), "type": Equal("N")}),
+						MatchAllKeys(Keys{"name": Equal("customer_id"), "type": Equal("S")}),
+						MatchAllKeys(Keys{"name": Equal("status"), "type": Equal("S")}),
+					),
+				}))
+			})
+
+			It("should create the indexes", func() {
+				Expect(AfterValuesForType(plan, "aws_dynamodb_table")).To(MatchKeys(IgnoreExtras, Keys{
+					"global_secondary_index": ConsistOf(
+						MatchKeys(IgnoreExtras, Keys{
+							"name":            Equal("by-customer"),
+							"hash_key":        Equal("customer_id"),
+							"range_key":       Equal("created_at"),
+							"projection_type": Equal("ALL"),
+						}),
+						MatchKeys(IgnoreExtras, Keys{
+							"name":               Equal("by-status"),
+							"hash_key":           Equal("status"),
+							"projection_type":    Equal("INCLUDE"),
+							"non_key_attributes": ConsistOf("total"),
+						}),
+					),
+				}))
+			})
+
+			It("should reject a key attribute with different types", func() {
+				session, _ := FailPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+					"global_secondary_indexes": []any{
+						map[string]any{"name": "by-id", "hash_key": "id", "hash_key_type": "N"},
+					},
+				}))
+
+				Expect(session.ExitCode()).NotTo(Equal(0))
+				Expect(session).To(gbytes.Say("A key attribute must have the same type"))
+			})
+		})
+
+		Context("with provisioned capacity", func() {
+			BeforeAll(func() {
+				plan = ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+					"billing_mode":   "PROVISIONED",
+					"read_capacity":  10,
+					"write_capacity": 20,
+					"global_secondary_indexes": []any{
+						map[string]any{"name": "by-status", "hash_key": "status", "write_capacity": 2},
+					},
+				}))
+			})
+
+			It("should set the capacity of the table and of its indexes", func() {
+				Expect(AfterValuesForType(plan, "aws_dynamodb_table")).To(MatchKeys(IgnoreExtras, Keys{
+					"billing_mode":   Equal("PROVISIONED"),
+					"read_capacity":  BeNumerically("==", 10),
+					"write_capacity": BeNumerically("==", 20),
+					"global_secondary_index": ConsistOf(MatchKeys(IgnoreExtras, Keys{
+						"read_capacity":  BeNumerically("==", 10),
+						"write_capacity": BeNumerically("==", 2),
+					})),
+				}))
+			})
+		})
+
+		Context("with TTL, streams, point-in-time recovery and a KMS key", func() {
+			const kmsKeyARN = "arn:aws:kms:us-west-2:123456789012:key/fake"
+
+			BeforeAll(func() {
+				plan = ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+					"ttl_attribute":          "expires_at",
+					"stream_view_type":       "NEW_AND_OLD_IMAGES",
+					"point_in_time_recovery": true,
+					"kms_key_arn":            kmsKeyARN,
+				}))
+			})
+
+			It("should configure the table", func() {
+				Expect(AfterValuesForType(plan, "aws_dynamodb_table")).To(MatchKeys(IgnoreExtras, Keys{
+					"ttl": ConsistOf(MatchKeys(IgnoreExtras, Keys{
+						"enabled":        BeTrue(),
+						"attribute_name": Equal("expires_at"),
+					})),
+					"stream_enabled":         BeTrue(),
+					"stream_view_type":       Equal("NEW_AND_OLD_IMAGES"),
+					"point_in_time_recovery": ConsistOf(MatchKeys(IgnoreExtras, Keys{"enabled": BeTrue()})),
+					"server_side_encryption": ConsistOf(MatchKeys(IgnoreExtras, Keys{
+						"enabled":     BeTrue(),
+						"kms_key_arn": Equal(kmsKeyARN),
+					})),
+				}))
+			})
+		})
+	})
+
+	Describe("binding", func() {
+		const (
+			tableARN  = "arn:aws:dynamodb:us-west-2:123456789012:table/fake"
+			streamARN = "arn:aws:dynamodb:us-west-2:123456789012:table/fake/stream/2026-01-01T00:00:00.000"
+			kmsKeyARN = "arn:aws:kms:us-west-2:123456789012:key/fake"
+		)
+
+		var terraformBindDir string
+
+		BeforeAll(func() {
+			terraformBindDir = path.Join(workingDir, "dynamodb-table/bind")
+			Init(terraformBindDir)
+		})
+
+		BeforeEach(func() {
+			defaultVars = map[string]any{
+				"region":      awsRegion,
+				"arn":         tableARN,
+				"stream_arn":  "",
+				"kms_key_arn": "",
+				"user_name":   "fake-user-name",
+				"access":      "read_write",
+			}
+		})
+
+		userPolicy := func(overrides map[string]any) string {
+			bindPlan := ShowPlan(terraformBindDir, buildVars(defaultVars, overrides))
+			values, ok := AfterValuesForType(bindPlan, "aws_iam_user_policy").(map[string]any)
+			Expect(ok).To(BeTrue(), "the plan should contain an aws_iam_user_policy")
+			return values["policy"].(string)
+		}
+
+		It("should include the new user credentials", func() {
+			bindPlan := ShowPlan(terraformBindDir, buildVars(defaultVars, map[string]any{}))
+
+			Expect(ResourceChangesTypes(bindPlan)).To(ConsistOf("aws_iam_user", "aws_iam_access_key", "aws_iam_user_policy"))
+			Expect(bindPlan.OutputChanges).To(HaveKeyWithValue("access_key_id", BeAssignableToTypeOf(&tfjson.Change{})))
+			Expect(bindPlan.OutputChanges).To(HaveKeyWithValue("secret_access_key", BeAssignableToTypeOf(&tfjson.Change{})))
+		})
+
+		It("should allow writing items in the table and its indexes for read_write", func() {
+			Expect(userPolicy(map[string]any{})).To(SatisfyAll(
+				ContainSubstring(`"dynamodb:GetItem"`),
+				ContainSubstring(`"dynamodb:PutItem"`),
+				ContainSubstring(fmt.Sprintf(`"%s"`, tableARN)),
+				ContainSubstring(fmt.Sprintf(`"%s/index/*"`, tableARN)),
+				Not(ContainSubstring(`"dynamodb:DeleteTable"`)),
+				Not(ContainSubstring(`"dynamodb:GetRecords"`)),
+				Not(ContainSubstring(`"kms:`)),
+			))
+		})
+
+		It("should only allow reading items for read_only", func() {
+			Expect(userPolicy(map[string]any{"access": "read_only"})).To(SatisfyAll(
+				ContainSubstring(`"dynamodb:GetItem"`),
+				ContainSubstring(`"dynamodb:Query"`),
+				Not(ContainSubstring(`"dynamodb:PutItem"`)),
+				Not(ContainSubstring(`"dynamodb:DeleteItem"`)),
+			))
+		})
+
+		It("should allow reading the stream and using the KMS key through DynamoDB", func() {
+			Expect(userPolicy(map[string]any{"stream_arn": streamARN, "kms_key_arn": kmsKeyARN})).To(SatisfyAll(
+				ContainSubstring(`"dynamodb:GetRecords"`),
+				ContainSubstring(streamARN),
+				ContainSubstring(`"kms:Decrypt"`),
+				ContainSubstring(kmsKeyARN),
+				ContainSubstring(`"kms:ViaService"`),
+			))
+		})
+	})
+})
@@ -69,6 +69,16 @@ func ShowPlan(dir string, vars map[string]any) tfjson.Plan {
 	return plan
 }
 
+// OverrideDataSource replaces arguments of a data source for the rest of the test with an override file,
+// so that a module can be planned as if the data source had found resources that do not exist in the IaaS
+func OverrideDataSource(dir, dataSourceType, name string, arguments map[string]any) {
+	overridePath := path.Join(dir, "test_override.tf.json")
+	content, err := json.Marshal(map[string]any{"data": map[string]any{dataSourceType: map[string]any{name: arguments}}})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(os.WriteFile(overridePath, content, 0644)).To(Succeed())
+	DeferCleanup(os.Remove, overridePath)
+}
+
 func createPlanCMD(dir string, planFile string) *exec.Cmd {
 	return exec.Command(binaryName, chdirFlag(dir), "plan", "-input=false", "-refresh=false", fmt.Sprintf("-out=%s", planFile), "-json")
 }
@@ -0,0 +1,210 @@
+package terraformtests
+
+import (
+	"fmt"
+	"path"
+	"time"
+
+	. "csbbrokerpakaws/terraform-tests/helpers"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("Kinesis", Label("Kinesis-terraform"), Ordered, func() {
+	var (
+		name                  string
+		plan                  tfjson.Plan
+		terraformProvisionDir string
+		defaultVars           map[string]any
+	)
+
+	BeforeAll(func() {
+		name = fmt.Sprintf("csb-tf-test-kinesis-%d-%d", GinkgoRandomSeed(), time.Now().Unix())
+
+		terraformProvisionDir = path.Join(workingDir, "kinesis/provision")
+		Init(terraformProvisionDir)
+	})
+
+	BeforeEach(func() {
+		defaultVars = map[string]any{
+			"instance_name":    name,
+			"labels":           map[string]string{"label1": "value1"},
+			"region":           awsRegion,
+			"stream_mode":      "ON_DEMAND",
+			"shard_count":      1,
+			"retention_period": 24,
+			"encryption_type":  "KMS",
+			"kms_key_id":       "",
+		}
+	})
+
+	Context("with default values", func() {
+		BeforeAll(func() {
+			plan = ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{}))
+		})
+
+		It("should create the right resources", func() {
+			Expect(plan.ResourceChanges).To(HaveLen(1))
+
+			Expect(ResourceChangesTypes(plan)).To(ConsistOf(
+				"aws_kinesis_stream",
+			))
+		})
+
+		It("should create an on-demand stream encrypted with the AWS managed key", func() {
+			Expect(AfterValuesForType(plan, "aws_kinesis_stream")).To(MatchKeys(IgnoreExtras, Keys{
+				"name":                      Equal(name),
+				"shard_count":               BeNil(),
+				"retention_period":          BeNumerically("==", 24),
+				"stream_mode_details":       ConsistOf(MatchKeys(IgnoreExtras, Keys{"stream_mode": Equal("ON_DEMAND")})),
+				"encryption_type":           Equal("KMS"),
+				"kms_key_id":                Equal("alias/aws/kinesis"),
+				"enforce_consumer_deletion": BeTrue(),
+				"tags_all":                  HaveKeyWithValue("label1", "value1"),
+			}))
+		})
+	})
+
+	Context("provisioned mode", func() {
+		BeforeAll(func() {
+			plan = ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+				"stream_mode":      "PROVISIONED",
+				"shard_count":      3,
+				"retention_period": 168,
+			}))
+		})
+
+		It("should create a stream with the requested shards and retention", func() {
+			Expect(AfterValuesForType(plan, "aws_kinesis_stream")).To(MatchKeys(IgnoreExtras, Keys{
+				"shard_count":         BeNumerically("==", 3),
+				"retention_period":    BeNumerically("==", 168),
+				"stream_mode_details": ConsistOf(MatchKeys(IgnoreExtras, Keys{"stream_mode": Equal("PROVISIONED")})),
+			}))
+		})
+	})
+
+	Context("with KMS key specified", func() {
+		BeforeAll(func() {
+			plan = ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+				"kms_key_id": "arn:aws:kms:us-west-2:123456789012:key/fake",
+			}))
+		})
+
+		It("should use the specified KMS key for encryption", func() {
+			Expect(AfterValuesForType(plan, "aws_kinesis_stream")).To(MatchKeys(IgnoreExtras, Keys{
+				"encryption_type": Equal("KMS"),
+				"kms_key_id":      Equal("arn:aws:kms:us-west-2:123456789012:key/fake"),
+			}))
+		})
+	})
+
+	Context("with encryption disabled", func() {
+		It("should not encrypt the stream", func() {
+			plan = ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{"encryption_type": "NONE"}))
+
+			Expect(AfterValuesForType(plan, "aws_kinesis_stream")).To(MatchKeys(IgnoreExtras, Keys{
+				"encryption_type": Equal("NONE"),
+				"kms_key_id":      BeNil(),
+			}))
+		})
+
+		It("should reject a KMS key", func() {
+			session, _ := FailPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+				"encryption_type": "NONE",
+				"kms_key_id":      "arn:aws:kms:us-west-2:123456789012:key/fake",
+			}))
+
+			Expect(session.ExitCode()).NotTo(Equal(0))
+			Expect(string(session.Out.Contents())).To(ContainSubstring(`A kms_key_id can only be set when encryption_type is "KMS".`))
+		})
+	})
+})
+
+var _ = Describe("Kinesis binding", Label("Kinesis-terraform"), Ordered, func() {
+	const streamARN = "arn:aws:kinesis:us-west-2:123456789012:stream/fake"
+
+	var (
+		terraformBindDir string
+		defaultVars      map[string]any
+	)
+
+	BeforeAll(func() {
+		terraformBindDir = path.Join(workingDir, "kinesis/bind")
+		Init(terraformBindDir)
+	})
+
+	BeforeEach(func() {
+		defaultVars = map[string]any{
+			"region":                awsRegion,
+			"arn":                   streamARN,
+			"user_name":             "fake-user-name",
+			"kms_key_id":            "",
+			"credential_type":       "access_key",
+			"trusted_principal_arn": "",
+			"role":                  "admin",
+		}
+	})
+
+	userPolicy := func(overrides map[string]any) string {
+		plan := ShowPlan(terraformBindDir, buildVars(defaultVars, overrides))
+		values, ok := AfterValuesForType(plan, "aws_iam_user_policy").(map[string]any)
+		Expect(ok).To(BeTrue(), "the plan should contain an aws_iam_user_policy")
+		return values["policy"].(string)
+	}
+
+	It("should grant full access for admin", func() {
+		Expect(userPolicy(map[string]any{})).To(SatisfyAll(
+			ContainSubstring(`"kinesis:PutRecord"`),
+			ContainSubstring(`"kinesis:GetRecords"`),
+			ContainSubstring(`"kinesis:DeregisterStreamConsumer"`),
+			ContainSubstring(streamARN+"/consumer/*"),
+			Not(ContainSubstring(`"kms:`)),
+		))
+	})
+
+	It("should only allow putting records for producer", func() {
+		Expect(userPolicy(map[string]any{"role": "producer"})).To(SatisfyAll(
+			ContainSubstring(`"kinesis:PutRecord"`),
+			ContainSubstring(`"kinesis:PutRecords"`),
+			Not(ContainSubstring(`"kinesis:GetRecords"`)),
+			Not(ContainSubstring(`"kinesis:GetShardIterator"`)),
+			Not(ContainSubstring(`"kinesis:SubscribeToShard"`)),
+		))
+	})
+
+	It("should only allow reading records for consumer", func() {
+		Expect(userPolicy(map[string]any{"role": "consumer"})).To(SatisfyAll(
+			ContainSubstring(`"kinesis:GetRecords"`),
+			ContainSubstring(`"kinesis:GetShardIterator"`),
+			ContainSubstring(`"kinesis:SubscribeToShard"`),
+			Not(ContainSubstring(`"kinesis:PutRecord"`)),
+			Not(ContainSubstring(`"kinesis:DeregisterStreamConsumer"`)),
+		))
+	})
+
+	It("should not grant the use of the AWS managed key", func() {
+		Expect(userPolicy(map[string]any{"kms_key_id": "alias/aws/kinesis"})).NotTo(ContainSubstring(`"kms:`))
+	})
+
+	It("should create a role instead of a user for iam_role credentials", func() {
+		plan := ShowPlan(terraformBindDir, buildVars(defaultVars, map[string]any{
+			"credential_type":       "iam_role",
+			"trusted_principal_arn": "arn:aws:iam::123456789012:role/platform",
+		}))
+
+		Expect(ResourceChangesTypes(plan)).To(ConsistOf("aws_iam_role", "aws_iam_role_policy", "random_password"))
+		Expect(plan.OutputChanges).To(HaveKeyWithValue("external_id", MatchFields(IgnoreExtras, Fields{
+			"AfterSensitive": BeTrue(),
+		})))
+	})
+
+	It("should require a trusted principal for iam_role credentials", func() {
+		session, _ := FailPlan(terraformBindDir, buildVars(defaultVars, map[string]any{"credential_type": "iam_role"}))
+
+		Expect(session.ExitCode()).NotTo(Equal(0))
+		Expect(string(session.Out.Contents())).To(ContainSubstring(`The broker must be configured with a trusted principal to create bindings with credential_type "iam_role".`))
+	})
+})
@@ -695,6 +695,62 @@ var _ = Describe("mssql", Label("mssql-terraform"), Ordered, func() {
 		})
 	})
 
+	Context("upgrade targets", func() {
+		When("the engine version is not a valid upgrade target of the provisioned version", func() {
+			It("fails the plan", func() {
+				OverrideDataSource(terraformProvisionDir, "csbmajorengineversion_upgrade_targets", "upgrade_checker", map[string]any{
+					"count":          1,
+					"engine_version": "16.00.4095.4.v1",
+				})
+
+				session, _ := FailPlan(terraformProvisionDir, buildVars(defaultVars, requiredVars, map[string]any{
+					"auto_minor_version_upgrade": false,
+					"mssql_version":              "15.00",
+				}))
+
+				Expect(session.ExitCode()).NotTo(Equal(0))
+				msgs := string(session.Out.Contents())
+				Expect(msgs).To(ContainSubstring(`Error: Resource postcondition failed`))
+				Expect(msgs).To(ContainSubstring(`Engine version 16.00.4095.4.v1 cannot be upgraded to 15.00. Valid upgrade targets are: 16.00.4125.3.v1.`))
+			})
+		})
+
+		When("the engine version is a major version upgrade that is not allowed", func() {
+			It("fails the plan", func() {
+				OverrideDataSource(terraformProvisionDir, "csbmajorengineversion_upgrade_targets", "upgrade_checker", map[string]any{
+					"count":          1,
+					"engine_version": "15.00.4236.7.v1",
+				})
+
+				session, _ := FailPlan(terraformProvisionDir, buildVars(defaultVars, requiredVars, map[string]any{
+					"allow_major_version_upgrade": false,
+					"mssql_version":               "16.00",
+				}))
+
+				Expect(session.ExitCode()).NotTo(Equal(0))
+				msgs := string(session.Out.Contents())
+				Expect(msgs).To(ContainSubstring(`Error: Resource postcondition failed`))
+				Expect(msgs).To(ContainSubstring(`Engine version 15.00.4236.7.v1 cannot be upgraded to 16.00.`))
+			})
+		})
+
+		When("the engine version is a valid upgrade target", func() {
+			It("plans the upgrade", func() {
+				OverrideDataSource(terraformProvisionDir, "csbmajorengineversion_upgrade_targets", "upgrade_checker", map[string]any{
+					"count":          1,
+					"engine_version": "15.00.4236.7.v1",
+				})
+
+				plan := ShowPlan(terraformProvisionDir, buildVars(defaultVars, requiredVars, map[string]any{
+					"allow_major_version_upgrade": true,
+					"mssql_version":               "16.00",
+				}))
+
+				Expect(AfterValuesForType(plan, "aws_db_instance")).To(MatchKeys(IgnoreExtras, Keys{"engine_version": Equal("16.00")}))
+			})
+		})
+	})
+
 	Context("performance insights", func() {
 		When("performance insights is enabled", func() {
 			It("works as expected", func() {
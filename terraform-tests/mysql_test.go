@@ -396,6 +396,62 @@ var _ = Describe("mysql", Label("mysql-terraform"), Ordered, func() {
 		})
 	})
 
+	Context("upgrade targets", func() {
+		When("the engine version is not a valid upgrade target of the provisioned version", func() {
+			It("fails the plan", func() {
+				OverrideDataSource(terraformProvisionDir, "csbmajorengineversion_upgrade_targets", "upgrade_checker", map[string]any{
+					"count":          1,
+					"engine_version": "5.7.39",
+				})
+
+				session, _ := FailPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+					"auto_minor_version_upgrade": false,
+					"engine_version":             "5.7.40",
+				}))
+
+				Expect(session.ExitCode()).NotTo(Equal(0))
+				msgs := string(session.Out.Contents())
+				Expect(msgs).To(ContainSubstring(`Error: Resource postcondition failed`))
+				Expect(msgs).To(ContainSubstring(`Engine version 5.7.39 cannot be upgraded to 5.7.40. Valid upgrade targets are: 5.7.42, 8.0.31, 8.0.32.`))
+			})
+		})
+
+		When("the engine version is a major version upgrade that is not allowed", func() {
+			It("fails the plan", func() {
+				OverrideDataSource(terraformProvisionDir, "csbmajorengineversion_upgrade_targets", "upgrade_checker", map[string]any{
+					"count":          1,
+					"engine_version": "5.7.39",
+				})
+
+				session, _ := FailPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+					"allow_major_version_upgrade": false,
+					"engine_version":              "8.0",
+				}))
+
+				Expect(session.ExitCode()).NotTo(Equal(0))
+				msgs := string(session.Out.Contents())
+				Expect(msgs).To(ContainSubstring(`Error: Resource postcondition failed`))
+				Expect(msgs).To(ContainSubstring(`Engine version 5.7.39 cannot be upgraded to 8.0.`))
+			})
+		})
+
+		When("the engine version is a valid upgrade target", func() {
+			It("plans the upgrade", func() {
+				OverrideDataSource(terraformProvisionDir, "csbmajorengineversion_upgrade_targets", "upgrade_checker", map[string]any{
+					"count":          1,
+					"engine_version": "5.7.39",
+				})
+
+				plan := ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+					"allow_major_version_upgrade": true,
+					"engine_version":              "8.0",
+				}))
+
+				Expect(AfterValuesForType(plan, "aws_db_instance")).To(MatchKeys(IgnoreExtras, Keys{"engine_version": Equal("8.0")}))
+			})
+		})
+	})
+
 	Context("managed admin password", func() {
 		When("disabled", func() {
 			BeforeAll(func() {
@@ -0,0 +1,213 @@
+package terraformtests
+
+import (
+	"path"
+
+	. "csbbrokerpakaws/terraform-tests/helpers"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("OpenSearch", Label("opensearch-terraform"), Ordered, func() {
+	var (
+		plan        tfjson.Plan
+		defaultVars map[string]any
+	)
+
+	Describe("provisioning", func() {
+		var terraformProvisionDir string
+
+		BeforeEach(func() {
+			defaultVars = map[string]any{
+				"instance_name":                     "csb-opensearch-test",
+				"labels":                            map[string]any{"key1": "some-opensearch-value"},
+				"region":                            awsRegion,
+				"aws_vpc_id":                        awsVPCID,
+				"engine_version":                    "OpenSearch_2.19",
+				"instance_type":                     "t3.medium.search",
+				"instance_count":                    1,
+				"zone_awareness_enabled":            false,
+				"availability_zone_count":           2,
+				"volume_type":                       "gp3",
+				"volume_size":                       10,
+				"kms_key_id":                        "",
+				"tls_security_policy":               "Policy-Min-TLS-1-2-2019-07",
+				"opensearch_subnet_ids":             "",
+				"opensearch_vpc_security_group_ids": "",
+			}
+		})
+
+		BeforeAll(func() {
+			terraformProvisionDir = path.Join(workingDir, "opensearch/provision")
+			Init(terraformProvisionDir)
+		})
+
+		Context("with Default values", func() {
+			BeforeAll(func() {
+				plan = ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{}))
+			})
+
+			It("should create the right resources", func() {
+				Expect(plan.ResourceChanges).To(HaveLen(6))
+
+				Expect(ResourceChangesTypes(plan)).To(ConsistOf(
+					"aws_opensearch_domain",
+					"aws_opensearch_domain_policy",
+					"random_password",
+					"random_string",
+					"aws_security_group_rule",
+					"aws_security_group",
+				))
+			})
+
+			It("should create a domain with fine-grained access control in a single subnet", func() {
+				Expect(AfterValuesForType(plan, "aws_opensearch_domain")).To(MatchKeys(IgnoreExtras, Keys{
+					"domain_name":    Equal("csb-opensearch-test"),
+					"engine_version": Equal("OpenSearch_2.19"),
+					"tags":           HaveKeyWithValue("key1", "some-opensearch-value"),
+					"cluster_config": ConsistOf(MatchKeys(IgnoreExtras, Keys{
+						"instance_type":          Equal("t3.medium.search"),
+						"instance_count":         BeNumerically("==", 1),
+						"zone_awareness_enabled": BeFalse(),
+						"zone_awareness_config":  BeEmpty(),
+					})),
+					"ebs_options": ConsistOf(MatchKeys(IgnoreExtras, Keys{
+						"ebs_enabled": BeTrue(),
+						"volume_type": Equal("gp3"),
+						"volume_size": BeNumerically("==", 10),
+					})),
+					"encrypt_at_rest":         ConsistOf(MatchKeys(IgnoreExtras, Keys{"enabled": BeTrue()})),
+					"node_to_node_encryption": ConsistOf(MatchKeys(IgnoreExtras, Keys{"enabled": BeTrue()})),
+					"domain_endpoint_options": ConsistOf(MatchKeys(IgnoreExtras, Keys{
+						"enforce_https":       BeTrue(),
+						"tls_security_policy": Equal("Policy-Min-TLS-1-2-2019-07"),
+					})),
+					"advanced_security_options": ConsistOf(MatchKeys(IgnoreExtras, Keys{
+						"enabled":                        BeTrue(),
+						"internal_user_database_enabled": BeTrue(),
+					})),
+					"vpc_options": ConsistOf(MatchKeys(IgnoreExtras, Keys{
+						"subnet_ids": HaveLen(1),
+					})),
+				}))
+			})
+
+			It("should only allow HTTPS into the security group", func() {
+				Expect(AfterValuesForType(plan, "aws_security_group_rule")).To(MatchKeys(IgnoreExtras, Keys{
+					"from_port": BeNumerically("==", 443),
+					"to_port":   BeNumerically("==", 443),
+					"protocol":  Equal("tcp"),
+					"type":      Equal("ingress"),
+				}))
+			})
+		})
+
+		When("zone awareness is enabled", func() {
+			BeforeAll(func() {
+				plan = ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+					"zone_awareness_enabled":  true,
+					"availability_zone_count": 2,
+					"instance_count":          2,
+				}))
+			})
+
+			It("should spread the domain across a subnet in each availability zone", func() {
+				Expect(AfterValuesForType(plan, "aws_opensearch_domain")).To(MatchKeys(IgnoreExtras, Keys{
+					"cluster_config": ConsistOf(MatchKeys(IgnoreExtras, Keys{
+						"zone_awareness_enabled": BeTrue(),
+						"zone_awareness_config": ConsistOf(MatchKeys(IgnoreExtras, Keys{
+							"availability_zone_count": BeNumerically("==", 2),
+						})),
+					})),
+					"vpc_options": ConsistOf(MatchKeys(IgnoreExtras, Keys{
+						"subnet_ids": HaveLen(2),
+					})),
+				}))
+			})
+		})
+
+		When("opensearch_subnet_ids, opensearch_vpc_security_group_ids and kms_key_id are passed", func() {
+			BeforeAll(func() {
+				plan = ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+					"opensearch_subnet_ids":             "subnet-1",
+					"opensearch_vpc_security_group_ids": "group1,group2",
+					"kms_key_id":                        "arn:aws:kms:us-west-2:123456789012:key/fake",
+				}))
+			})
+
+			It("should use the values passed and not create new security groups or rules", func() {
+				Expect(AfterValuesForType(plan, "aws_opensearch_domain")).To(MatchKeys(IgnoreExtras, Keys{
+					"vpc_options": ConsistOf(MatchKeys(IgnoreExtras, Keys{
+						"subnet_ids":         ConsistOf("subnet-1"),
+						"security_group_ids": ConsistOf("group1", "group2"),
+					})),
+					"encrypt_at_rest": ConsistOf(MatchKeys(IgnoreExtras, Keys{
+						"enabled":    BeTrue(),
+						"kms_key_id": Equal("arn:aws:kms:us-west-2:123456789012:key/fake"),
+					})),
+				}))
+				Expect(ResourceCreationForType(plan, "aws_security_group")).To(BeEmpty())
+				Expect(ResourceCreationForType(plan, "aws_security_group_rule")).To(BeEmpty())
+			})
+		})
+
+		Context("preconditions", func() {
+			It("should require a subnet in each availability zone", func() {
+				session, _ := FailPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+					"zone_awareness_enabled":  true,
+					"availability_zone_count": 3,
+					"instance_count":          3,
+					"opensearch_subnet_ids":   "subnet-1,subnet-2",
+				}))
+
+				Expect(session.ExitCode()).NotTo(Equal(0))
+				Expect(string(session.Out.Contents())).To(ContainSubstring("The domain needs one subnet in each of 3 availability zones, but 2 subnets were found or specified."))
+			})
+
+			It("should require an even instance count in two availability zones", func() {
+				session, _ := FailPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+					"zone_awareness_enabled":  true,
+					"availability_zone_count": 2,
+					"instance_count":          3,
+				}))
+
+				Expect(session.ExitCode()).NotTo(Equal(0))
+				Expect(string(session.Out.Contents())).To(ContainSubstring("With zone awareness, instance_count must be at least availability_zone_count, and must be even when availability_zone_count is 2."))
+			})
+		})
+	})
+
+	Describe("binding", func() {
+		var terraformBindDir string
+
+		BeforeEach(func() {
+			defaultVars = map[string]any{
+				"endpoint":       "https://vpc-csb-opensearch-test-fake.us-west-2.es.amazonaws.com",
+				"hostname":       "vpc-csb-opensearch-test-fake.us-west-2.es.amazonaws.com",
+				"admin_username": "admin",
+				"admin_password": "fake-admin-password",
+				"index_patterns": []string{"logs-*"},
+				"read_only":      false,
+			}
+		})
+
+		BeforeAll(func() {
+			terraformBindDir = path.Join(workingDir, "opensearch/bind")
+			Init(terraformBindDir)
+		})
+
+		It("should create a binding user for the index patterns", func() {
+			plan = ShowPlan(terraformBindDir, buildVars(defaultVars, map[string]any{"read_only": true}))
+
+			Expect(ResourceChangesTypes(plan)).To(ConsistOf("random_string", "random_password", "csbopensearch_binding_user"))
+			Expect(AfterValuesForType(plan, "csbopensearch_binding_user")).To(MatchKeys(IgnoreExtras, Keys{
+				"index_patterns": ConsistOf("logs-*"),
+				"read_only":      BeTrue(),
+			}))
+			Expect(plan.OutputChanges).To(HaveKeyWithValue("uri", BeAssignableToTypeOf(&tfjson.Change{})))
+		})
+	})
+})
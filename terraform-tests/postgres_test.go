@@ -563,6 +563,62 @@ var _ = Describe("postgres", Label("postgres-terraform"), Ordered, func() {
 		})
 	})
 
+	Context("upgrade targets", func() {
+		When("the engine version is not a valid upgrade target of the provisioned version", func() {
+			It("fails the plan", func() {
+				OverrideDataSource(terraformProvisionDir, "csbmajorengineversion_upgrade_targets", "upgrade_checker", map[string]any{
+					"count":          1,
+					"engine_version": "14.2",
+				})
+
+				session, _ := FailPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+					"auto_minor_version_upgrade": false,
+					"postgres_version":           "14.3",
+				}))
+
+				Expect(session.ExitCode()).NotTo(Equal(0))
+				msgs := string(session.Out.Contents())
+				Expect(msgs).To(ContainSubstring(`Error: Resource postcondition failed`))
+				Expect(msgs).To(ContainSubstring(`Engine version 14.2 cannot be upgraded to 14.3. Valid upgrade targets are: 14.7, 15.3.`))
+			})
+		})
+
+		When("the engine version is a major version upgrade that is not allowed", func() {
+			It("fails the plan", func() {
+				OverrideDataSource(terraformProvisionDir, "csbmajorengineversion_upgrade_targets", "upgrade_checker", map[string]any{
+					"count":          1,
+					"engine_version": "14.2",
+				})
+
+				session, _ := FailPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+					"allow_major_version_upgrade": false,
+					"postgres_version":            "15",
+				}))
+
+				Expect(session.ExitCode()).NotTo(Equal(0))
+				msgs := string(session.Out.Contents())
+				Expect(msgs).To(ContainSubstring(`Error: Resource postcondition failed`))
+				Expect(msgs).To(ContainSubstring(`Engine version 14.2 cannot be upgraded to 15.`))
+			})
+		})
+
+		When("the engine version is a valid upgrade target", func() {
+			It("plans the upgrade", func() {
+				OverrideDataSource(terraformProvisionDir, "csbmajorengineversion_upgrade_targets", "upgrade_checker", map[string]any{
+					"count":          1,
+					"engine_version": "14.2",
+				})
+
+				plan := ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+					"allow_major_version_upgrade": true,
+					"postgres_version":            "15",
+				}))
+
+				Expect(AfterValuesForType(plan, "aws_db_instance")).To(MatchKeys(IgnoreExtras, Keys{"engine_version": Equal("15")}))
+			})
+		})
+	})
+
 	Context("managed admin password", func() {
 		When("disabled", func() {
 			BeforeAll(func() {
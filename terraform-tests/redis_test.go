@@ -60,6 +60,7 @@ var _ = Describe("Redis", Label("redis-terraform"), Ordered, func() {
 			"logs_engine_log_loggroup_retention_in_days": 0,
 			"logs_engine_log_enabled":                    false,
 			"auto_minor_version_upgrade":                 false,
+			"rbac_enabled":                               false,
 			"port":                                       2345,
 		}
 	})
@@ -408,6 +409,37 @@ var _ = Describe("Redis", Label("redis-terraform"), Ordered, func() {
 		})
 	})
 
+	Context("rbac_enabled is true", func() {
+		BeforeAll(func() {
+			plan = ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+				"rbac_enabled": true,
+			}))
+		})
+
+		It("should create a default user and a user group", func() {
+			Expect(ResourceChangesTypes(plan)).To(ConsistOf(append(getExpectedResources(), "aws_elasticache_user", "aws_elasticache_user_group")))
+
+			Expect(AfterValuesForType(plan, "aws_elasticache_user")).To(MatchKeys(IgnoreExtras, Keys{
+				"user_id":       Equal("csb-redis-test-default"),
+				"user_name":     Equal("default"),
+				"access_string": Equal("on ~* +@all"),
+				"engine":        Equal("redis"),
+			}))
+			Expect(AfterValuesForType(plan, "aws_elasticache_user_group")).To(MatchKeys(IgnoreExtras, Keys{
+				"user_group_id": Equal("csb-redis-test"),
+				"engine":        Equal("redis"),
+				"user_ids":      ConsistOf("csb-redis-test-default"),
+			}))
+		})
+
+		It("should use the user group instead of the auth token", func() {
+			Expect(AfterValuesForType(plan, resource)).To(MatchKeys(IgnoreExtras, Keys{
+				"user_group_ids": ConsistOf("csb-redis-test"),
+				"auth_token":     BeNil(),
+			}))
+		})
+	})
+
 	Context("auto_minor_version_upgrade is true", func() {
 		Context("redis_version ends with .x", func() {
 			JustBeforeEach(func() {
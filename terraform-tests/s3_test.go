@@ -8,6 +8,7 @@ import (
 	tfjson "github.com/hashicorp/terraform-json"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
 	. "github.com/onsi/gomega/gstruct"
 )
 
@@ -22,27 +23,29 @@ var _ = Describe("S3", Label("S3-terraform"), Ordered, func() {
 
 	BeforeEach(func() {
 		defaultVars = map[string]any{
-			"bucket_name":                                bucketName,
-			"region":                                     awsRegion,
-			"acl":                                        "public-read",
-			"enable_versioning":                          true,
-			"boc_object_ownership":                       "BucketOwnerEnforced",
-			"pab_block_public_acls":                      false,
-			"pab_block_public_policy":                    false,
-			"pab_ignore_public_acls":                     false,
-			"pab_restrict_public_buckets":                false,
-			"sse_default_kms_key_id":                     nil,
-			"sse_extra_kms_key_ids":                      nil,
-			"sse_default_algorithm":                      nil,
-			"sse_bucket_key_enabled":                     false,
-			"ol_enabled":                                 false,
+			"bucket_name":                 bucketName,
+			"region":                      awsRegion,
+			"acl":                         "public-read",
+			"enable_versioning":           true,
+			"boc_object_ownership":        "BucketOwnerEnforced",
+			"pab_block_public_acls":       false,
+			"pab_block_public_policy":     false,
+			"pab_ignore_public_acls":      false,
+			"pab_restrict_public_buckets": false,
+			"sse_default_kms_key_id":      nil,
+			"sse_extra_kms_key_ids":       nil,
+			"sse_default_algorithm":       nil,
+			"sse_bucket_key_enabled":      false,
+			"ol_enabled":                  false,
 			"ol_configuration_default_retention_enabled": nil,
 			"ol_configuration_default_retention_mode":    nil,
 			"ol_configuration_default_retention_days":    nil,
 			"ol_configuration_default_retention_years":   nil,
-			"labels":             map[string]any{"k1": "v1"},
-			"require_tls":        false,
-			"allowed_aws_vpc_id": "",
+			"labels":                                 map[string]any{"k1": "v1"},
+			"require_tls":                            false,
+			"allowed_aws_vpc_id":                     "",
+			"access_key_rotation":                    0,
+			"access_key_rotation_grace_period_hours": 24,
 		}
 	})
 
@@ -57,7 +60,7 @@ var _ = Describe("S3", Label("S3-terraform"), Ordered, func() {
 		})
 
 		It("should create the right resources", func() {
-			Expect(plan.ResourceChanges).To(HaveLen(5))
+			Expect(plan.ResourceChanges).To(HaveLen(6))
 
 			Expect(ResourceChangesTypes(plan)).To(ConsistOf(
 				"aws_s3_bucket",
@@ -65,6 +68,7 @@ var _ = Describe("S3", Label("S3-terraform"), Ordered, func() {
 				"aws_s3_bucket_versioning",
 				"aws_s3_bucket_ownership_controls",
 				"aws_s3_bucket_public_access_block",
+				"terraform_data",
 			))
 		})
 
@@ -128,7 +132,7 @@ var _ = Describe("S3", Label("S3-terraform"), Ordered, func() {
 		})
 
 		It("should create an aws_s3_bucket_policy", func() {
-			Expect(plan.ResourceChanges).To(HaveLen(6))
+			Expect(plan.ResourceChanges).To(HaveLen(7))
 
 			Expect(ResourceChangesTypes(plan)).To(ConsistOf(
 				"aws_s3_bucket",
@@ -137,7 +141,88 @@ var _ = Describe("S3", Label("S3-terraform"), Ordered, func() {
 				"aws_s3_bucket_ownership_controls",
 				"aws_s3_bucket_public_access_block",
 				"aws_s3_bucket_policy",
+				"terraform_data",
 			))
 		})
 	})
 })
+
+var _ = Describe("S3 binding", Label("S3-terraform"), Ordered, func() {
+	const bucketARN = "arn:aws:s3:::csb-s3-test"
+
+	var (
+		terraformBindDir string
+		defaultVars      map[string]any
+	)
+
+	BeforeAll(func() {
+		terraformBindDir = path.Join(workingDir, "s3/bind")
+		Init(terraformBindDir)
+	})
+
+	BeforeEach(func() {
+		defaultVars = map[string]any{
+			"region":                awsRegion,
+			"arn":                   bucketARN,
+			"user_name":             "fake-user-name",
+			"sse_all_kms_key_ids":   "",
+			"allowed_aws_vpc_id":    "",
+			"credential_type":       "access_key",
+			"trusted_principal_arn": "",
+			"instance_details":      "{}",
+			"permissions":           "read_write",
+			"key_prefix":            "",
+		}
+	})
+
+	userPolicy := func(overrides map[string]any) string {
+		plan := ShowPlan(terraformBindDir, buildVars(defaultVars, overrides))
+		values, ok := AfterValuesForType(plan, "aws_iam_user_policy").(map[string]any)
+		Expect(ok).To(BeTrue(), "the plan should contain an aws_iam_user_policy")
+		return values["policy"].(string)
+	}
+
+	It("should grant full access to the bucket by default", func() {
+		Expect(userPolicy(map[string]any{})).To(SatisfyAll(
+			ContainSubstring(`"s3:PutBucketCORS"`),
+			ContainSubstring(`"s3:GetObject"`),
+			ContainSubstring(`"s3:PutObject"`),
+			ContainSubstring(`"s3:DeleteObject"`),
+		))
+	})
+
+	It("should only grant read access for read_only", func() {
+		Expect(userPolicy(map[string]any{"permissions": "read_only"})).To(SatisfyAll(
+			ContainSubstring(`"s3:ListBucket"`),
+			ContainSubstring(`"s3:GetObject"`),
+			Not(ContainSubstring(`"s3:PutObject"`)),
+			Not(ContainSubstring(`"s3:DeleteObject"`)),
+			Not(ContainSubstring(`"s3:PutBucketCORS"`)),
+		))
+	})
+
+	It("should only grant upload access for write_only", func() {
+		Expect(userPolicy(map[string]any{"permissions": "write_only"})).To(SatisfyAll(
+			ContainSubstring(`"s3:PutObject"`),
+			Not(ContainSubstring(`"s3:ListBucket"`)),
+			Not(ContainSubstring(`"s3:GetObject"`)),
+			Not(ContainSubstring(`"s3:DeleteObject"`)),
+		))
+	})
+
+	It("should scope access to the key prefix for custom_prefix", func() {
+		Expect(userPolicy(map[string]any{"permissions": "custom_prefix", "key_prefix": "reports/"})).To(SatisfyAll(
+			ContainSubstring(`"arn:aws:s3:::csb-s3-test/reports/*"`),
+			ContainSubstring(`"s3:prefix"`),
+			ContainSubstring(`"reports/*"`),
+			Not(ContainSubstring(`"arn:aws:s3:::csb-s3-test/*"`)),
+		))
+	})
+
+	It("should fail for custom_prefix without a key prefix", func() {
+		session, _ := FailPlan(terraformBindDir, buildVars(defaultVars, map[string]any{"permissions": "custom_prefix"}))
+
+		Expect(session.ExitCode()).NotTo(Equal(0))
+		Expect(session).To(gbytes.Say("A key_prefix must be specified"))
+	})
+})
@@ -0,0 +1,182 @@
+package terraformtests
+
+import (
+	"fmt"
+	"path"
+	"time"
+
+	. "csbbrokerpakaws/terraform-tests/helpers"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("SNS", Label("SNS-terraform"), Ordered, func() {
+	var (
+		name                  string
+		plan                  tfjson.Plan
+		terraformProvisionDir string
+		defaultVars           map[string]any
+	)
+
+	BeforeAll(func() {
+		name = fmt.Sprintf("csb-tf-test-sns-%d-%d", GinkgoRandomSeed(), time.Now().Unix())
+
+		terraformProvisionDir = path.Join(workingDir, "sns/provision")
+		Init(terraformProvisionDir)
+	})
+
+	BeforeEach(func() {
+		defaultVars = map[string]any{
+			"instance_name":               name,
+			"labels":                      map[string]string{"label1": "value1"},
+			"region":                      awsRegion,
+			"fifo":                        false,
+			"content_based_deduplication": false,
+			"kms_master_key_id":           "",
+			"delivery_policy":             "",
+		}
+	})
+
+	Context("with default values", func() {
+		BeforeAll(func() {
+			plan = ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{}))
+		})
+
+		It("should create the right resources", func() {
+			Expect(plan.ResourceChanges).To(HaveLen(1))
+
+			Expect(ResourceChangesTypes(plan)).To(ConsistOf("aws_sns_topic"))
+		})
+
+		It("should create an SNS topic with the correct properties", func() {
+			Expect(AfterValuesForType(plan, "aws_sns_topic")).To(
+				MatchKeys(IgnoreExtras, Keys{
+					"name":       Equal(name),
+					"fifo_topic": BeFalse(),
+					"tags_all": MatchAllKeys(Keys{
+						"label1": Equal("value1"),
+					}),
+				}),
+			)
+
+			Expect(AfterValuesForType(plan, "aws_sns_topic")).NotTo(SatisfyAny(
+				HaveKey("kms_master_key_id"),
+				HaveKey("delivery_policy"),
+			))
+		})
+	})
+
+	Context("FIFO topics", func() {
+		BeforeAll(func() {
+			plan = ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+				"fifo":                        true,
+				"content_based_deduplication": true,
+			}))
+		})
+
+		It("should create an SNS FIFO topic with the correct properties", func() {
+			Expect(AfterValuesForType(plan, "aws_sns_topic")).To(
+				MatchKeys(IgnoreExtras, Keys{
+					"name":                        Equal(fmt.Sprintf("%s.fifo", name)),
+					"fifo_topic":                  BeTrue(),
+					"content_based_deduplication": BeTrue(),
+				}),
+			)
+		})
+	})
+
+	Context("with KMS master key specified", func() {
+		BeforeAll(func() {
+			plan = ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+				"kms_master_key_id": "alias/aws/sns",
+			}))
+		})
+
+		It("should use the specified KMS master key for encryption", func() {
+			Expect(AfterValuesForType(plan, "aws_sns_topic")).To(
+				MatchKeys(IgnoreExtras, Keys{
+					"kms_master_key_id": Equal("alias/aws/sns"),
+				}),
+			)
+		})
+	})
+
+	Context("with delivery policy specified", func() {
+		const deliveryPolicy = `{"http":{"defaultHealthyRetryPolicy":{"minDelayTarget":20,"maxDelayTarget":20,"numRetries":3}}}`
+
+		BeforeAll(func() {
+			plan = ShowPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{
+				"delivery_policy": deliveryPolicy,
+			}))
+		})
+
+		It("should set the delivery policy of the topic", func() {
+			Expect(AfterValuesForType(plan, "aws_sns_topic")).To(
+				MatchKeys(IgnoreExtras, Keys{
+					"delivery_policy": MatchJSON(deliveryPolicy),
+				}),
+			)
+		})
+
+		It("should reject a delivery policy that is not JSON", func() {
+			session, _ := FailPlan(terraformProvisionDir, buildVars(defaultVars, map[string]any{"delivery_policy": "retry"}))
+
+			Expect(session.ExitCode()).NotTo(Equal(0))
+			Expect(session).To(gbytes.Say("The delivery_policy must be a JSON document"))
+		})
+	})
+})
+
+var _ = Describe("SNS binding", Label("SNS-terraform"), Ordered, func() {
+	const topicARN = "arn:aws:sns:us-west-2:123456789012:topic"
+
+	var (
+		terraformBindDir string
+		defaultVars      map[string]any
+	)
+
+	BeforeAll(func() {
+		terraformBindDir = path.Join(workingDir, "sns/bind")
+		Init(terraformBindDir)
+	})
+
+	BeforeEach(func() {
+		defaultVars = map[string]any{
+			"region":                 awsRegion,
+			"arn":                    topicARN,
+			"user_name":              "fake-user-name",
+			"kms_master_key_id":      "",
+			"subscription_queue_arn": "",
+			"raw_message_delivery":   false,
+		}
+	})
+
+	It("should only create publish credentials by default", func() {
+		plan := ShowPlan(terraformBindDir, buildVars(defaultVars, map[string]any{}))
+
+		Expect(ResourceChangesTypes(plan)).To(ConsistOf(
+			"aws_iam_user",
+			"aws_iam_access_key",
+			"aws_iam_user_policy",
+		))
+
+		values, ok := AfterValuesForType(plan, "aws_iam_user_policy").(map[string]any)
+		Expect(ok).To(BeTrue(), "the plan should contain an aws_iam_user_policy")
+		Expect(values["policy"]).To(SatisfyAll(
+			ContainSubstring(`"sns:Publish"`),
+			ContainSubstring(topicARN),
+			Not(ContainSubstring(`"kms:`)),
+		))
+	})
+
+	It("should reject a subscription ARN that is not an SQS queue", func() {
+		session, _ := FailPlan(terraformBindDir, buildVars(defaultVars, map[string]any{"subscription_queue_arn": topicARN}))
+
+		Expect(session.ExitCode()).NotTo(Equal(0))
+		Expect(session).To(gbytes.Say("The subscription_queue_arn must be the ARN of an SQS queue"))
+	})
+})
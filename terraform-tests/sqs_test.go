@@ -30,24 +30,26 @@ var _ = Describe("SQS", Label("SQS-terraform"), Ordered, func() {
 
 	BeforeEach(func() {
 		defaultVars = map[string]any{
-			"instance_name":                     name,
-			"fifo":                              false,
-			"visibility_timeout_seconds":        30,
-			"message_retention_seconds":         345600,
-			"max_message_size":                  262144,
-			"delay_seconds":                     0,
-			"receive_wait_time_seconds":         0,
-			"labels":                            map[string]string{"label1": "value1"},
-			"region":                            awsRegion,
-			"dlq_arn":                           "",
-			"max_receive_count":                 5,
-			"deduplication_scope":               nil,
-			"fifo_throughput_limit":             nil,
-			"content_based_deduplication":       false,
-			"sqs_managed_sse_enabled":           true,
-			"kms_master_key_id":                 "",
-			"kms_extra_key_ids":                 "",
-			"kms_data_key_reuse_period_seconds": 300,
+			"instance_name":                          name,
+			"fifo":                                   false,
+			"visibility_timeout_seconds":             30,
+			"message_retention_seconds":              345600,
+			"max_message_size":                       262144,
+			"delay_seconds":                          0,
+			"receive_wait_time_seconds":              0,
+			"labels":                                 map[string]string{"label1": "value1"},
+			"region":                                 awsRegion,
+			"dlq_arn":                                "",
+			"max_receive_count":                      5,
+			"deduplication_scope":                    nil,
+			"fifo_throughput_limit":                  nil,
+			"content_based_deduplication":            false,
+			"sqs_managed_sse_enabled":                true,
+			"kms_master_key_id":                      "",
+			"kms_extra_key_ids":                      "",
+			"kms_data_key_reuse_period_seconds":      300,
+			"access_key_rotation":                    0,
+			"access_key_rotation_grace_period_hours": 24,
 		}
 	})
 
@@ -57,10 +59,11 @@ var _ = Describe("SQS", Label("SQS-terraform"), Ordered, func() {
 		})
 
 		It("should create the right resources", func() {
-			Expect(plan.ResourceChanges).To(HaveLen(1))
+			Expect(plan.ResourceChanges).To(HaveLen(2))
 
 			Expect(ResourceChangesTypes(plan)).To(ConsistOf(
 				"aws_sqs_queue",
+				"terraform_data",
 			))
 		})
 
@@ -268,3 +271,72 @@ var _ = Describe("SQS", Label("SQS-terraform"), Ordered, func() {
 		})
 	})
 })
+
+var _ = Describe("SQS binding", Label("SQS-terraform"), Ordered, func() {
+	const (
+		queueARN = "arn:aws:sqs:us-west-2:123456789012:queue"
+		dlqARN   = "arn:aws:sqs:us-west-2:123456789012:dlq"
+	)
+
+	var (
+		terraformBindDir string
+		defaultVars      map[string]any
+	)
+
+	BeforeAll(func() {
+		terraformBindDir = path.Join(workingDir, "sqs/bind")
+		Init(terraformBindDir)
+	})
+
+	BeforeEach(func() {
+		defaultVars = map[string]any{
+			"region":                awsRegion,
+			"arn":                   queueARN,
+			"user_name":             "fake-user-name",
+			"dlq_arn":               dlqARN,
+			"kms_all_key_ids":       "",
+			"credential_type":       "access_key",
+			"trusted_principal_arn": "",
+			"instance_details":      "{}",
+			"role":                  "admin",
+		}
+	})
+
+	userPolicy := func(overrides map[string]any) string {
+		plan := ShowPlan(terraformBindDir, buildVars(defaultVars, overrides))
+		values, ok := AfterValuesForType(plan, "aws_iam_user_policy").(map[string]any)
+		Expect(ok).To(BeTrue(), "the plan should contain an aws_iam_user_policy")
+		return values["policy"].(string)
+	}
+
+	It("should grant full access and redrive for admin", func() {
+		Expect(userPolicy(map[string]any{})).To(SatisfyAll(
+			ContainSubstring(`"sqs:SendMessage"`),
+			ContainSubstring(`"sqs:ReceiveMessage"`),
+			ContainSubstring(`"sqs:PurgeQueue"`),
+			ContainSubstring(`"sqs:StartMessageMoveTask"`),
+		))
+	})
+
+	It("should only allow sending for producer", func() {
+		Expect(userPolicy(map[string]any{"role": "producer"})).To(SatisfyAll(
+			ContainSubstring(`"sqs:SendMessage"`),
+			Not(ContainSubstring(`"sqs:ReceiveMessage"`)),
+			Not(ContainSubstring(`"sqs:DeleteMessage"`)),
+			Not(ContainSubstring(`"sqs:ChangeMessageVisibility"`)),
+			Not(ContainSubstring(`"sqs:PurgeQueue"`)),
+			Not(ContainSubstring(dlqARN)),
+		))
+	})
+
+	It("should only allow receiving and deleting for consumer", func() {
+		Expect(userPolicy(map[string]any{"role": "consumer"})).To(SatisfyAll(
+			ContainSubstring(`"sqs:ReceiveMessage"`),
+			ContainSubstring(`"sqs:DeleteMessage"`),
+			ContainSubstring(`"sqs:ChangeMessageVisibility"`),
+			Not(ContainSubstring(`"sqs:SendMessage"`)),
+			Not(ContainSubstring(`"sqs:PurgeQueue"`)),
+			Not(ContainSubstring(dlqARN)),
+		))
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -38,6 +39,12 @@ var _ = BeforeSuite(func() {
 	awsAccessKeyID = getenv("AWS_ACCESS_KEY_ID")
 	awsVPCID = getenv("AWS_PAS_VPC_ID")
 	awsRegion = getAWSRegion()
+
+	// The csbmajorengineversion provider reads the versions that the tests reference from a fixture rather than calling RDS
+	catalog, err := filepath.Abs(filepath.Join("testdata", "engine_versions.json"))
+	Expect(err).NotTo(HaveOccurred())
+	GinkgoT().Setenv("CSB_ENGINE_VERSION_CATALOG", catalog)
+	GinkgoT().Setenv("CSB_ENGINE_VERSION_CATALOG_MODE", "offline")
 })
 
 func buildVars(varOverrides ...map[string]any) map[string]any {
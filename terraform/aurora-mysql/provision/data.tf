@@ -49,4 +49,31 @@ data "csbmajorengineversion" "major_version_checker" {
       error_message = "A Major engine version should be specified when auto_minor_version_upgrade is enabled. Expected engine version: ${self.major_version} - got: ${var.engine_version}"
     }
   }
-}
\ No newline at end of file
+}
+
+data "aws_rds_clusters" "provisioned" {
+  filter {
+    name   = "db-cluster-id"
+    values = [var.instance_name]
+  }
+}
+
+data "aws_rds_cluster" "provisioned" {
+  count              = local.engine_version_is_defined ? length(data.aws_rds_clusters.provisioned.cluster_identifiers) : 0
+  cluster_identifier = var.instance_name
+}
+
+data "csbmajorengineversion_upgrade_targets" "upgrade_checker" {
+  count          = length(data.aws_rds_cluster.provisioned)
+  engine_version = data.aws_rds_cluster.provisioned[0].engine_version
+
+  lifecycle {
+    postcondition {
+      condition = (
+        self.engine_version == var.engine_version || startswith(self.engine_version, "${var.engine_version}.") ||
+        anytrue([for target in self.upgrade_targets : (target.engine_version == var.engine_version || startswith(target.engine_version, "${var.engine_version}.")) && (var.allow_major_version_upgrade || !target.is_major_version_upgrade)])
+      )
+      error_message = "Engine version ${self.engine_version} cannot be upgraded to ${var.engine_version}. Valid upgrade targets are: ${join(", ", self.upgrade_targets[*].engine_version)}. Major version upgrades also require allow_major_version_upgrade."
+    }
+  }
+}
@@ -0,0 +1,9 @@
+locals {
+  hostname = var.reader_endpoint ? var.reader_hostname : var.hostname
+  # The users of a DocumentDB cluster are all defined in the admin database, and retryable writes are not supported
+  uri_options = format(
+    "tls=%t&replicaSet=rs0&authSource=admin&retryWrites=false%s",
+    var.require_tls,
+    var.reader_endpoint ? "&readPreference=secondaryPreferred" : "",
+  )
+}
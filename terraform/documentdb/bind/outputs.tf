@@ -0,0 +1,22 @@
+output "username" { value = csbdocumentdb_binding_user.new_user.username }
+output "password" {
+  value     = csbdocumentdb_binding_user.new_user.password
+  sensitive = true
+}
+output "name" { value = var.name }
+output "hostname" { value = local.hostname }
+output "port" { value = var.port }
+output "uri" {
+  value = format(
+    "mongodb://%s:%s@%s:%d/%s?%s",
+    csbdocumentdb_binding_user.new_user.username,
+    csbdocumentdb_binding_user.new_user.password,
+    local.hostname,
+    var.port,
+    var.name,
+    local.uri_options,
+  )
+  sensitive = true
+}
+output "tls_ca_bundle_url" { value = var.require_tls ? var.tls_ca_bundle_url : "" }
+output "read_only" { value = var.read_only }
@@ -0,0 +1,7 @@
+provider "csbdocumentdb" {
+  address           = format("%s:%d", var.hostname, var.port)
+  admin_username    = var.admin_username
+  admin_password    = var.admin_password
+  tls               = var.require_tls
+  tls_ca_bundle_url = var.tls_ca_bundle_url
+}
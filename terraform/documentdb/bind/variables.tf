@@ -0,0 +1,14 @@
+
+variable "name" { type = string }
+variable "reader_endpoint" { type = bool }
+variable "read_only" { type = bool }
+variable "hostname" { type = string }
+variable "reader_hostname" { type = string }
+variable "admin_username" { type = string }
+variable "admin_password" {
+  type      = string
+  sensitive = true
+}
+variable "port" { type = number }
+variable "require_tls" { type = bool }
+variable "tls_ca_bundle_url" { type = string }
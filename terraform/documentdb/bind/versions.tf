@@ -0,0 +1,12 @@
+terraform {
+  required_providers {
+    csbdocumentdb = {
+      source  = "cloudfoundry.org/cloud-service-broker/csbdocumentdb"
+      version = "1.0.0"
+    }
+    random = {
+      source  = "registry.terraform.io/hashicorp/random"
+      version = "~> 3"
+    }
+  }
+}
@@ -0,0 +1,31 @@
+data "aws_vpc" "vpc" {
+  default = length(var.aws_vpc_id) == 0
+  id      = length(var.aws_vpc_id) == 0 ? null : var.aws_vpc_id
+}
+
+data "aws_subnets" "all" {
+  filter {
+    name   = "vpc-id"
+    values = [data.aws_vpc.vpc.id]
+  }
+}
+
+locals {
+  engine = "docdb"
+  # DocumentDB cluster parameter group families are named after the first two components of the version, e.g. docdb5.0
+  major_version = join(".", slice(split(".", data.csbmajorengineversion.version_checker.resolved_version), 0, 2))
+
+  docdb_vpc_security_group_ids = length(var.docdb_vpc_security_group_ids) == 0 ? [aws_security_group.docdb_sg[0].id] : split(",", var.docdb_vpc_security_group_ids)
+  subnet_group                 = length(var.docdb_subnet_group) > 0 ? var.docdb_subnet_group : aws_docdb_subnet_group.docdb_private_subnet[0].name
+}
+
+data "csbmajorengineversion" "version_checker" {
+  engine_version = var.engine_version
+
+  lifecycle {
+    postcondition {
+      condition     = self.resolved_version == var.engine_version
+      error_message = "An exact DocumentDB engine version should be specified. Expected engine version: ${self.resolved_version} - got: ${var.engine_version}"
+    }
+  }
+}
@@ -0,0 +1,95 @@
+resource "aws_docdb_subnet_group" "docdb_private_subnet" {
+  count      = length(var.docdb_subnet_group) == 0 ? 1 : 0
+  name       = format("%s-p-sn", var.instance_name)
+  subnet_ids = data.aws_subnets.all.ids
+}
+
+resource "aws_security_group" "docdb_sg" {
+  count  = length(var.docdb_vpc_security_group_ids) == 0 ? 1 : 0
+  name   = format("%s-sg", var.instance_name)
+  vpc_id = data.aws_vpc.vpc.id
+}
+
+resource "aws_security_group_rule" "docdb_inbound_access" {
+  count             = length(var.docdb_vpc_security_group_ids) == 0 ? 1 : 0
+  protocol          = "tcp"
+  security_group_id = aws_security_group.docdb_sg[0].id
+  from_port         = var.port
+  to_port           = var.port
+  type              = "ingress"
+  cidr_blocks       = ["0.0.0.0/0"]
+}
+
+resource "random_string" "username" {
+  length  = 16
+  special = false
+  numeric = false
+}
+
+resource "random_password" "password" {
+  length = 64
+  // https://docs.aws.amazon.com/documentdb/latest/developerguide/limits.html#limits-naming_constraints
+  special = false
+}
+
+resource "aws_docdb_cluster" "cluster" {
+  cluster_identifier              = var.instance_name
+  engine                          = local.engine
+  engine_version                  = var.engine_version
+  tags                            = var.labels
+  master_username                 = random_string.username.result
+  master_password                 = random_password.password.result
+  port                            = var.port
+  db_subnet_group_name            = local.subnet_group
+  vpc_security_group_ids          = local.docdb_vpc_security_group_ids
+  skip_final_snapshot             = true
+  allow_major_version_upgrade     = var.allow_major_version_upgrade
+  backup_retention_period         = var.backup_retention_period
+  preferred_backup_window         = var.preferred_backup_window
+  db_cluster_parameter_group_name = length(var.db_cluster_parameter_group_name) == 0 ? aws_docdb_cluster_parameter_group.cluster_parameter_group[0].name : var.db_cluster_parameter_group_name
+  deletion_protection             = var.deletion_protection
+  storage_encrypted               = var.storage_encrypted
+  kms_key_id                      = var.kms_key_id == "" ? null : var.kms_key_id
+  apply_immediately               = true
+
+  lifecycle {
+    prevent_destroy = true
+
+    precondition {
+      condition     = var.kms_key_id == "" || var.storage_encrypted
+      error_message = "When specifying kms_key_id, storage_encrypted needs to be set to true."
+    }
+  }
+}
+
+resource "aws_docdb_cluster_instance" "cluster_instances" {
+  count                      = var.cluster_instances
+  identifier                 = "${var.instance_name}-${count.index}"
+  cluster_identifier         = aws_docdb_cluster.cluster.id
+  tags                       = var.labels
+  instance_class             = var.instance_class
+  engine                     = aws_docdb_cluster.cluster.engine
+  auto_minor_version_upgrade = var.auto_minor_version_upgrade
+  apply_immediately          = true
+
+  lifecycle {
+    prevent_destroy = true
+  }
+}
+
+resource "aws_docdb_cluster_parameter_group" "cluster_parameter_group" {
+  count  = length(var.db_cluster_parameter_group_name) == 0 ? 1 : 0
+  family = format("docdb%s", local.major_version)
+  # Must not match the name of an existing DB cluster parameter group.
+  name_prefix = format("docdb-%s", var.instance_name)
+
+  parameter {
+    name         = "tls"
+    value        = var.require_tls ? "enabled" : "disabled"
+    apply_method = "pending-reboot"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
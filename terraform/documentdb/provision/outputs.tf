@@ -0,0 +1,21 @@
+output "name" { value = var.db_name }
+output "hostname" { value = aws_docdb_cluster.cluster.endpoint }
+output "reader_hostname" { value = aws_docdb_cluster.cluster.reader_endpoint }
+output "port" { value = var.port }
+output "username" { value = aws_docdb_cluster.cluster.master_username }
+output "password" {
+  value     = aws_docdb_cluster.cluster.master_password
+  sensitive = true
+}
+output "require_tls" { value = var.require_tls }
+output "region" {
+  value = var.region
+}
+output "status" {
+  value = format(
+    "created cluster %s (version: %s) on server %s",
+    aws_docdb_cluster.cluster.cluster_identifier,
+    aws_docdb_cluster.cluster.engine_version,
+    aws_docdb_cluster.cluster.endpoint,
+  )
+}
@@ -0,0 +1,8 @@
+provider "aws" {
+  region = var.region
+}
+
+provider "csbmajorengineversion" {
+  region = var.region
+  engine = local.engine
+}
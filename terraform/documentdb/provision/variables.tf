@@ -0,0 +1,21 @@
+
+variable "region" { type = string }
+variable "instance_name" { type = string }
+variable "db_name" { type = string }
+variable "port" { type = number }
+variable "labels" { type = map(any) }
+variable "aws_vpc_id" { type = string }
+variable "cluster_instances" { type = number }
+variable "engine_version" { type = string }
+variable "docdb_subnet_group" { type = string }
+variable "docdb_vpc_security_group_ids" { type = string }
+variable "allow_major_version_upgrade" { type = bool }
+variable "auto_minor_version_upgrade" { type = bool }
+variable "backup_retention_period" { type = number }
+variable "preferred_backup_window" { type = string }
+variable "require_tls" { type = bool }
+variable "db_cluster_parameter_group_name" { type = string }
+variable "deletion_protection" { type = bool }
+variable "storage_encrypted" { type = bool }
+variable "kms_key_id" { type = string }
+variable "instance_class" { type = string }
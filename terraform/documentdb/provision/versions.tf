@@ -0,0 +1,16 @@
+terraform {
+  required_providers {
+    aws = {
+      source  = "registry.terraform.io/hashicorp/aws"
+      version = "~> 6"
+    }
+    random = {
+      source  = "registry.terraform.io/hashicorp/random"
+      version = "~> 3"
+    }
+    csbmajorengineversion = {
+      source  = "cloudfoundry.org/cloud-service-broker/csbmajorengineversion"
+      version = "1.0.0"
+    }
+  }
+}
\ No newline at end of file
@@ -1,2 +1,20 @@
 data "aws_partition" "current" {}
-data "aws_caller_identity" "current" {}
\ No newline at end of file
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_policy_document" "trust_policy" {
+  count = local.use_role ? 1 : 0
+
+  statement {
+    sid     = "AssumeBindingRole"
+    actions = ["sts:AssumeRole"]
+    principals {
+      type        = "AWS"
+      identifiers = [var.trusted_principal_arn]
+    }
+    condition {
+      test     = "StringEquals"
+      variable = "sts:ExternalId"
+      values   = [random_password.external_id[0].result]
+    }
+  }
+}
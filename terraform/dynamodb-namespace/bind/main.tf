@@ -1,32 +1,137 @@
-resource "aws_iam_user" "binding_user" {
-  name = var.user_name
-}
+locals {
+  use_role = var.credential_type == "iam_role"
 
-resource "aws_iam_access_key" "binding_user_key" {
-  user = aws_iam_user.binding_user.name
-}
+  # The previous access key is only kept for users that had one, during the grace period of the rotation
+  instance_details         = jsondecode(var.instance_details)
+  access_key_rotation      = try(local.instance_details.access_key_rotation, 0)
+  user_access_key_rotation = local.use_role ? 0 : try(tonumber(aws_iam_user.binding_user[0].tags["access_key_rotation"]), 0)
+  keep_previous_access_key = local.user_access_key_rotation < local.access_key_rotation && try(timecmp(plantimestamp(), local.instance_details.access_key_rotation_grace_period_ends_at) < 0, false)
+  access_key_rotations = local.use_role ? [] : compact([
+    tostring(local.access_key_rotation),
+    local.keep_previous_access_key ? tostring(local.access_key_rotation - 1) : "",
+  ])
 
-resource "aws_iam_user_policy" "binding_policy" {
-  user = aws_iam_user.binding_user.name
-  policy = jsonencode({
+  read_only_actions = [
+    "dynamodb:BatchGetItem",
+    "dynamodb:ConditionCheckItem",
+    "dynamodb:DescribeTable",
+    "dynamodb:DescribeTimeToLive",
+    "dynamodb:GetItem",
+    "dynamodb:ListTagsOfResource",
+    "dynamodb:PartiQLSelect",
+    "dynamodb:Query",
+    "dynamodb:Scan",
+  ]
+
+  read_write_actions = concat(local.read_only_actions, [
+    "dynamodb:BatchWriteItem",
+    "dynamodb:DeleteItem",
+    "dynamodb:PartiQLDelete",
+    "dynamodb:PartiQLInsert",
+    "dynamodb:PartiQLUpdate",
+    "dynamodb:PutItem",
+    "dynamodb:UpdateItem",
+  ])
+
+  # Only admin bindings may create and delete tables
+  access_actions = {
+    read_only  = local.read_only_actions
+    read_write = local.read_write_actions
+    admin      = ["dynamodb:*"]
+  }
+
+  table_arn_prefix = format("arn:%s:dynamodb:%s:%s:table/%s",
+    data.aws_partition.current.partition,
+    var.region,
+    data.aws_caller_identity.current.account_id,
+    var.prefix
+  )
+
+  # Without table suffixes, the binding can access every table in the namespace. Otherwise it is
+  # restricted to the listed tables, and to their indexes and streams.
+  table_resources = length(var.table_suffixes) == 0 ? [format("%s*", local.table_arn_prefix)] : flatten([
+    for suffix in var.table_suffixes : [
+      format("%s%s", local.table_arn_prefix, suffix),
+      format("%s%s/*", local.table_arn_prefix, suffix),
+    ]
+  ])
+
+  binding_policy = jsonencode({
     "Version" = "2012-10-17",
     "Statement" = [
       {
-        "Sid"    = "PrefixFullAccess",
-        "Effect" = "Allow",
-        "Action" = [
-          "dynamodb:*"
-        ],
+        "Sid"       = "PrefixAccess",
+        "Effect"    = "Allow",
+        "Action"    = local.access_actions[var.access],
         "Condition" = {},
-        "Resource" = [
-          format("arn:%s:dynamodb:%s:%s:table/%s*",
-            data.aws_partition.current.partition,
-            var.region,
-            data.aws_caller_identity.current.account_id,
-            var.prefix
-          )
-        ]
+        "Resource"  = local.table_resources
       }
     ]
   })
-}
\ No newline at end of file
+}
+
+resource "aws_iam_user" "binding_user" {
+  count = local.use_role ? 0 : 1
+  name  = var.user_name
+  tags  = { access_key_rotation = local.access_key_rotation }
+
+  // The tag keeps the rotation that the user was created for
+  lifecycle {
+    ignore_changes = [tags["access_key_rotation"]]
+  }
+}
+
+resource "aws_iam_access_key" "binding_user_key" {
+  for_each = toset(local.access_key_rotations)
+  user     = aws_iam_user.binding_user[0].name
+}
+
+resource "aws_iam_user_policy" "binding_policy" {
+  count  = local.use_role ? 0 : 1
+  user   = aws_iam_user.binding_user[0].name
+  policy = local.binding_policy
+}
+
+// Secret that the trusted principal must present to assume the role. Since any app can run as the
+// trusted principal, this is what keeps the tables of the namespace to the apps holding this binding
+resource "random_password" "external_id" {
+  count   = local.use_role ? 1 : 0
+  length  = 32
+  special = false
+}
+
+resource "aws_iam_role" "role" {
+  count              = local.use_role ? 1 : 0
+  name               = var.user_name
+  path               = "/cf/"
+  assume_role_policy = data.aws_iam_policy_document.trust_policy[0].json
+
+  lifecycle {
+    precondition {
+      condition     = var.trusted_principal_arn != ""
+      error_message = "The broker must be configured with a trusted principal to create bindings with credential_type \"iam_role\"."
+    }
+  }
+}
+
+resource "aws_iam_role_policy" "role_policy" {
+  count = local.use_role ? 1 : 0
+  role  = aws_iam_role.role[0].id
+
+  policy = local.binding_policy
+}
+
+moved {
+  from = aws_iam_user.binding_user
+  to   = aws_iam_user.binding_user[0]
+}
+
+moved {
+  from = aws_iam_access_key.binding_user_key
+  to   = aws_iam_access_key.binding_user_key["0"]
+}
+
+moved {
+  from = aws_iam_user_policy.binding_policy
+  to   = aws_iam_user_policy.binding_policy[0]
+}
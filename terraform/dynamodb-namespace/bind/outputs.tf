@@ -1,5 +1,16 @@
-output "access_key_id" { value = aws_iam_access_key.binding_user_key.id }
+output "access_key_id" { value = local.use_role ? "" : aws_iam_access_key.binding_user_key[tostring(local.access_key_rotation)].id }
 output "secret_access_key" {
-  value     = aws_iam_access_key.binding_user_key.secret
+  value     = local.use_role ? "" : aws_iam_access_key.binding_user_key[tostring(local.access_key_rotation)].secret
   sensitive = true
-}
\ No newline at end of file
+}
+output "role_arn" { value = local.use_role ? aws_iam_role.role[0].arn : "" }
+output "external_id" {
+  value     = local.use_role ? random_password.external_id[0].result : ""
+  sensitive = true
+}
+output "trust_policy" {
+  value     = local.use_role ? data.aws_iam_policy_document.trust_policy[0].json : ""
+  sensitive = true
+}
+output "access" { value = var.access }
+output "table_names" { value = [for suffix in var.table_suffixes : format("%s%s", var.prefix, suffix)] }
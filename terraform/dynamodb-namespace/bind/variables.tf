@@ -1,3 +1,8 @@
 variable "region" { type = string }
 variable "user_name" { type = string }
 variable "prefix" { type = string }
+variable "credential_type" { type = string }
+variable "trusted_principal_arn" { type = string }
+variable "instance_details" { type = string }
+variable "access" { type = string }
+variable "table_suffixes" { type = list(string) }
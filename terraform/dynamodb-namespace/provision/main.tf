@@ -45,6 +45,17 @@ resource "aws_iam_access_key" "housekeeping_user_key" {
 }
 
 resource "csbdynamodbns_instance" "housekeeping" {
-  access_key_id     = aws_iam_access_key.housekeeping_user_key.id
-  secret_access_key = aws_iam_access_key.housekeeping_user_key.secret
-}
\ No newline at end of file
+  access_key_id        = aws_iam_access_key.housekeeping_user_key.id
+  secret_access_key    = aws_iam_access_key.housekeeping_user_key.secret
+  backup_before_delete = var.backup_before_delete
+}
+
+# End of the grace period of the latest access key rotation, which bindings compare against
+resource "terraform_data" "access_key_rotation" {
+  triggers_replace = [var.access_key_rotation]
+  input            = timeadd(plantimestamp(), format("%dh", var.access_key_rotation_grace_period_hours))
+
+  lifecycle {
+    ignore_changes = [input]
+  }
+}
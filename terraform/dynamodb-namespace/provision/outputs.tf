@@ -13,4 +13,6 @@
 # limitations under the License.
 
 output "region" { value = var.region }
-output "prefix" { value = var.prefix }
\ No newline at end of file
+output "prefix" { value = var.prefix }
+output "access_key_rotation" { value = var.access_key_rotation }
+output "access_key_rotation_grace_period_ends_at" { value = terraform_data.access_key_rotation.output }
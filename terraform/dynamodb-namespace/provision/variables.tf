@@ -14,3 +14,6 @@
 
 variable "prefix" { type = string }
 variable "region" { type = string }
+variable "backup_before_delete" { type = bool }
+variable "access_key_rotation" { type = number }
+variable "access_key_rotation_grace_period_hours" { type = number }
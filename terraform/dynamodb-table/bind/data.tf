@@ -0,0 +1,82 @@
+locals {
+  read_only_actions = [
+    "dynamodb:BatchGetItem",
+    "dynamodb:ConditionCheckItem",
+    "dynamodb:DescribeTable",
+    "dynamodb:DescribeTimeToLive",
+    "dynamodb:GetItem",
+    "dynamodb:ListTagsOfResource",
+    "dynamodb:PartiQLSelect",
+    "dynamodb:Query",
+    "dynamodb:Scan",
+  ]
+
+  read_write_actions = concat(local.read_only_actions, [
+    "dynamodb:BatchWriteItem",
+    "dynamodb:DeleteItem",
+    "dynamodb:PartiQLDelete",
+    "dynamodb:PartiQLInsert",
+    "dynamodb:PartiQLUpdate",
+    "dynamodb:PutItem",
+    "dynamodb:UpdateItem",
+  ])
+
+  access_actions = {
+    read_only  = local.read_only_actions
+    read_write = local.read_write_actions
+  }
+
+  # Bindings never get table management actions, so the schema stays as provisioned
+  table_statement = {
+    sid : "tableAccess",
+    actions : local.access_actions[var.access],
+    resources : [var.arn, format("%s/index/*", var.arn)]
+  }
+
+  stream_statement = {
+    sid : "streamAccess",
+    actions : [
+      "dynamodb:DescribeStream",
+      "dynamodb:GetRecords",
+      "dynamodb:GetShardIterator",
+    ],
+    resources : [var.stream_arn]
+  }
+
+  # With a customer managed key, the binding can only use the key through DynamoDB
+  kms_statement = {
+    sid : "kmsAccess",
+    actions : [
+      "kms:Decrypt",
+      "kms:DescribeKey",
+      "kms:GenerateDataKey",
+    ],
+    resources : [var.kms_key_arn]
+  }
+
+  binding_policy = concat(
+    [local.table_statement],
+    var.stream_arn != "" ? [local.stream_statement] : [],
+    var.kms_key_arn != "" ? [local.kms_statement] : [],
+  )
+}
+
+data "aws_iam_policy_document" "user_policy" {
+  dynamic "statement" {
+    for_each = local.binding_policy
+    content {
+      sid       = statement.value.sid
+      actions   = statement.value.actions
+      resources = statement.value.resources
+
+      dynamic "condition" {
+        for_each = statement.value.sid == "kmsAccess" ? [1] : []
+        content {
+          test     = "StringEquals"
+          variable = "kms:ViaService"
+          values   = [format("dynamodb.%s.amazonaws.com", var.region)]
+        }
+      }
+    }
+  }
+}
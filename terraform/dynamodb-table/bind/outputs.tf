@@ -0,0 +1,9 @@
+output "access_key_id" {
+  value     = aws_iam_access_key.access_key.id
+  sensitive = true
+}
+output "secret_access_key" {
+  value     = aws_iam_access_key.access_key.secret
+  sensitive = true
+}
+output "access" { value = var.access }
@@ -0,0 +1,6 @@
+variable "region" { type = string }
+variable "arn" { type = string }
+variable "stream_arn" { type = string }
+variable "kms_key_arn" { type = string }
+variable "user_name" { type = string }
+variable "access" { type = string }
@@ -0,0 +1,17 @@
+locals {
+  provisioned = var.billing_mode == "PROVISIONED"
+
+  # Every attribute that is used as a key of the table or of an index must be defined, with the same
+  # type wherever it is used. DynamoDB rejects definitions of attributes that are not keys.
+  key_attributes = concat(
+    [{ name : var.hash_key, type : var.hash_key_type }],
+    var.range_key != "" ? [{ name : var.range_key, type : var.range_key_type }] : [],
+    flatten([
+      for index in var.global_secondary_indexes : concat(
+        [{ name : index.hash_key, type : index.hash_key_type }],
+        index.range_key != "" ? [{ name : index.range_key, type : index.range_key_type }] : [],
+      )
+    ]),
+  )
+  attribute_types = { for attribute in local.key_attributes : attribute.name => attribute.type... }
+}
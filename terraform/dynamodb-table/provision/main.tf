@@ -0,0 +1,60 @@
+resource "aws_dynamodb_table" "table" {
+  name           = var.instance_name
+  billing_mode   = var.billing_mode
+  read_capacity  = local.provisioned ? var.read_capacity : null
+  write_capacity = local.provisioned ? var.write_capacity : null
+  hash_key       = var.hash_key
+  range_key      = var.range_key == "" ? null : var.range_key
+
+  dynamic "attribute" {
+    for_each = local.attribute_types
+    content {
+      name = attribute.key
+      type = attribute.value[0]
+    }
+  }
+
+  dynamic "global_secondary_index" {
+    for_each = var.global_secondary_indexes
+    content {
+      name               = global_secondary_index.value.name
+      hash_key           = global_secondary_index.value.hash_key
+      range_key          = global_secondary_index.value.range_key == "" ? null : global_secondary_index.value.range_key
+      projection_type    = global_secondary_index.value.projection_type
+      non_key_attributes = global_secondary_index.value.projection_type == "INCLUDE" ? global_secondary_index.value.non_key_attributes : null
+      read_capacity      = local.provisioned ? coalesce(global_secondary_index.value.read_capacity, var.read_capacity) : null
+      write_capacity     = local.provisioned ? coalesce(global_secondary_index.value.write_capacity, var.write_capacity) : null
+    }
+  }
+
+  # Always rendered, so that clearing ttl_attribute disables time to live rather than leaving it enabled
+  ttl {
+    enabled        = var.ttl_attribute != ""
+    attribute_name = var.ttl_attribute
+  }
+
+  stream_enabled   = var.stream_view_type != null
+  stream_view_type = var.stream_view_type
+
+  point_in_time_recovery {
+    enabled = var.point_in_time_recovery
+  }
+
+  # Without a customer managed key, the table is encrypted with a key owned by DynamoDB
+  dynamic "server_side_encryption" {
+    for_each = var.kms_key_arn == "" ? [] : [var.kms_key_arn]
+    content {
+      enabled     = true
+      kms_key_arn = server_side_encryption.value
+    }
+  }
+
+  lifecycle {
+    prevent_destroy = true
+
+    precondition {
+      condition     = alltrue([for types in values(local.attribute_types) : length(distinct(types)) == 1])
+      error_message = "A key attribute must have the same type in the table and in every index that uses it."
+    }
+  }
+}
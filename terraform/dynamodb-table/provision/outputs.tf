@@ -0,0 +1,14 @@
+output "arn" { value = aws_dynamodb_table.table.arn }
+output "region" { value = var.region }
+output "table_name" { value = aws_dynamodb_table.table.name }
+output "hash_key" { value = var.hash_key }
+output "range_key" { value = var.range_key }
+output "stream_arn" { value = var.stream_view_type == null ? "" : aws_dynamodb_table.table.stream_arn }
+output "kms_key_arn" { value = var.kms_key_arn }
+output "status" {
+  value = format(
+    "created DynamoDB table: %s (ARN: %s)",
+    aws_dynamodb_table.table.name,
+    aws_dynamodb_table.table.arn
+  )
+}
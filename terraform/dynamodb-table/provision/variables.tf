@@ -0,0 +1,28 @@
+variable "region" { type = string }
+
+variable "instance_name" { type = string }
+variable "labels" { type = map(any) }
+variable "hash_key" { type = string }
+variable "hash_key_type" { type = string }
+variable "range_key" { type = string }
+variable "range_key_type" { type = string }
+variable "global_secondary_indexes" {
+  type = list(object({
+    name               = string
+    hash_key           = string
+    hash_key_type      = optional(string, "S")
+    range_key          = optional(string, "")
+    range_key_type     = optional(string, "S")
+    projection_type    = optional(string, "ALL")
+    non_key_attributes = optional(list(string), [])
+    read_capacity      = optional(number)
+    write_capacity     = optional(number)
+  }))
+}
+variable "billing_mode" { type = string }
+variable "read_capacity" { type = number }
+variable "write_capacity" { type = number }
+variable "ttl_attribute" { type = string }
+variable "stream_view_type" { type = string }
+variable "point_in_time_recovery" { type = bool }
+variable "kms_key_arn" { type = string }
@@ -0,0 +1,120 @@
+locals {
+
+  # The actions granted on the stream depend on the role of the binding. Producers cannot read
+  # the records, and consumers cannot put records into the stream.
+  role_actions = {
+    producer : [
+      "kinesis:PutRecord",
+      "kinesis:PutRecords",
+      "kinesis:DescribeStream",
+      "kinesis:DescribeStreamSummary",
+      "kinesis:ListShards",
+    ],
+    consumer : [
+      "kinesis:GetRecords",
+      "kinesis:GetShardIterator",
+      "kinesis:DescribeStream",
+      "kinesis:DescribeStreamSummary",
+      "kinesis:ListShards",
+      "kinesis:RegisterStreamConsumer",
+      "kinesis:ListStreamConsumers",
+    ],
+    admin : [
+      "kinesis:PutRecord",
+      "kinesis:PutRecords",
+      "kinesis:GetRecords",
+      "kinesis:GetShardIterator",
+      "kinesis:DescribeStream",
+      "kinesis:DescribeStreamSummary",
+      "kinesis:ListShards",
+      "kinesis:RegisterStreamConsumer",
+      "kinesis:ListStreamConsumers",
+      "kinesis:ListTagsForStream",
+    ],
+  }
+
+  # Enhanced fan-out consumers are separate resources of the stream, so reading through them is
+  # granted on the consumer ARNs
+  role_consumer_actions = {
+    producer : [],
+    consumer : [
+      "kinesis:DescribeStreamConsumer",
+      "kinesis:SubscribeToShard",
+    ],
+    admin : [
+      "kinesis:DescribeStreamConsumer",
+      "kinesis:SubscribeToShard",
+      "kinesis:DeregisterStreamConsumer",
+    ],
+  }
+
+  # Records are encrypted when they are put, and decrypted when they are read
+  role_kms_actions = {
+    producer : ["kms:GenerateDataKey"],
+    consumer : ["kms:Decrypt"],
+    admin : ["kms:GenerateDataKey", "kms:Decrypt"],
+  }
+
+  stream_access = {
+    sid : "kinesisAccess",
+    actions : local.role_actions[var.role],
+    resources : [var.arn]
+  }
+
+  stream_consumer_access = {
+    sid : "kinesisConsumerAccess",
+    actions : local.role_consumer_actions[var.role],
+    resources : ["${var.arn}/consumer/*"]
+  }
+
+  kms_statement = {
+    sid : "kmsAccess",
+    actions : local.role_kms_actions[var.role],
+    resources = [for key in data.aws_kms_key.stream_key : key.arn]
+  }
+
+  # The AWS managed key for Kinesis needs no permissions of its own
+  has_customer_key = var.kms_key_id != "" && var.kms_key_id != "alias/aws/kinesis"
+
+  use_role = var.credential_type == "iam_role"
+
+  stream_policy = concat(
+    [local.stream_access],
+    var.role != "producer" ? [local.stream_consumer_access] : [],
+    local.has_customer_key ? [local.kms_statement] : []
+  )
+}
+
+data "aws_iam_policy_document" "user_policy" {
+  dynamic "statement" {
+    for_each = local.stream_policy
+    content {
+      sid       = statement.value.sid
+      actions   = statement.value.actions
+      resources = statement.value.resources
+    }
+  }
+}
+
+data "aws_kms_key" "stream_key" {
+  count  = local.has_customer_key ? 1 : 0
+  key_id = var.kms_key_id
+}
+
+data "aws_iam_policy_document" "trust_policy" {
+  count = local.use_role ? 1 : 0
+
+  statement {
+    sid     = "AssumeBindingRole"
+    actions = ["sts:AssumeRole"]
+    principals {
+      type        = "AWS"
+      identifiers = [var.trusted_principal_arn]
+    }
+    condition {
+      test     = "StringEquals"
+      variable = "sts:ExternalId"
+      values   = [random_password.external_id[0].result]
+    }
+  }
+}
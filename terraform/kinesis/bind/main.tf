@@ -0,0 +1,48 @@
+resource "aws_iam_user" "user" {
+  count = local.use_role ? 0 : 1
+  name  = var.user_name
+  path  = "/cf/"
+}
+
+resource "aws_iam_access_key" "access_key" {
+  count = local.use_role ? 0 : 1
+  user  = aws_iam_user.user[0].name
+}
+
+resource "aws_iam_user_policy" "user_policy" {
+  count = local.use_role ? 0 : 1
+  name  = format("%s-p", var.user_name)
+  user  = aws_iam_user.user[0].name
+
+  policy = data.aws_iam_policy_document.user_policy.json
+}
+
+// Apps are trusted to assume the stream role through the principal that they all share, and
+// they prove that they hold this binding with its generated external ID
+resource "random_password" "external_id" {
+  count   = local.use_role ? 1 : 0
+  length  = 32
+  special = false
+}
+
+resource "aws_iam_role" "role" {
+  count              = local.use_role ? 1 : 0
+  name               = var.user_name
+  path               = "/cf/"
+  assume_role_policy = data.aws_iam_policy_document.trust_policy[0].json
+
+  lifecycle {
+    precondition {
+      condition     = var.trusted_principal_arn != ""
+      error_message = "The broker must be configured with a trusted principal to create bindings with credential_type \"iam_role\"."
+    }
+  }
+}
+
+resource "aws_iam_role_policy" "role_policy" {
+  count = local.use_role ? 1 : 0
+  name  = format("%s-p", var.user_name)
+  role  = aws_iam_role.role[0].id
+
+  policy = data.aws_iam_policy_document.user_policy.json
+}
@@ -0,0 +1,18 @@
+output "access_key_id" {
+  value     = local.use_role ? "" : aws_iam_access_key.access_key[0].id
+  sensitive = true
+}
+output "secret_access_key" {
+  value     = local.use_role ? "" : aws_iam_access_key.access_key[0].secret
+  sensitive = true
+}
+output "role_arn" { value = local.use_role ? aws_iam_role.role[0].arn : "" }
+output "external_id" {
+  value     = local.use_role ? random_password.external_id[0].result : ""
+  sensitive = true
+}
+output "trust_policy" {
+  value     = local.use_role ? data.aws_iam_policy_document.trust_policy[0].json : ""
+  sensitive = true
+}
+output "role" { value = var.role }
@@ -0,0 +1,7 @@
+variable "region" { type = string }
+variable "arn" { type = string }
+variable "user_name" { type = string }
+variable "kms_key_id" { type = string }
+variable "credential_type" { type = string }
+variable "trusted_principal_arn" { type = string }
+variable "role" { type = string }
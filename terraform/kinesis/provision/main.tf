@@ -0,0 +1,27 @@
+resource "aws_kinesis_stream" "stream" {
+  name             = var.instance_name
+  retention_period = var.retention_period
+
+  # On-demand streams scale their shards automatically
+  shard_count = var.stream_mode == "PROVISIONED" ? var.shard_count : null
+
+  stream_mode_details {
+    stream_mode = var.stream_mode
+  }
+
+  # Server-side encryption settings
+  encryption_type = var.encryption_type
+  kms_key_id      = var.encryption_type == "KMS" ? (var.kms_key_id == "" ? "alias/aws/kinesis" : var.kms_key_id) : null
+
+  # Consumers registered by the bindings must not prevent the stream from being deleted
+  enforce_consumer_deletion = true
+
+  lifecycle {
+    prevent_destroy = true
+
+    precondition {
+      condition     = var.kms_key_id == "" || var.encryption_type == "KMS"
+      error_message = "A kms_key_id can only be set when encryption_type is \"KMS\"."
+    }
+  }
+}
@@ -0,0 +1,12 @@
+output "arn" { value = aws_kinesis_stream.stream.arn }
+output "region" { value = var.region }
+output "stream_name" { value = aws_kinesis_stream.stream.name }
+output "stream_mode" { value = var.stream_mode }
+output "kms_key_id" { value = var.kms_key_id }
+output "status" {
+  value = format(
+    "created Kinesis data stream: %s (ARN: %s)",
+    aws_kinesis_stream.stream.name,
+    aws_kinesis_stream.stream.arn
+  )
+}
@@ -0,0 +1,9 @@
+variable "region" { type = string }
+
+variable "instance_name" { type = string }
+variable "labels" { type = map(any) }
+variable "stream_mode" { type = string }
+variable "shard_count" { type = number }
+variable "retention_period" { type = number }
+variable "encryption_type" { type = string }
+variable "kms_key_id" { type = string }
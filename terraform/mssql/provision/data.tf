@@ -141,3 +141,30 @@ data "csbmajorengineversion" "major_version_checker" {
     }
   }
 }
+
+data "aws_db_instances" "provisioned" {
+  filter {
+    name   = "db-instance-id"
+    values = [var.instance_name]
+  }
+}
+
+data "aws_db_instance" "provisioned" {
+  count                  = length(data.aws_db_instances.provisioned.instance_identifiers)
+  db_instance_identifier = var.instance_name
+}
+
+data "csbmajorengineversion_upgrade_targets" "upgrade_checker" {
+  count          = length(data.aws_db_instance.provisioned)
+  engine_version = data.aws_db_instance.provisioned[0].engine_version
+
+  lifecycle {
+    postcondition {
+      condition = (
+        self.engine_version == var.mssql_version || startswith(self.engine_version, "${var.mssql_version}.") ||
+        anytrue([for target in self.upgrade_targets : (target.engine_version == var.mssql_version || startswith(target.engine_version, "${var.mssql_version}.")) && (var.allow_major_version_upgrade || !target.is_major_version_upgrade)])
+      )
+      error_message = "Engine version ${self.engine_version} cannot be upgraded to ${var.mssql_version}. Valid upgrade targets are: ${join(", ", self.upgrade_targets[*].engine_version)}. Major version upgrades also require allow_major_version_upgrade."
+    }
+  }
+}
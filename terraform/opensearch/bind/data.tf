@@ -0,0 +1,4 @@
+locals {
+  # Domains with fine-grained access control enforce HTTPS, which is served on the default port
+  port = 443
+}
@@ -0,0 +1,21 @@
+resource "random_string" "username" {
+  length  = 16
+  special = false
+  numeric = false
+}
+
+resource "random_password" "password" {
+  length           = 64
+  override_special = "~_-."
+  min_upper        = 2
+  min_lower        = 2
+  min_numeric      = 2
+  min_special      = 2
+}
+
+resource "csbopensearch_binding_user" "new_user" {
+  username       = random_string.username.result
+  password       = random_password.password.result
+  index_patterns = var.index_patterns
+  read_only      = var.read_only
+}
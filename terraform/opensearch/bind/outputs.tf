@@ -0,0 +1,20 @@
+output "username" { value = csbopensearch_binding_user.new_user.username }
+output "password" {
+  value     = csbopensearch_binding_user.new_user.password
+  sensitive = true
+}
+output "endpoint" { value = var.endpoint }
+output "hostname" { value = var.hostname }
+output "port" { value = local.port }
+output "uri" {
+  value = format(
+    "https://%s:%s@%s:%d",
+    csbopensearch_binding_user.new_user.username,
+    csbopensearch_binding_user.new_user.password,
+    var.hostname,
+    local.port,
+  )
+  sensitive = true
+}
+output "index_patterns" { value = csbopensearch_binding_user.new_user.index_patterns }
+output "read_only" { value = var.read_only }
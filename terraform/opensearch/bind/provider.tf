@@ -0,0 +1,5 @@
+provider "csbopensearch" {
+  endpoint       = var.endpoint
+  admin_username = var.admin_username
+  admin_password = var.admin_password
+}
@@ -0,0 +1,10 @@
+
+variable "endpoint" { type = string }
+variable "hostname" { type = string }
+variable "admin_username" { type = string }
+variable "admin_password" {
+  type      = string
+  sensitive = true
+}
+variable "index_patterns" { type = list(string) }
+variable "read_only" { type = bool }
@@ -0,0 +1,42 @@
+data "aws_vpc" "vpc" {
+  default = length(var.aws_vpc_id) == 0
+  id      = length(var.aws_vpc_id) == 0 ? null : var.aws_vpc_id
+}
+
+data "aws_subnets" "all" {
+  filter {
+    name   = "vpc-id"
+    values = [data.aws_vpc.vpc.id]
+  }
+}
+
+data "aws_subnet" "all" {
+  for_each = toset(data.aws_subnets.all.ids)
+  id       = each.value
+}
+
+locals {
+  # A domain has one subnet per availability zone that it uses, so the default subnets are the first
+  # subnet of as many availability zones as needed
+  subnet_count       = var.zone_awareness_enabled ? var.availability_zone_count : 1
+  subnet_ids_by_az   = { for s in data.aws_subnet.all : s.availability_zone => s.id... }
+  default_subnet_ids = [for az in sort(keys(local.subnet_ids_by_az)) : sort(local.subnet_ids_by_az[az])[0]]
+
+  subnet_ids                        = length(var.opensearch_subnet_ids) == 0 ? slice(local.default_subnet_ids, 0, min(local.subnet_count, length(local.default_subnet_ids))) : split(",", var.opensearch_subnet_ids)
+  opensearch_vpc_security_group_ids = length(var.opensearch_vpc_security_group_ids) == 0 ? [aws_security_group.opensearch_sg[0].id] : split(",", var.opensearch_vpc_security_group_ids)
+}
+
+# Access is controlled by the fine-grained access control of the domain, so any principal that can reach
+# the domain in the VPC may send requests to it
+data "aws_iam_policy_document" "access" {
+  statement {
+    effect    = "Allow"
+    actions   = ["es:ESHttp*"]
+    resources = [format("%s/*", aws_opensearch_domain.domain.arn)]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["*"]
+    }
+  }
+}
@@ -0,0 +1,105 @@
+resource "aws_security_group" "opensearch_sg" {
+  count  = length(var.opensearch_vpc_security_group_ids) == 0 ? 1 : 0
+  name   = format("%s-sg", var.instance_name)
+  vpc_id = data.aws_vpc.vpc.id
+}
+
+resource "aws_security_group_rule" "opensearch_inbound_access" {
+  count             = length(var.opensearch_vpc_security_group_ids) == 0 ? 1 : 0
+  protocol          = "tcp"
+  security_group_id = aws_security_group.opensearch_sg[0].id
+  from_port         = 443
+  to_port           = 443
+  type              = "ingress"
+  cidr_blocks       = ["0.0.0.0/0"]
+}
+
+resource "random_string" "username" {
+  length  = 16
+  special = false
+  numeric = false
+}
+
+resource "random_password" "password" {
+  length = 64
+  // The master user password must contain at least one uppercase letter, lowercase letter, number and special character
+  override_special = "~_-."
+  min_upper        = 2
+  min_lower        = 2
+  min_numeric      = 2
+  min_special      = 2
+}
+
+resource "aws_opensearch_domain" "domain" {
+  domain_name    = var.instance_name
+  engine_version = var.engine_version
+  tags           = var.labels
+
+  cluster_config {
+    instance_type          = var.instance_type
+    instance_count         = var.instance_count
+    zone_awareness_enabled = var.zone_awareness_enabled
+
+    dynamic "zone_awareness_config" {
+      for_each = var.zone_awareness_enabled ? [1] : []
+      content {
+        availability_zone_count = var.availability_zone_count
+      }
+    }
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_type = var.volume_type
+    volume_size = var.volume_size
+  }
+
+  # Fine-grained access control requires encryption at rest, node-to-node encryption and HTTPS
+  encrypt_at_rest {
+    enabled    = true
+    kms_key_id = var.kms_key_id == "" ? null : var.kms_key_id
+  }
+
+  node_to_node_encryption {
+    enabled = true
+  }
+
+  domain_endpoint_options {
+    enforce_https       = true
+    tls_security_policy = var.tls_security_policy
+  }
+
+  advanced_security_options {
+    enabled                        = true
+    internal_user_database_enabled = true
+
+    master_user_options {
+      master_user_name     = random_string.username.result
+      master_user_password = random_password.password.result
+    }
+  }
+
+  vpc_options {
+    subnet_ids         = local.subnet_ids
+    security_group_ids = local.opensearch_vpc_security_group_ids
+  }
+
+  lifecycle {
+    prevent_destroy = true
+
+    precondition {
+      condition     = length(local.subnet_ids) == local.subnet_count
+      error_message = format("The domain needs one subnet in each of %d availability zones, but %d subnets were found or specified.", local.subnet_count, length(local.subnet_ids))
+    }
+
+    precondition {
+      condition     = !var.zone_awareness_enabled || (var.instance_count >= var.availability_zone_count && (var.availability_zone_count == 3 || var.instance_count % 2 == 0))
+      error_message = "With zone awareness, instance_count must be at least availability_zone_count, and must be even when availability_zone_count is 2."
+    }
+  }
+}
+
+resource "aws_opensearch_domain_policy" "access" {
+  domain_name     = aws_opensearch_domain.domain.domain_name
+  access_policies = data.aws_iam_policy_document.access.json
+}
@@ -0,0 +1,21 @@
+output "name" { value = aws_opensearch_domain.domain.domain_name }
+output "arn" { value = aws_opensearch_domain.domain.arn }
+output "hostname" { value = aws_opensearch_domain.domain.endpoint }
+output "endpoint" { value = format("https://%s", aws_opensearch_domain.domain.endpoint) }
+output "dashboards_endpoint" { value = format("https://%s", aws_opensearch_domain.domain.dashboard_endpoint) }
+output "username" { value = random_string.username.result }
+output "password" {
+  value     = random_password.password.result
+  sensitive = true
+}
+output "region" {
+  value = var.region
+}
+output "status" {
+  value = format(
+    "created domain %s (version: %s) with endpoint %s",
+    aws_opensearch_domain.domain.domain_name,
+    aws_opensearch_domain.domain.engine_version,
+    aws_opensearch_domain.domain.endpoint,
+  )
+}
@@ -0,0 +1,16 @@
+
+variable "region" { type = string }
+variable "instance_name" { type = string }
+variable "labels" { type = map(any) }
+variable "aws_vpc_id" { type = string }
+variable "engine_version" { type = string }
+variable "instance_type" { type = string }
+variable "instance_count" { type = number }
+variable "zone_awareness_enabled" { type = bool }
+variable "availability_zone_count" { type = number }
+variable "volume_type" { type = string }
+variable "volume_size" { type = number }
+variable "kms_key_id" { type = string }
+variable "tls_security_policy" { type = string }
+variable "opensearch_subnet_ids" { type = string }
+variable "opensearch_vpc_security_group_ids" { type = string }
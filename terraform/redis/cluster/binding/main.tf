@@ -0,0 +1,40 @@
+# Copyright 2020 Pivotal Software, Inc.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#      http:#www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+// Instances provisioned before bindings got their own users have no RBAC details, and keep sharing the auth token
+locals {
+  instance_details = jsondecode(var.instance_details)
+  rbac_enabled     = try(local.instance_details.rbac_enabled, false)
+}
+
+resource "random_string" "username" {
+  length  = 16
+  special = false
+  upper   = false
+}
+
+resource "random_password" "password" {
+  length = 64
+  // https://docs.aws.amazon.com/AmazonElastiCache/latest/red-ug/auth.html
+  override_special = "!&#$^<>-"
+  min_upper        = 2
+  min_lower        = 2
+  min_special      = 2
+}
+
+resource "csbredis_binding_user" "binding" {
+  count    = local.rbac_enabled ? 1 : 0
+  username = format("csb-%s", random_string.username.result)
+  password = random_password.password.result
+}
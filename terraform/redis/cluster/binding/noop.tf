@@ -1,3 +0,0 @@
-# dummy file to prevent the broker wrapping this into modules. DO NOT REMOVE.
-
-
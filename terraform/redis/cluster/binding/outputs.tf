@@ -0,0 +1,46 @@
+# Copyright 2020 Pivotal Software, Inc.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#      http:#www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+// Without RBAC, bindings share the auth token of the instance
+locals {
+  username = local.rbac_enabled ? csbredis_binding_user.binding[0].username : ""
+  password = local.rbac_enabled ? csbredis_binding_user.binding[0].password : var.instance_password
+}
+
+output "username" { value = local.username }
+output "password" {
+  value     = local.password
+  sensitive = true
+}
+output "uri" {
+  value = format(
+    "rediss://%s:%s@%s:%d",
+    urlencode(local.username),
+    urlencode(local.password),
+    var.host,
+    var.tls_port,
+  )
+  sensitive = true
+}
+output "reader_uri" {
+  value = var.reader_endpoint == "" ? "" : format(
+    "rediss://%s:%s@%s:%d",
+    urlencode(local.username),
+    urlencode(local.password),
+    var.reader_endpoint,
+    var.tls_port,
+  )
+  sensitive = true
+}
+output "tls" { value = true }
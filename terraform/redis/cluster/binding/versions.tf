@@ -0,0 +1,12 @@
+terraform {
+  required_providers {
+    csbredis = {
+      source  = "cloudfoundry.org/cloud-service-broker/csbredis"
+      version = "1.0.0"
+    }
+    random = {
+      source  = "registry.terraform.io/hashicorp/random"
+      version = "~> 3"
+    }
+  }
+}
@@ -43,6 +43,31 @@ resource "random_password" "auth_token" {
   min_special      = 2
 }
 
+// With RBAC enabled, the auth token becomes the password of the "default" user, and every binding
+// gets its own user in the user group
+resource "aws_elasticache_user" "default" {
+  count         = var.rbac_enabled ? 1 : 0
+  user_id       = format("%s-default", var.instance_name)
+  user_name     = "default"
+  access_string = "on ~* +@all"
+  engine        = "redis"
+  passwords     = [random_password.auth_token.result]
+  tags          = var.labels
+}
+
+resource "aws_elasticache_user_group" "users" {
+  count         = var.rbac_enabled ? 1 : 0
+  user_group_id = var.instance_name
+  engine        = "redis"
+  user_ids      = [aws_elasticache_user.default[0].user_id]
+  tags          = var.labels
+
+  lifecycle {
+    // Binding users are added and removed by the csbredis provider
+    ignore_changes = [user_ids]
+  }
+}
+
 resource "aws_elasticache_replication_group" "redis" {
   automatic_failover_enabled  = var.node_count == 1 ? false : var.automatic_failover_enabled
   multi_az_enabled            = var.node_count == 1 ? false : var.multi_az_enabled
@@ -56,7 +81,8 @@ resource "aws_elasticache_replication_group" "redis" {
   security_group_ids          = local.elasticache_vpc_security_group_ids
   subnet_group_name           = local.subnet_group
   transit_encryption_enabled  = true
-  auth_token                  = random_password.auth_token.result
+  auth_token                  = var.rbac_enabled ? null : random_password.auth_token.result
+  user_group_ids              = var.rbac_enabled ? [aws_elasticache_user_group.users[0].user_group_id] : null
   apply_immediately           = true
   at_rest_encryption_enabled  = var.at_rest_encryption_enabled
   kms_key_id                  = var.kms_key_id
@@ -22,4 +22,7 @@ output "tls_port" { value = var.port }
 output "status" {
   value = format("created cache %s (id: %s)", aws_elasticache_replication_group.redis.primary_endpoint_address, aws_elasticache_replication_group.redis.id)
 }
-output "reader_endpoint" { value = aws_elasticache_replication_group.redis.reader_endpoint_address }
\ No newline at end of file
+output "reader_endpoint" { value = aws_elasticache_replication_group.redis.reader_endpoint_address }
+output "region" { value = var.region }
+output "rbac_enabled" { value = var.rbac_enabled }
+output "user_group_id" { value = var.rbac_enabled ? aws_elasticache_user_group.users[0].user_group_id : "" }
\ No newline at end of file
@@ -49,3 +49,4 @@ variable "logs_engine_log_enabled" { type = bool }
 variable "logs_engine_log_loggroup_retention_in_days" { type = number }
 variable "logs_engine_log_loggroup_kms_key_id" { type = string }
 variable "auto_minor_version_upgrade" { type = bool }
+variable "rbac_enabled" { type = bool }
@@ -16,10 +16,49 @@ locals {
   user_policy_with_or_without_encryption = try(data.aws_iam_policy_document.user_policy_sse[0], data.aws_iam_policy_document.user_policy)
 
   key_ids_list = try(compact(split(",", var.sse_all_kms_key_ids)), [])
+
+  use_role = var.credential_type == "iam_role"
+
+  permission_policies = {
+    read_write    = data.aws_iam_policy_document.read_write
+    read_only     = data.aws_iam_policy_document.read_only
+    write_only    = data.aws_iam_policy_document.write_only
+    custom_prefix = data.aws_iam_policy_document.custom_prefix
+  }
+
+  # A key is kept per rotation. The key of the previous rotation keeps working until the grace period
+  # ends, unless the user was only created after that rotation. Instances provisioned before rotation
+  # was available count as rotation 0.
+  instance_details         = jsondecode(var.instance_details)
+  access_key_rotation      = try(local.instance_details.access_key_rotation, 0)
+  user_access_key_rotation = local.use_role ? 0 : try(tonumber(aws_iam_user.user[0].tags["access_key_rotation"]), 0)
+  keep_previous_access_key = local.user_access_key_rotation < local.access_key_rotation && try(timecmp(plantimestamp(), local.instance_details.access_key_rotation_grace_period_ends_at) < 0, false)
+  access_key_rotations = local.use_role ? [] : compact([
+    tostring(local.access_key_rotation),
+    local.keep_previous_access_key ? tostring(local.access_key_rotation - 1) : "",
+  ])
 }
 
+data "aws_iam_policy_document" "trust_policy" {
+  count = local.use_role ? 1 : 0
 
-data "aws_iam_policy_document" "user_policy" {
+  statement {
+    sid     = "AssumeBindingRole"
+    actions = ["sts:AssumeRole"]
+    principals {
+      type        = "AWS"
+      identifiers = [var.trusted_principal_arn]
+    }
+    condition {
+      test     = "StringEquals"
+      variable = "sts:ExternalId"
+      values   = [random_password.external_id[0].result]
+    }
+  }
+}
+
+// Each value of the `permissions` parameter maps to its own policy document
+data "aws_iam_policy_document" "read_write" {
   statement {
     sid = "bucketAccess"
     actions = [
@@ -77,6 +116,115 @@ data "aws_iam_policy_document" "user_policy" {
       format("%s/*", var.arn)
     ]
   }
+}
+
+data "aws_iam_policy_document" "read_only" {
+  statement {
+    sid = "bucketAccess"
+    actions = [
+      "s3:ListBucket",
+      "s3:ListBucketVersions",
+      "s3:GetBucketLocation",
+      "s3:GetBucketVersioning",
+      "s3:GetBucketTagging",
+    ]
+    resources = [
+      var.arn
+    ]
+  }
+
+  statement {
+    sid = "bucketContentAccess"
+    actions = [
+      "s3:GetObject",
+      "s3:GetObjectAcl",
+      "s3:GetObjectTagging",
+      "s3:GetObjectVersion",
+      "s3:GetObjectVersionAcl",
+    ]
+    resources = [
+      format("%s/*", var.arn)
+    ]
+  }
+}
+
+// Producers can upload objects, but can neither list, read nor delete them
+data "aws_iam_policy_document" "write_only" {
+  statement {
+    sid = "bucketAccess"
+    actions = [
+      "s3:GetBucketLocation",
+      "s3:ListBucketMultipartUploads",
+    ]
+    resources = [
+      var.arn
+    ]
+  }
+
+  statement {
+    sid = "bucketContentAccess"
+    actions = [
+      "s3:AbortMultipartUpload",
+      "s3:ListMultipartUploadParts",
+      "s3:PutObject",
+      "s3:PutObjectTagging",
+    ]
+    resources = [
+      format("%s/*", var.arn)
+    ]
+  }
+}
+
+// Read and write access to the objects under the key prefix, and to listing them
+data "aws_iam_policy_document" "custom_prefix" {
+  statement {
+    sid = "bucketAccess"
+    actions = [
+      "s3:GetBucketLocation",
+    ]
+    resources = [
+      var.arn
+    ]
+  }
+
+  statement {
+    sid = "bucketListPrefix"
+    actions = [
+      "s3:ListBucket",
+      "s3:ListBucketVersions",
+    ]
+    resources = [
+      var.arn
+    ]
+    condition {
+      test     = "StringLike"
+      variable = "s3:prefix"
+      values   = [format("%s*", var.key_prefix)]
+    }
+  }
+
+  statement {
+    sid = "bucketContentAccess"
+    actions = [
+      "s3:AbortMultipartUpload",
+      "s3:DeleteObject",
+      "s3:DeleteObjectTagging",
+      "s3:DeleteObjectVersion",
+      "s3:GetObject",
+      "s3:GetObjectTagging",
+      "s3:GetObjectVersion",
+      "s3:ListMultipartUploadParts",
+      "s3:PutObject",
+      "s3:PutObjectTagging",
+    ]
+    resources = [
+      format("%s/%s*", var.arn, var.key_prefix)
+    ]
+  }
+}
+
+data "aws_iam_policy_document" "user_policy" {
+  source_policy_documents = [local.permission_policies[var.permissions].json]
 
   dynamic "statement" {
     for_each = var.allowed_aws_vpc_id != "" ? [1] : []
@@ -93,6 +241,13 @@ data "aws_iam_policy_document" "user_policy" {
       }
     }
   }
+
+  lifecycle {
+    precondition {
+      condition     = var.permissions != "custom_prefix" || var.key_prefix != ""
+      error_message = "A key_prefix must be specified when permissions is \"custom_prefix\"."
+    }
+  }
 }
 
 data "aws_kms_key" "customer_provided_keys" {
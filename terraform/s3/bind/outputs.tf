@@ -13,10 +13,21 @@
 # limitations under the License.
 
 output "access_key_id" {
-  value     = aws_iam_access_key.access_key.id
+  value     = local.use_role ? "" : aws_iam_access_key.access_key[tostring(local.access_key_rotation)].id
   sensitive = true
 }
 output "secret_access_key" {
-  value     = aws_iam_access_key.access_key.secret
+  value     = local.use_role ? "" : aws_iam_access_key.access_key[tostring(local.access_key_rotation)].secret
   sensitive = true
-}
\ No newline at end of file
+}
+output "role_arn" { value = local.use_role ? aws_iam_role.role[0].arn : "" }
+output "external_id" {
+  value     = local.use_role ? random_password.external_id[0].result : ""
+  sensitive = true
+}
+output "trust_policy" {
+  value     = local.use_role ? data.aws_iam_policy_document.trust_policy[0].json : ""
+  sensitive = true
+}
+output "permissions" { value = var.permissions }
+output "key_prefix" { value = var.key_prefix }
@@ -17,3 +17,8 @@ variable "arn" { type = string }
 variable "user_name" { type = string }
 variable "sse_all_kms_key_ids" { type = string }
 variable "allowed_aws_vpc_id" { type = string }
+variable "credential_type" { type = string }
+variable "trusted_principal_arn" { type = string }
+variable "instance_details" { type = string }
+variable "permissions" { type = string }
+variable "key_prefix" { type = string }
@@ -4,5 +4,9 @@ terraform {
       source  = "registry.terraform.io/hashicorp/aws"
       version = "~> 6"
     }
+    random = {
+      source  = "registry.terraform.io/hashicorp/random"
+      version = "~> 3"
+    }
   }
 }
\ No newline at end of file
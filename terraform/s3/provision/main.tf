@@ -113,4 +113,14 @@ resource "aws_s3_bucket_policy" "bucket_require_tls_policy" {
       "Principal" : "*"
     }]
   })
-}
\ No newline at end of file
+}
+
+# Bindings keep the access key of the previous rotation until this time
+resource "terraform_data" "access_key_rotation" {
+  triggers_replace = [var.access_key_rotation]
+  input            = timeadd(plantimestamp(), format("%dh", var.access_key_rotation_grace_period_hours))
+
+  lifecycle {
+    ignore_changes = [input]
+  }
+}
@@ -18,3 +18,5 @@ output "region" { value = aws_s3_bucket.b.region }
 output "bucket_name" { value = aws_s3_bucket.b.bucket }
 output "sse_all_kms_key_ids" { value = local.sse_all_kms_key_ids }
 output "allowed_aws_vpc_id" { value = var.allowed_aws_vpc_id }
+output "access_key_rotation" { value = var.access_key_rotation }
+output "access_key_rotation_grace_period_ends_at" { value = terraform_data.access_key_rotation.output }
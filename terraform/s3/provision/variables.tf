@@ -42,3 +42,5 @@ variable "require_tls" { type = bool }
 
 variable "allowed_aws_vpc_id" { type = string }
 
+variable "access_key_rotation" { type = number }
+variable "access_key_rotation_grace_period_hours" { type = number }
@@ -0,0 +1,71 @@
+locals {
+  subscribe_queue = var.subscription_queue_arn != ""
+
+  publish_statement = {
+    sid : "snsPublish",
+    actions : [
+      "sns:Publish",
+      "sns:GetTopicAttributes",
+    ],
+    resources : [var.arn]
+  }
+
+  # Publishing to a topic encrypted with a customer managed key needs access to the key
+  kms_statement = {
+    sid : "kmsAccess",
+    actions : [
+      "kms:GenerateDataKey",
+      "kms:Decrypt"
+    ]
+    resources = [for key in data.aws_kms_key.topic_key : key.arn]
+  }
+
+  # SNS can only deliver to the queue when the queue policy allows it, and the statement only allows this topic
+  queue_statement = jsonencode({
+    Effect    = "Allow"
+    Principal = { Service = "sns.amazonaws.com" }
+    Action    = "sqs:SendMessage"
+    Resource  = var.subscription_queue_arn
+    Condition = { ArnEquals = { "aws:SourceArn" = var.arn } }
+  })
+
+  topic_policy = concat(
+    [local.publish_statement],
+    var.kms_master_key_id != "" ? [local.kms_statement] : []
+  )
+}
+
+data "aws_iam_policy_document" "user_policy" {
+  dynamic "statement" {
+    for_each = local.topic_policy
+    content {
+      sid       = statement.value.sid
+      actions   = statement.value.actions
+      resources = statement.value.resources
+    }
+  }
+}
+
+data "aws_kms_key" "topic_key" {
+  count  = var.kms_master_key_id != "" ? 1 : 0
+  key_id = var.kms_master_key_id
+}
+
+data "aws_arn" "queue" {
+  count = local.subscribe_queue ? 1 : 0
+  arn   = var.subscription_queue_arn
+
+  lifecycle {
+    postcondition {
+      condition     = self.service == "sqs"
+      error_message = "The subscription_queue_arn must be the ARN of an SQS queue."
+    }
+  }
+}
+
+// The queue may be in a different region to the topic
+data "aws_sqs_queue" "queue" {
+  count  = local.subscribe_queue ? 1 : 0
+  name   = data.aws_arn.queue[0].resource
+  region = data.aws_arn.queue[0].region
+}
@@ -0,0 +1,34 @@
+resource "aws_iam_user" "user" {
+  name = var.user_name
+  path = "/cf/"
+}
+
+resource "aws_iam_access_key" "access_key" {
+  user = aws_iam_user.user.name
+}
+
+resource "aws_iam_user_policy" "user_policy" {
+  name = format("%s-p", var.user_name)
+  user = aws_iam_user.user.name
+
+  policy = data.aws_iam_policy_document.user_policy.json
+}
+
+// Adds a statement to the queue policy rather than replacing it, so that the statements of the queue
+// owner and of other topics are kept. Deleting the binding only removes this statement.
+resource "csbsqs_queue_policy_statement" "topic_delivery" {
+  count     = local.subscribe_queue ? 1 : 0
+  queue_url = data.aws_sqs_queue.queue[0].url
+  sid       = format("AllowTopicDelivery%s", replace(var.user_name, "/[^a-zA-Z0-9]/", ""))
+  statement = local.queue_statement
+}
+
+resource "aws_sns_topic_subscription" "queue" {
+  count                = local.subscribe_queue ? 1 : 0
+  topic_arn            = var.arn
+  protocol             = "sqs"
+  endpoint             = var.subscription_queue_arn
+  raw_message_delivery = var.raw_message_delivery
+
+  depends_on = [csbsqs_queue_policy_statement.topic_delivery]
+}
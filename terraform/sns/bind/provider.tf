@@ -0,0 +1,8 @@
+provider "aws" {
+  region = var.region
+}
+
+// The policy of the queue is changed in the region of the queue, taken from its ARN
+provider "csbsqs" {
+  region = try(split(":", var.subscription_queue_arn)[3], var.region)
+}
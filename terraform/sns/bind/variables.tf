@@ -0,0 +1,6 @@
+variable "region" { type = string }
+variable "arn" { type = string }
+variable "user_name" { type = string }
+variable "kms_master_key_id" { type = string }
+variable "subscription_queue_arn" { type = string }
+variable "raw_message_delivery" { type = bool }
@@ -0,0 +1,12 @@
+terraform {
+  required_providers {
+    aws = {
+      source  = "registry.terraform.io/hashicorp/aws"
+      version = "~> 6"
+    }
+    csbsqs = {
+      source  = "cloudfoundry.org/cloud-service-broker/csbsqs"
+      version = "1.0.0"
+    }
+  }
+}
\ No newline at end of file
@@ -0,0 +1,19 @@
+resource "aws_sns_topic" "topic" {
+  name                        = var.fifo ? "${var.instance_name}.fifo" : var.instance_name
+  fifo_topic                  = var.fifo
+  content_based_deduplication = var.fifo ? var.content_based_deduplication : null
+
+  # Server-side encryption settings
+  kms_master_key_id = var.kms_master_key_id == "" ? null : var.kms_master_key_id
+
+  delivery_policy = var.delivery_policy == "" ? null : var.delivery_policy
+
+  lifecycle {
+    prevent_destroy = true
+
+    precondition {
+      condition     = var.delivery_policy == "" || can(jsondecode(var.delivery_policy))
+      error_message = "The delivery_policy must be a JSON document."
+    }
+  }
+}
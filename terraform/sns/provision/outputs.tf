@@ -0,0 +1,12 @@
+output "arn" { value = aws_sns_topic.topic.arn }
+output "region" { value = var.region }
+output "topic_name" { value = aws_sns_topic.topic.name }
+output "fifo" { value = var.fifo }
+output "kms_master_key_id" { value = var.kms_master_key_id }
+output "status" {
+  value = format(
+    "created SNS topic: %s (ARN: %s)",
+    aws_sns_topic.topic.name,
+    aws_sns_topic.topic.arn
+  )
+}
@@ -0,0 +1,8 @@
+variable "region" { type = string }
+
+variable "instance_name" { type = string }
+variable "labels" { type = map(any) }
+variable "fifo" { type = bool }
+variable "content_based_deduplication" { type = bool }
+variable "kms_master_key_id" { type = string }
+variable "delivery_policy" { type = string }
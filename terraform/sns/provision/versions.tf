@@ -0,0 +1,8 @@
+terraform {
+  required_providers {
+    aws = {
+      source  = "registry.terraform.io/hashicorp/aws"
+      version = "~> 6"
+    }
+  }
+}
\ No newline at end of file
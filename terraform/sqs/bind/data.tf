@@ -1,11 +1,25 @@
 locals {
 
-  standard_access = {
-    sid : "sqsAccess",
-    actions : [
+  # The actions granted on the queue depend on the role of the binding. Producers cannot drain
+  # the queue, and consumers cannot inject messages into it.
+  role_actions = {
+    producer : [
       "sqs:SendMessage",
+      "sqs:GetQueueAttributes",
+      "sqs:GetQueueUrl",
+    ],
+    consumer : [
       "sqs:ReceiveMessage",
       "sqs:DeleteMessage",
+      "sqs:ChangeMessageVisibility",
+      "sqs:GetQueueAttributes",
+      "sqs:GetQueueUrl",
+    ],
+    admin : [
+      "sqs:SendMessage",
+      "sqs:ReceiveMessage",
+      "sqs:DeleteMessage",
+      "sqs:ChangeMessageVisibility",
       "sqs:PurgeQueue",
       "sqs:GetQueueAttributes",
       "sqs:GetQueueUrl",
@@ -13,6 +27,11 @@ locals {
       "sqs:ListQueueTags",
       "sqs:ListDeadLetterSourceQueues",
     ],
+  }
+
+  standard_access = {
+    sid : "sqsAccess",
+    actions : local.role_actions[var.role],
     resources : [var.arn]
   }
 
@@ -41,9 +60,22 @@ locals {
   key_ids_list = compact(split(",", var.kms_all_key_ids))
   has_key_ids  = length(local.key_ids_list) != 0
 
+  use_role = var.credential_type == "iam_role"
+
+  # Rotations before the binding user was created never had a key, so only existing users keep the key
+  # of the previous rotation, and only until the grace period of the rotation ends
+  instance_details         = jsondecode(var.instance_details)
+  access_key_rotation      = try(local.instance_details.access_key_rotation, 0)
+  user_access_key_rotation = local.use_role ? 0 : try(tonumber(aws_iam_user.user[0].tags["access_key_rotation"]), 0)
+  keep_previous_access_key = local.user_access_key_rotation < local.access_key_rotation && try(timecmp(plantimestamp(), local.instance_details.access_key_rotation_grace_period_ends_at) < 0, false)
+  access_key_rotations = local.use_role ? [] : compact([
+    tostring(local.access_key_rotation),
+    local.keep_previous_access_key ? tostring(local.access_key_rotation - 1) : "",
+  ])
+
   queue_policy = concat(
     [local.standard_access],
-    length(var.dlq_arn) > 0 ? [local.dql_redrive_access] : [],
+    length(var.dlq_arn) > 0 && var.role == "admin" ? [local.dql_redrive_access] : [],
     local.has_key_ids ? [local.kms_statement] : []
   )
 }
@@ -62,4 +94,22 @@ data "aws_iam_policy_document" "user_policy" {
 data "aws_kms_key" "customer_provided_keys" {
   count  = local.has_key_ids ? length(local.key_ids_list) : 0
   key_id = local.key_ids_list[count.index]
-}
\ No newline at end of file
+}
+
+data "aws_iam_policy_document" "trust_policy" {
+  count = local.use_role ? 1 : 0
+
+  statement {
+    sid     = "AssumeBindingRole"
+    actions = ["sts:AssumeRole"]
+    principals {
+      type        = "AWS"
+      identifiers = [var.trusted_principal_arn]
+    }
+    condition {
+      test     = "StringEquals"
+      variable = "sts:ExternalId"
+      values   = [random_password.external_id[0].result]
+    }
+  }
+}
@@ -1,15 +1,68 @@
 resource "aws_iam_user" "user" {
-  name = var.user_name
-  path = "/cf/"
+  count = local.use_role ? 0 : 1
+  name  = var.user_name
+  path  = "/cf/"
+  tags  = { access_key_rotation = local.access_key_rotation }
+
+  lifecycle {
+    ignore_changes = [tags["access_key_rotation"]]
+  }
 }
 
 resource "aws_iam_access_key" "access_key" {
-  user = aws_iam_user.user.name
+  for_each = toset(local.access_key_rotations)
+  user     = aws_iam_user.user[0].name
 }
 
 resource "aws_iam_user_policy" "user_policy" {
-  name = format("%s-p", var.user_name)
-  user = aws_iam_user.user.name
+  count = local.use_role ? 0 : 1
+  name  = format("%s-p", var.user_name)
+  user  = aws_iam_user.user[0].name
+
+  policy = data.aws_iam_policy_document.user_policy.json
+}
+
+// The trusted principal is shared by all apps, so assuming the queue role also requires this
+// secret, which only the credentials of this binding contain
+resource "random_password" "external_id" {
+  count   = local.use_role ? 1 : 0
+  length  = 32
+  special = false
+}
+
+resource "aws_iam_role" "role" {
+  count              = local.use_role ? 1 : 0
+  name               = var.user_name
+  path               = "/cf/"
+  assume_role_policy = data.aws_iam_policy_document.trust_policy[0].json
+
+  lifecycle {
+    precondition {
+      condition     = var.trusted_principal_arn != ""
+      error_message = "The broker must be configured with a trusted principal to create bindings with credential_type \"iam_role\"."
+    }
+  }
+}
+
+resource "aws_iam_role_policy" "role_policy" {
+  count = local.use_role ? 1 : 0
+  name  = format("%s-p", var.user_name)
+  role  = aws_iam_role.role[0].id
 
   policy = data.aws_iam_policy_document.user_policy.json
 }
+
+moved {
+  from = aws_iam_user.user
+  to   = aws_iam_user.user[0]
+}
+
+moved {
+  from = aws_iam_access_key.access_key
+  to   = aws_iam_access_key.access_key["0"]
+}
+
+moved {
+  from = aws_iam_user_policy.user_policy
+  to   = aws_iam_user_policy.user_policy[0]
+}
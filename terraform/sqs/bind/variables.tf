@@ -3,3 +3,7 @@ variable "arn" { type = string }
 variable "user_name" { type = string }
 variable "dlq_arn" { type = string }
 variable "kms_all_key_ids" { type = string }
+variable "credential_type" { type = string }
+variable "trusted_principal_arn" { type = string }
+variable "instance_details" { type = string }
+variable "role" { type = string }
@@ -25,4 +25,14 @@ resource "aws_sqs_queue" "queue" {
   lifecycle {
     prevent_destroy = true
   }
-}
\ No newline at end of file
+}
+
+# Computed once per rotation, so that the end of the grace period stays put until the next rotation
+resource "terraform_data" "access_key_rotation" {
+  triggers_replace = [var.access_key_rotation]
+  input            = timeadd(plantimestamp(), format("%dh", var.access_key_rotation_grace_period_hours))
+
+  lifecycle {
+    ignore_changes = [input]
+  }
+}
@@ -10,4 +10,6 @@ output "status" {
     aws_sqs_queue.queue.id,
     aws_sqs_queue.queue.arn
   )
-}
\ No newline at end of file
+}
+output "access_key_rotation" { value = var.access_key_rotation }
+output "access_key_rotation_grace_period_ends_at" { value = terraform_data.access_key_rotation.output }
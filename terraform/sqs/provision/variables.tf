@@ -17,3 +17,5 @@ variable "sqs_managed_sse_enabled" { type = bool }
 variable "kms_master_key_id" { type = string }
 variable "kms_data_key_reuse_period_seconds" { type = number }
 variable "kms_extra_key_ids" { type = string }
+variable "access_key_rotation" { type = number }
+variable "access_key_rotation_grace_period_hours" { type = number }